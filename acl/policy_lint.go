@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// LintRedundantPrefix is reported for a prefix rule that grants exactly
+	// the same access as the closest enclosing prefix rule.
+	LintRedundantPrefix = "redundant-prefix"
+
+	// LintShadowedExact is reported for an exact rule that grants exactly the
+	// same access as the prefix rule that would otherwise match it.
+	LintShadowedExact = "shadowed-exact"
+
+	// LintDuplicateRule is reported when the same segment is declared more
+	// than once for a resource. Only the rule that takes precedence is ever
+	// enforced.
+	LintDuplicateRule = "duplicate-rule"
+
+	// LintBroadWrite is reported for write access granted on the empty prefix,
+	// which matches every resource of that type.
+	LintBroadWrite = "broad-write"
+
+	// LintDeprecatedSyntax is reported for rule blocks that are still decoded
+	// but ignored by the authorizer.
+	LintDeprecatedSyntax = "deprecated-syntax"
+)
+
+// PolicyLintFinding describes a single issue found while linting the rules
+// of a policy. Findings never make a policy invalid, they only point out
+// rules that are unlikely to do what the author intended.
+type PolicyLintFinding struct {
+	// Code is one of the Lint* constants.
+	Code string
+
+	// Resource is the rule block the finding applies to, such as
+	// "key_prefix" or "service".
+	Resource string
+
+	// Segment is the name or prefix of the offending rule.
+	Segment string
+
+	// Message is a human readable description of the finding.
+	Message string
+}
+
+// lintRule is the resource independent view of a single rule used by the
+// linter.
+type lintRule struct {
+	segment string
+	access  string
+}
+
+// lintResource groups the exact and prefix rules for one resource type.
+type lintResource struct {
+	exactName  string
+	prefixName string
+	exact      []lintRule
+	prefix     []lintRule
+}
+
+// LintPolicy parses the given rules with the same parser used when creating
+// a policy and returns any findings. An error is returned only when the rules
+// could not be parsed or are invalid.
+func LintPolicy(rules string, conf *Config, meta *EnterprisePolicyMeta) ([]PolicyLintFinding, error) {
+	if rules == "" {
+		return nil, nil
+	}
+
+	p, err := decodeRules(rules, conf, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []PolicyLintFinding
+
+	// Validate zeroes out the deprecated rules so they have to be inspected
+	// beforehand.
+	for _, r := range p.Identities {
+		findings = append(findings, PolicyLintFinding{
+			Code:     LintDeprecatedSyntax,
+			Resource: "identity",
+			Segment:  r.Name,
+			Message:  fmt.Sprintf("identity %q is deprecated and ignored; use a service rule instead", r.Name),
+		})
+	}
+	for _, r := range p.IdentityPrefixes {
+		findings = append(findings, PolicyLintFinding{
+			Code:     LintDeprecatedSyntax,
+			Resource: "identity_prefix",
+			Segment:  r.Name,
+			Message:  fmt.Sprintf("identity_prefix %q is deprecated and ignored; use a service_prefix rule instead", r.Name),
+		})
+	}
+
+	if err := p.PolicyRules.Validate(conf); err != nil {
+		return nil, err
+	}
+	if err := p.EnterprisePolicyRules.Validate(conf); err != nil {
+		return nil, err
+	}
+
+	for _, res := range p.PolicyRules.lintResources() {
+		findings = append(findings, res.lint()...)
+	}
+
+	return findings, nil
+}
+
+func (pr *PolicyRules) lintResources() []*lintResource {
+	agents := &lintResource{exactName: "agent", prefixName: "agent_prefix"}
+	for _, r := range pr.Agents {
+		agents.exact = append(agents.exact, lintRule{r.Node, r.Policy})
+	}
+	for _, r := range pr.AgentPrefixes {
+		agents.prefix = append(agents.prefix, lintRule{r.Node, r.Policy})
+	}
+
+	keys := &lintResource{exactName: "key", prefixName: "key_prefix"}
+	for _, r := range pr.Keys {
+		keys.exact = append(keys.exact, lintRule{r.Prefix, r.Policy})
+	}
+	for _, r := range pr.KeyPrefixes {
+		keys.prefix = append(keys.prefix, lintRule{r.Prefix, r.Policy})
+	}
+
+	nodes := &lintResource{exactName: "node", prefixName: "node_prefix"}
+	for _, r := range pr.Nodes {
+		nodes.exact = append(nodes.exact, lintRule{r.Name, r.Policy})
+	}
+	for _, r := range pr.NodePrefixes {
+		nodes.prefix = append(nodes.prefix, lintRule{r.Name, r.Policy})
+	}
+
+	// The intentions policy is folded into the access so that rules only
+	// compare equal when both the service and intentions access match.
+	services := &lintResource{exactName: "service", prefixName: "service_prefix"}
+	for _, r := range pr.Services {
+		services.exact = append(services.exact, lintRule{r.Name, serviceLintAccess(r)})
+	}
+	for _, r := range pr.ServicePrefixes {
+		services.prefix = append(services.prefix, lintRule{r.Name, serviceLintAccess(r)})
+	}
+
+	sessions := &lintResource{exactName: "session", prefixName: "session_prefix"}
+	for _, r := range pr.Sessions {
+		sessions.exact = append(sessions.exact, lintRule{r.Node, r.Policy})
+	}
+	for _, r := range pr.SessionPrefixes {
+		sessions.prefix = append(sessions.prefix, lintRule{r.Node, r.Policy})
+	}
+
+	events := &lintResource{exactName: "event", prefixName: "event_prefix"}
+	for _, r := range pr.Events {
+		events.exact = append(events.exact, lintRule{r.Event, r.Policy})
+	}
+	for _, r := range pr.EventPrefixes {
+		events.prefix = append(events.prefix, lintRule{r.Event, r.Policy})
+	}
+
+	queries := &lintResource{exactName: "query", prefixName: "query_prefix"}
+	for _, r := range pr.PreparedQueries {
+		queries.exact = append(queries.exact, lintRule{r.Prefix, r.Policy})
+	}
+	for _, r := range pr.PreparedQueryPrefixes {
+		queries.prefix = append(queries.prefix, lintRule{r.Prefix, r.Policy})
+	}
+
+	return []*lintResource{agents, keys, nodes, services, sessions, events, queries}
+}
+
+func serviceLintAccess(r *ServiceRule) string {
+	if r.Intentions == "" {
+		return r.Policy
+	}
+	return r.Policy + " (intentions " + r.Intentions + ")"
+}
+
+func (res *lintResource) lint() []PolicyLintFinding {
+	var findings []PolicyLintFinding
+
+	exact, dups := dedupeLintRules(res.exactName, res.exact)
+	findings = append(findings, dups...)
+	prefix, dups := dedupeLintRules(res.prefixName, res.prefix)
+	findings = append(findings, dups...)
+
+	// Walk prefixes from shortest to longest so the enclosing prefix of a
+	// rule is always known by the time the rule itself is checked.
+	sort.Slice(prefix, func(i, j int) bool {
+		return prefix[i].segment < prefix[j].segment
+	})
+
+	for i, r := range prefix {
+		if r.segment == "" && lintPolicyOf(r.access) == PolicyWrite {
+			findings = append(findings, PolicyLintFinding{
+				Code:     LintBroadWrite,
+				Resource: res.prefixName,
+				Segment:  r.segment,
+				Message:  fmt.Sprintf(`%s "" grants write on every %s; consider scoping it to a narrower prefix`, res.prefixName, res.exactName),
+			})
+		}
+
+		if parent, ok := longestLintPrefix(prefix[:i], r.segment); ok && parent.access == r.access {
+			findings = append(findings, PolicyLintFinding{
+				Code:     LintRedundantPrefix,
+				Resource: res.prefixName,
+				Segment:  r.segment,
+				Message: fmt.Sprintf("%s %q grants the same %q access as %s %q and can be removed",
+					res.prefixName, r.segment, r.access, res.prefixName, parent.segment),
+			})
+		}
+	}
+
+	for _, r := range exact {
+		if parent, ok := longestLintPrefix(prefix, r.segment); ok && parent.access == r.access {
+			findings = append(findings, PolicyLintFinding{
+				Code:     LintShadowedExact,
+				Resource: res.exactName,
+				Segment:  r.segment,
+				Message: fmt.Sprintf("%s %q is shadowed by %s %q which already grants %q access",
+					res.exactName, r.segment, res.prefixName, parent.segment, r.access),
+			})
+		}
+	}
+
+	return findings
+}
+
+// dedupeLintRules collapses rules declared for the same segment into the one
+// that takes precedence and reports the others as unreachable.
+func dedupeLintRules(resource string, rules []lintRule) ([]lintRule, []PolicyLintFinding) {
+	var findings []PolicyLintFinding
+	bySegment := make(map[string]lintRule, len(rules))
+	var out []lintRule
+
+	for _, r := range rules {
+		existing, ok := bySegment[r.segment]
+		if !ok {
+			bySegment[r.segment] = r
+			out = append(out, r)
+			continue
+		}
+
+		winner, loser := existing, r
+		if takesPrecedenceOver(lintPolicyOf(r.access), lintPolicyOf(existing.access)) {
+			winner, loser = r, existing
+		}
+		bySegment[r.segment] = winner
+		for i := range out {
+			if out[i].segment == r.segment {
+				out[i] = winner
+			}
+		}
+
+		findings = append(findings, PolicyLintFinding{
+			Code:     LintDuplicateRule,
+			Resource: resource,
+			Segment:  r.segment,
+			Message: fmt.Sprintf("%s %q is declared more than once; %q access is never applied because %q takes precedence",
+				resource, r.segment, loser.access, winner.access),
+		})
+	}
+
+	return out, findings
+}
+
+// lintPolicyOf strips the intentions annotation added by serviceLintAccess.
+func lintPolicyOf(access string) string {
+	policy, _, _ := strings.Cut(access, " ")
+	return policy
+}
+
+// longestLintPrefix returns the longest prefix rule that matches segment.
+func longestLintPrefix(prefixes []lintRule, segment string) (lintRule, bool) {
+	var (
+		found lintRule
+		ok    bool
+	)
+	for _, p := range prefixes {
+		if !strings.HasPrefix(segment, p.segment) {
+			continue
+		}
+		if !ok || len(p.segment) > len(found.segment) {
+			found, ok = p, true
+		}
+	}
+	return found, ok
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package acl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintPolicy(t *testing.T) {
+	type finding struct {
+		Code     string
+		Resource string
+		Segment  string
+	}
+
+	cases := []struct {
+		Name     string
+		Rules    string
+		Expected []finding
+		Err      string
+	}{
+		{
+			Name: "Clean",
+			Rules: `
+				key_prefix "" {
+					policy = "read"
+				}
+				key_prefix "app/" {
+					policy = "write"
+				}
+				service "web" {
+					policy = "write"
+				}
+			`,
+		},
+		{
+			Name: "Redundant prefix",
+			Rules: `
+				key_prefix "app/" {
+					policy = "write"
+				}
+				key_prefix "app/config/" {
+					policy = "write"
+				}
+				key_prefix "app/config/secret/" {
+					policy = "deny"
+				}
+			`,
+			Expected: []finding{
+				{LintRedundantPrefix, "key_prefix", "app/config/"},
+			},
+		},
+		{
+			Name: "Shadowed exact",
+			Rules: `
+				node_prefix "web-" {
+					policy = "read"
+				}
+				node "web-1" {
+					policy = "read"
+				}
+				node "web-2" {
+					policy = "write"
+				}
+			`,
+			Expected: []finding{
+				{LintShadowedExact, "node", "web-1"},
+			},
+		},
+		{
+			Name: "Service intentions are compared",
+			Rules: `
+				service_prefix "" {
+					policy = "read"
+				}
+				service "api" {
+					policy = "read"
+					intentions = "write"
+				}
+			`,
+		},
+		{
+			Name: "Duplicate rule",
+			Rules: `
+				event "deploy" {
+					policy = "write"
+				}
+				event "deploy" {
+					policy = "deny"
+				}
+			`,
+			Expected: []finding{
+				{LintDuplicateRule, "event", "deploy"},
+			},
+		},
+		{
+			Name: "Broad write",
+			Rules: `
+				service_prefix "" {
+					policy = "write"
+					intentions = "read"
+				}
+				session_prefix "" {
+					policy = "write"
+				}
+			`,
+			Expected: []finding{
+				{LintBroadWrite, "service_prefix", ""},
+				{LintBroadWrite, "session_prefix", ""},
+			},
+		},
+		{
+			Name: "Deprecated identity",
+			Rules: `
+				identity "web" {
+					policy = "write"
+				}
+				identity_prefix "" {
+					policy = "read"
+				}
+			`,
+			Expected: []finding{
+				{LintDeprecatedSyntax, "identity", "web"},
+				{LintDeprecatedSyntax, "identity_prefix", ""},
+			},
+		},
+		{
+			Name: "Invalid policy",
+			Rules: `
+				key "foo" {
+					policy = "nope"
+				}
+			`,
+			Err: "Invalid key policy",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			findings, err := LintPolicy(tc.Rules, nil, nil)
+			if tc.Err != "" {
+				errStartsWith(t, err, tc.Err)
+				return
+			}
+			require.NoError(t, err)
+
+			var actual []finding
+			for _, f := range findings {
+				require.NotEmpty(t, f.Message)
+				actual = append(actual, finding{f.Code, f.Resource, f.Segment})
+			}
+			require.Equal(t, tc.Expected, actual)
+		})
+	}
+}
@@ -270,6 +270,47 @@ func (s *HTTPHandlers) ACLPolicyDelete(resp http.ResponseWriter, req *http.Reque
 	return true, nil
 }
 
+// aclPolicyLintBody is the payload accepted by the policy lint endpoint.
+type aclPolicyLintBody struct {
+	Rules string
+}
+
+func (s *HTTPHandlers) ACLPolicyLint(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	if s.checkACLDisabled() {
+		return nil, aclDisabled
+	}
+
+	args := structs.ACLPolicyLintRequest{
+		Datacenter: s.agent.config.Datacenter,
+	}
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	if err := s.parseEntMeta(req, &args.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+
+	var body aclPolicyLintBody
+	if err := decodeBody(req.Body, &body); err != nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Policy decoding failed: %v", err)}
+	}
+	args.Rules = body.Rules
+
+	var out structs.ACLPolicyLintResponse
+	defer setMeta(resp, &out.QueryMeta)
+	if err := s.agent.RPC(req.Context(), "ACL.PolicyLint", &args, &out); err != nil {
+		return nil, err
+	}
+
+	// Always return a list, even when there is nothing to report.
+	if out.Findings == nil {
+		out.Findings = make([]acl.PolicyLintFinding, 0)
+	}
+
+	return out.Findings, nil
+}
+
 func (s *HTTPHandlers) ACLTokenList(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	if s.checkACLDisabled() {
 		return nil, aclDisabled
@@ -421,6 +421,24 @@ func TestACL_HTTP(t *testing.T) {
 			require.True(t, isHTTPBadRequest(err))
 		})
 
+		t.Run("Lint", func(t *testing.T) {
+			body := map[string]string{
+				"Rules": `node_prefix "" { policy = "write" }`,
+			}
+
+			req, _ := http.NewRequest("POST", "/v1/acl/policy/lint", jsonBody(body))
+			req.Header.Add("X-Consul-Token", "root")
+			resp := httptest.NewRecorder()
+			obj, err := a.srv.ACLPolicyLint(resp, req)
+			require.NoError(t, err)
+
+			findings, ok := obj.([]acl.PolicyLintFinding)
+			require.True(t, ok)
+			require.Len(t, findings, 1)
+			require.Equal(t, acl.LintBroadWrite, findings[0].Code)
+			require.Equal(t, "node_prefix", findings[0].Resource)
+		})
+
 		t.Run("Delete", func(t *testing.T) {
 			req, _ := http.NewRequest("DELETE", "/v1/acl/policy/"+idMap["policy-minimal"], nil)
 			req.Header.Add("X-Consul-Token", "root")
@@ -49,6 +49,7 @@ import (
 	rpcRate "github.com/hashicorp/consul/agent/consul/rate"
 	"github.com/hashicorp/consul/agent/consul/servercert"
 	external "github.com/hashicorp/consul/agent/grpc-external"
+	"github.com/hashicorp/consul/agent/grpc-external/services/accesslogs"
 	grpcDNS "github.com/hashicorp/consul/agent/grpc-external/services/dns"
 	middleware "github.com/hashicorp/consul/agent/grpc-middleware"
 	"github.com/hashicorp/consul/agent/hcp/scada"
@@ -113,6 +114,10 @@ const (
 	// defaultQueryTime is the amount of time we block waiting for a change
 	// if no time is specified. Previously we would wait the maxQueryTime.
 	defaultQueryTime = 300 * time.Second
+
+	// proxyHealthCheckTimeout bounds the catalog writes that report the result
+	// of a health check performed by a proxy.
+	proxyHealthCheckTimeout = 30 * time.Second
 )
 
 var (
@@ -431,6 +436,10 @@ type Agent struct {
 	// xdsServer serves the XDS protocol for configuring Envoy proxies.
 	xdsServer *xds.Server
 
+	// intentionDenials buffers the intention denials observed by local
+	// proxies until they are reported to the servers.
+	intentionDenials *intentionDenialReporter
+
 	// scadaProvider is set when HashiCorp Cloud Platform integration is configured and exposes the agent's API over
 	// an encrypted session to HCP
 	scadaProvider scada.Provider
@@ -492,6 +501,8 @@ func New(bd BaseDeps) (*Agent, error) {
 		leafCertManager: bd.LeafCertManager,
 		routineManager:  routine.NewManager(bd.Logger),
 		scadaProvider:   bd.HCP.Provider,
+
+		intentionDenials: newIntentionDenialReporter(maxPendingIntentionDenials),
 	}
 
 	// TODO: create rpcClientHealth in BaseDeps once NetRPC is available without Agent
@@ -849,6 +860,11 @@ func (a *Agent) Start(ctx context.Context) error {
 		go a.sendCoordinate()
 	}
 
+	// Start reporting the intention denials observed by local proxies.
+	if a.config.ConnectEnabled {
+		go a.reportIntentionDenials()
+	}
+
 	// Write out the PID file if necessary.
 	if err := a.storePid(); err != nil {
 		return err
@@ -961,6 +977,7 @@ func (a *Agent) configureXDSServer(proxyWatcher xds.ProxyWatcher, server *consul
 			return a.delegate.ResolveTokenAndDefaultMeta(id, nil, nil)
 		},
 		a,
+		a,
 	)
 	a.xdsServer.Register(a.externalGRPCServer)
 }
@@ -973,6 +990,14 @@ func (a *Agent) listenAndServeGRPC(server *consul.Server) error {
 
 	a.configureXDSServer(proxyWatcher, server)
 
+	if a.config.ConnectEnabled {
+		accesslogs.NewServer(accesslogs.Config{
+			Logger:       a.logger.Named("grpc-api.access-logs"),
+			GetProxy:     a.localConnectProxy,
+			RecordDenial: a.intentionDenials.Record,
+		}).Register(a.externalGRPCServer)
+	}
+
 	// Attempt to spawn listeners
 	var listeners []net.Listener
 	start := func(port_name string, addrs []net.Addr, protocol middleware.Protocol) error {
@@ -3408,6 +3433,58 @@ func (a *Agent) AdvertiseAddrLAN() string {
 	return a.config.AdvertiseAddrLAN.String()
 }
 
+// UpdateProxyHealthCheck registers or updates a check that reports the result
+// of a health check performed by a proxy, such as a terminating gateway
+// checking its linked services. The check is registered in the catalog
+// directly since the checked service instance is usually not registered with
+// this agent.
+func (a *Agent) UpdateProxyHealthCheck(check *structs.HealthCheck, token string) error {
+	req := structs.RegisterRequest{
+		Datacenter:     a.config.Datacenter,
+		Node:           check.Node,
+		SkipNodeUpdate: true,
+		Checks:         structs.HealthChecks{check},
+		EnterpriseMeta: *structs.NodeEnterpriseMetaInPartition(check.PartitionOrDefault()),
+		WriteRequest:   structs.WriteRequest{Token: token},
+	}
+	return a.proxyHealthCheckRPC("Catalog.Register", &req)
+}
+
+// RemoveProxyHealthCheck deregisters a check that was registered with
+// UpdateProxyHealthCheck.
+func (a *Agent) RemoveProxyHealthCheck(check *structs.HealthCheck, token string) error {
+	req := structs.DeregisterRequest{
+		Datacenter:     a.config.Datacenter,
+		Node:           check.Node,
+		CheckID:        check.CheckID,
+		EnterpriseMeta: check.EnterpriseMeta,
+		WriteRequest:   structs.WriteRequest{Token: token},
+	}
+	return a.proxyHealthCheckRPC("Catalog.Deregister", &req)
+}
+
+// proxyHealthCheckRPC makes a catalog write for UpdateProxyHealthCheck or
+// RemoveProxyHealthCheck. RPCs are not interrupted when their context is
+// done, so the RPC is made in the background to keep an unresponsive server
+// from blocking the proxy stream that reports the check.
+func (a *Agent) proxyHealthCheckRPC(method string, args interface{}) error {
+	ctx, cancel := context.WithTimeout(&lib.StopChannelContext{StopCh: a.shutdownCh}, proxyHealthCheckTimeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		var out struct{}
+		errCh <- a.RPC(ctx, method, args, &out)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("%s did not complete: %w", method, ctx.Err())
+	}
+}
+
 func (a *Agent) cancelCheckMonitors(checkID structs.CheckID) {
 	// Stop any monitors
 	delete(a.checkReapAfter, checkID)
@@ -1746,10 +1746,18 @@ func (s *HTTPHandlers) AgentConnectAuthorize(resp http.ResponseWriter, req *http
 	}
 
 	var (
-		authorized bool
-		reason     string
+		authorized   bool
+		reason       string
+		defaultAllow bool
 	)
 
+	if s.agent.config.DefaultIntentionPolicy != "" {
+		defaultAllow = s.agent.config.DefaultIntentionPolicy == structs.IntentionDefaultPolicyAllow
+	} else {
+		//nolint:staticcheck
+		defaultAllow = authz.IntentionDefaultAllow(nil) == acl.Allow
+	}
+
 	if ixnMatch != nil {
 		if len(ixnMatch.Permissions) == 0 {
 			// This is an L4 intention.
@@ -1762,26 +1770,47 @@ func (s *HTTPHandlers) AgentConnectAuthorize(resp http.ResponseWriter, req *http
 		}
 	} else if s.agent.config.DefaultIntentionPolicy != "" {
 		reason = "Default intention policy"
-		authorized = s.agent.config.DefaultIntentionPolicy == structs.IntentionDefaultPolicyAllow
+		authorized = defaultAllow
 	} else {
 		reason = "Default behavior configured by ACLs"
-		//nolint:staticcheck
-		authorized = authz.IntentionDefaultAllow(nil) == acl.Allow
+		authorized = defaultAllow
+	}
+
+	// Record denials decided by an L4 intention or the default policy so they
+	// show up in /v1/connect/intentions/denials. Denials of L7 intentions are
+	// not recorded because this endpoint cannot evaluate their permissions;
+	// callers that do report those themselves.
+	if !authorized && (ixnMatch == nil || len(ixnMatch.Permissions) == 0) {
+		srcEntMeta := uriService.GetEnterpriseMeta()
+		denial := structs.IntentionDenial{
+			SourceName:           uriService.Service,
+			SourceNS:             srcEntMeta.NamespaceOrEmpty(),
+			SourcePartition:      srcEntMeta.PartitionOrEmpty(),
+			DestinationName:      authReq.Target,
+			DestinationNS:        authReq.TargetNamespace(),
+			DestinationPartition: authReq.TargetPartition(),
+		}
+		if ixnMatch != nil {
+			denial.PolicyID = structs.IntentionDenyPolicyID(ixnMatch.SourceServiceName(), ixnMatch.SourcePeer)
+		}
+		s.agent.intentionDenials.Record(token, denial)
 	}
 
 	setCacheMeta(resp, &meta)
 
 	return &connectAuthorizeResp{
-		Authorized: authorized,
-		Reason:     reason,
+		Authorized:   authorized,
+		Reason:       reason,
+		DefaultAllow: defaultAllow,
 	}, nil
 }
 
 // connectAuthorizeResp is the response format/structure for the
 // /v1/agent/connect/authorize endpoint.
 type connectAuthorizeResp struct {
-	Authorized bool   // True if authorized, false if not
-	Reason     string // Reason for the Authorized value (whether true or false)
+	Authorized   bool   // True if authorized, false if not
+	Reason       string // Reason for the Authorized value (whether true or false)
+	DefaultAllow bool   // True if the default intention policy allows traffic
 }
 
 // AgentHost
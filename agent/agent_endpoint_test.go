@@ -7811,6 +7811,17 @@ func TestAgentConnectAuthorize_deny(t *testing.T) {
 	require.NoError(t, dec.Decode(obj))
 	assert.False(t, obj.Authorized)
 	assert.Contains(t, obj.Reason, "Matched")
+
+	// The denial is reported to the servers.
+	a.flushIntentionDenials()
+
+	var denials structs.IndexedIntentionDenials
+	require.NoError(t, a.RPC(context.Background(), "Intention.Denials", &structs.IntentionDenialsRequest{Datacenter: "dc1"}, &denials))
+	require.Len(t, denials.Denials, 1)
+	require.Equal(t, "web", denials.Denials[0].SourceName)
+	require.Equal(t, target, denials.Denials[0].DestinationName)
+	require.Equal(t, "web", denials.Denials[0].Intention)
+	require.Equal(t, uint64(1), denials.Denials[0].Count)
 }
 
 // Test when there is an intention allowing service with a different trust
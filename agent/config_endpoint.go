@@ -157,6 +157,19 @@ func (s *HTTPHandlers) ConfigApply(resp http.ResponseWriter, req *http.Request)
 	}
 	args.Entry.GetEnterpriseMeta().Merge(&meta)
 
+	// A dry run only evaluates the entry and reports its effect.
+	if _, ok := req.URL.Query()["dry-run"]; ok {
+		if args.Entry.GetKind() != structs.ServiceIntentions {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("dry-run is only supported for %s config entries", structs.ServiceIntentions)}
+		}
+
+		var reply structs.IntentionDryRunResponse
+		if err := s.agent.RPC(req.Context(), "ConfigEntry.ApplyDryRun", &args, &reply); err != nil {
+			return nil, err
+		}
+		return reply, nil
+	}
+
 	// Check for cas value
 	if casStr := req.URL.Query().Get("cas"); casStr != "" {
 		casVal, err := strconv.ParseUint(casStr, 10, 64)
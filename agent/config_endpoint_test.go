@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
 	"github.com/hashicorp/consul/testrpc"
 )
 
@@ -324,6 +325,81 @@ func TestConfig_Apply(t *testing.T) {
 	}
 }
 
+func TestConfig_Apply_DryRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	for _, svc := range []string{"web", "db"} {
+		var out struct{}
+		require.NoError(t, a.RPC(context.Background(), "Catalog.Register", &structs.RegisterRequest{
+			Datacenter: "dc1",
+			Node:       "foo",
+			Address:    "127.0.0.1",
+			Service:    &structs.NodeService{Service: svc},
+		}, &out))
+	}
+
+	t.Run("service-intentions", func(t *testing.T) {
+		retry.Run(t, func(r *retry.R) {
+			body := bytes.NewBuffer([]byte(`
+			{
+				"Kind": "service-intentions",
+				"Name": "db",
+				"Sources": [
+					{
+						"Name": "web",
+						"Action": "deny"
+					}
+				]
+			}`))
+
+			req, _ := http.NewRequest("PUT", "/v1/config?dry-run", body)
+			resp := httptest.NewRecorder()
+			obj, err := a.srv.ConfigApply(resp, req)
+			require.NoError(r, err)
+
+			out, ok := obj.(structs.IntentionDryRunResponse)
+			require.True(r, ok)
+			require.Len(r, out.Changes, 1)
+			require.Equal(r, "web", out.Changes[0].SourceName)
+			require.Equal(r, "db", out.Changes[0].DestinationName)
+			require.True(r, out.Changes[0].Before.Allowed)
+			require.False(r, out.Changes[0].After.Allowed)
+		})
+
+		// Nothing should have been written.
+		args := structs.ConfigEntryQuery{
+			Kind:       structs.ServiceIntentions,
+			Name:       "db",
+			Datacenter: "dc1",
+		}
+		var out structs.ConfigEntryResponse
+		require.NoError(t, a.RPC(context.Background(), "ConfigEntry.Get", &args, &out))
+		require.Nil(t, out.Entry)
+	})
+
+	t.Run("other kinds", func(t *testing.T) {
+		body := bytes.NewBuffer([]byte(`
+		{
+			"Kind": "service-defaults",
+			"Name": "db"
+		}`))
+
+		req, _ := http.NewRequest("PUT", "/v1/config?dry-run", body)
+		resp := httptest.NewRecorder()
+		_, err := a.srv.ConfigApply(resp, req)
+		require.Error(t, err)
+		require.True(t, isHTTPBadRequest(err))
+	})
+}
+
 func TestConfig_Apply_TerminatingGateway(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
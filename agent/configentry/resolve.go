@@ -141,6 +141,12 @@ func ComputeResolvedServiceConfig(
 		if serviceConf.LocalRequestTimeoutMs > 0 {
 			proxyConf["local_request_timeout_ms"] = serviceConf.LocalRequestTimeoutMs
 		}
+		if serviceConf.FaultInjection != nil {
+			proxyConf["fault_injection"] = serviceConf.FaultInjection
+		}
+		if serviceConf.RateLimit != nil {
+			proxyConf["rate_limit"] = serviceConf.RateLimit
+		}
 		// Add the proxy conf to the response if any fields were populated
 		if len(proxyConf) > 0 {
 			thisReply.ProxyConfig = proxyConf
@@ -106,6 +106,50 @@ func Test_ComputeResolvedServiceConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "proxy with fault_injection",
+			args: args{
+				scReq: &structs.ServiceConfigRequest{
+					Name: "sid",
+				},
+				entries: &ResolvedServiceConfigSet{
+					ServiceDefaults: map[structs.ServiceID]*structs.ServiceConfigEntry{
+						sid: {
+							FaultInjection: &structs.FaultInjection{
+								Abort: &structs.FaultInjectionAbort{HTTPStatus: 503},
+							},
+						},
+					},
+				},
+			},
+			want: &structs.ServiceConfigResponse{
+				ProxyConfig: map[string]interface{}{
+					"fault_injection": &structs.FaultInjection{
+						Abort: &structs.FaultInjectionAbort{HTTPStatus: 503},
+					},
+				},
+			},
+		},
+		{
+			name: "proxy with rate_limit",
+			args: args{
+				scReq: &structs.ServiceConfigRequest{
+					Name: "sid",
+				},
+				entries: &ResolvedServiceConfigSet{
+					ServiceDefaults: map[structs.ServiceID]*structs.ServiceConfigEntry{
+						sid: {
+							RateLimit: &structs.LocalRateLimit{RequestsPerSecond: 100},
+						},
+					},
+				},
+			},
+			want: &structs.ServiceConfigResponse{
+				ProxyConfig: map[string]interface{}{
+					"rate_limit": &structs.LocalRateLimit{RequestsPerSecond: 100},
+				},
+			},
+		},
 		{
 			name: "proxy upstream mesh-gateway inherits proxy-defaults",
 			args: args{
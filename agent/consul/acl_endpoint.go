@@ -1149,6 +1149,39 @@ func (a *ACL) PolicyResolve(args *structs.ACLPolicyBatchGetRequest, reply *struc
 	return nil
 }
 
+// PolicyLint parses the given policy rules and reports rules that are
+// redundant, unreachable or overly broad. Nothing is persisted.
+func (a *ACL) PolicyLint(args *structs.ACLPolicyLintRequest, reply *structs.ACLPolicyLintResponse) error {
+	if err := a.aclPreCheck(); err != nil {
+		return err
+	}
+
+	if err := a.srv.validateEnterpriseRequest(&args.EnterpriseMeta, false); err != nil {
+		return err
+	}
+
+	if done, err := a.srv.ForwardRPC("ACL.PolicyLint", args, reply); done {
+		return err
+	}
+
+	var authzContext acl.AuthorizerContext
+	if authz, err := a.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, &authzContext); err != nil {
+		return err
+	} else if err := authz.ToAllowAuthorizer().ACLReadAllowed(&authzContext); err != nil {
+		return err
+	}
+
+	findings, err := acl.LintPolicy(args.Rules, a.srv.aclConfig, args.EnterprisePolicyMeta())
+	if err != nil {
+		return err
+	}
+
+	reply.Findings = findings
+	a.srv.SetQueryMeta(&reply.QueryMeta, args.Token)
+
+	return nil
+}
+
 // ReplicationStatus is used to retrieve the current ACL replication status.
 func (a *ACL) ReplicationStatus(args *structs.DCSpecificRequest,
 	reply *structs.ACLReplicationStatus) error {
@@ -2463,6 +2463,56 @@ func TestACLEndpoint_PolicyResolve(t *testing.T) {
 	require.ElementsMatch(t, gatherIDs(t, resp.Policies), policies)
 }
 
+func TestACLEndpoint_PolicyLint(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	_, srv, _ := testACLServerWithConfig(t, nil, false)
+	waitForLeaderEstablishment(t, srv)
+
+	aclEp := ACL{srv: srv}
+
+	t.Run("findings", func(t *testing.T) {
+		req := structs.ACLPolicyLintRequest{
+			Datacenter:   "dc1",
+			Rules:        `key_prefix "" { policy = "write" } key "foo" { policy = "write" }`,
+			QueryOptions: structs.QueryOptions{Token: TestDefaultInitialManagementToken},
+		}
+		resp := structs.ACLPolicyLintResponse{}
+
+		require.NoError(t, aclEp.PolicyLint(&req, &resp))
+		require.Len(t, resp.Findings, 2)
+		require.Equal(t, acl.LintBroadWrite, resp.Findings[0].Code)
+		require.Equal(t, acl.LintShadowedExact, resp.Findings[1].Code)
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		req := structs.ACLPolicyLintRequest{
+			Datacenter:   "dc1",
+			Rules:        `key "foo" { policy = "nope" }`,
+			QueryOptions: structs.QueryOptions{Token: TestDefaultInitialManagementToken},
+		}
+		resp := structs.ACLPolicyLintResponse{}
+
+		err := aclEp.PolicyLint(&req, &resp)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Invalid key policy")
+	})
+
+	t.Run("requires acl read", func(t *testing.T) {
+		req := structs.ACLPolicyLintRequest{
+			Datacenter: "dc1",
+			Rules:      `key "foo" { policy = "read" }`,
+		}
+		resp := structs.ACLPolicyLintResponse{}
+
+		err := aclEp.PolicyLint(&req, &resp)
+		require.True(t, acl.IsErrPermissionDenied(err), "unexpected error: %v", err)
+	})
+}
+
 func TestACLEndpoint_RoleRead(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -68,7 +68,7 @@ func (c *ConfigEntry) Apply(args *structs.ConfigEntryRequest, reply *bool) error
 
 	// Ensure that all config entry writes go to the primary datacenter. These will then
 	// be replicated to all the other datacenters.
-	args.Datacenter = c.srv.config.PrimaryDatacenter
+	args.Datacenter = c.writeDatacenter(args.Datacenter, args.Entry.GetKind())
 
 	if done, err := c.srv.ForwardRPC("ConfigEntry.Apply", args, reply); done {
 		return err
@@ -128,6 +128,58 @@ func (c *ConfigEntry) Apply(args *structs.ConfigEntryRequest, reply *bool) error
 	return nil
 }
 
+// ApplyDryRun validates the given service-intentions config entry as Apply
+// would and reports which source and destination pairs in the catalog would
+// change between allow and deny if it were applied. Nothing is written.
+func (c *ConfigEntry) ApplyDryRun(args *structs.ConfigEntryRequest, reply *structs.IntentionDryRunResponse) error {
+	if err := c.srv.validateEnterpriseRequest(args.Entry.GetEnterpriseMeta(), true); err != nil {
+		return err
+	}
+
+	// The decisions are evaluated against the catalog of the requested
+	// datacenter, so unlike Apply this is not forwarded to the primary.
+	if done, err := c.srv.ForwardRPC("ConfigEntry.ApplyDryRun", args, reply); done {
+		return err
+	}
+
+	entry, ok := args.Entry.(*structs.ServiceIntentionsConfigEntry)
+	if !ok {
+		return fmt.Errorf("dry run is only supported for %s config entries, got %q", structs.ServiceIntentions, args.Entry.GetKind())
+	}
+
+	authz, err := c.srv.ResolveTokenAndDefaultMeta(args.Token, entry.GetEnterpriseMeta(), nil)
+	if err != nil {
+		return err
+	}
+
+	if err := c.preflightCheck(entry.GetKind()); err != nil {
+		return err
+	}
+
+	if err := entry.Normalize(); err != nil {
+		return err
+	}
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+
+	// Previewing a change reveals as much as making it, so require the same
+	// permissions.
+	if err := entry.CanWrite(authz); err != nil {
+		return err
+	}
+
+	defaultAllow := DefaultIntentionAllow(authz, c.srv.config.DefaultIntentionPolicy)
+
+	_, resp, err := c.srv.fsm.State().IntentionDryRun(nil, entry, defaultAllow)
+	if err != nil {
+		return err
+	}
+	*reply = *resp
+
+	return nil
+}
+
 // shouldSkipOperation returns true if the result of the operation has
 // already happened and is safe to skip.
 //
@@ -404,7 +456,7 @@ func (c *ConfigEntry) Delete(args *structs.ConfigEntryRequest, reply *structs.Co
 
 	// Ensure that all config entry writes go to the primary datacenter. These will then
 	// be replicated to all the other datacenters.
-	args.Datacenter = c.srv.config.PrimaryDatacenter
+	args.Datacenter = c.writeDatacenter(args.Datacenter, args.Entry.GetKind())
 
 	if done, err := c.srv.ForwardRPC("ConfigEntry.Delete", args, reply); done {
 		return err
@@ -541,10 +593,27 @@ func (c *ConfigEntry) ResolveServiceConfig(args *structs.ServiceConfigRequest, r
 		})
 }
 
+// writeDatacenter returns the datacenter that a write to a config entry of the
+// given kind is applied in. Imported-services entries are keyed by the name of a
+// peering, and peerings are local to a datacenter, so they are written to the
+// targeted datacenter and are not replicated. All other kinds are written to the
+// primary datacenter.
+func (c *ConfigEntry) writeDatacenter(targetDC, kind string) string {
+	if kind != structs.ImportedServices {
+		return c.srv.config.PrimaryDatacenter
+	}
+	if targetDC == "" {
+		return c.srv.config.Datacenter
+	}
+	return targetDC
+}
+
 func gateWriteToSecondary(targetDC, localDC, primaryDC, kind string) error {
 	// ExportedServices entries are gated from interactions from secondary DCs
 	// because non-default partitions cannot be created in secondaries
 	// and services cannot be exported to another datacenter.
+	// ImportedServices entries are not gated because they apply to the peerings
+	// of the datacenter they are written in.
 	if kind != structs.ExportedServices {
 		return nil
 	}
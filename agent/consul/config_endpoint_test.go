@@ -197,6 +197,90 @@ func TestConfigEntry_ProxyDefaultsMeshGateway(t *testing.T) {
 	require.Equal(t, structs.MeshGatewayModeLocal, proxyConf.MeshGateway.Mode)
 }
 
+func TestConfigEntry_ApplyDryRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	dir1, s1 := testServer(t)
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	codec := rpcClient(t, s1)
+	defer codec.Close()
+
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	state := s1.fsm.State()
+	require.NoError(t, state.EnsureNode(1, &structs.Node{Node: "foo", Address: "127.0.0.1"}))
+	for i, svc := range []string{"api", "web", "db"} {
+		require.NoError(t, state.EnsureService(uint64(2+i), "foo", &structs.NodeService{ID: svc, Service: svc}))
+	}
+
+	current := &structs.ServiceIntentionsConfigEntry{
+		Kind: structs.ServiceIntentions,
+		Name: "db",
+		Sources: []*structs.SourceIntention{
+			{Name: "api", Action: structs.IntentionActionAllow},
+			{Name: "web", Action: structs.IntentionActionAllow},
+		},
+	}
+	retry.Run(t, func(r *retry.R) {
+		// Intentions may not be migrated to config entries right away.
+		var out bool
+		require.NoError(r, msgpackrpc.CallWithCodec(codec, "ConfigEntry.Apply", &structs.ConfigEntryRequest{
+			Datacenter: "dc1",
+			Entry:      current,
+		}, &out))
+		require.True(r, out)
+	})
+
+	testutil.RunStep(t, "preview a deny", func(t *testing.T) {
+		args := structs.ConfigEntryRequest{
+			Datacenter: "dc1",
+			Entry: &structs.ServiceIntentionsConfigEntry{
+				Kind: structs.ServiceIntentions,
+				Name: "db",
+				Sources: []*structs.SourceIntention{
+					{Name: "api", Action: structs.IntentionActionAllow},
+					{Name: "web", Action: structs.IntentionActionDeny},
+				},
+			},
+		}
+		var out structs.IntentionDryRunResponse
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "ConfigEntry.ApplyDryRun", &args, &out))
+
+		require.Equal(t, 2, out.Evaluated)
+		require.Len(t, out.Changes, 1)
+		require.Equal(t, "web", out.Changes[0].SourceName)
+		require.Equal(t, "db", out.Changes[0].DestinationName)
+		require.True(t, out.Changes[0].Before.Allowed)
+		require.False(t, out.Changes[0].After.Allowed)
+	})
+
+	testutil.RunStep(t, "nothing was written", func(t *testing.T) {
+		_, entry, err := state.ConfigEntry(nil, structs.ServiceIntentions, "db", nil)
+		require.NoError(t, err)
+
+		ixns, ok := entry.(*structs.ServiceIntentionsConfigEntry)
+		require.True(t, ok)
+		for _, src := range ixns.Sources {
+			require.Equal(t, structs.IntentionActionAllow, src.Action)
+		}
+	})
+
+	testutil.RunStep(t, "other kinds are rejected", func(t *testing.T) {
+		args := structs.ConfigEntryRequest{
+			Datacenter: "dc1",
+			Entry:      &structs.ServiceConfigEntry{Kind: structs.ServiceDefaults, Name: "db"},
+		}
+		var out structs.IntentionDryRunResponse
+		err := msgpackrpc.CallWithCodec(codec, "ConfigEntry.ApplyDryRun", &args, &out)
+		testutil.RequireErrorContains(t, err, "dry run is only supported for service-intentions")
+	})
+}
+
 func TestConfigEntry_Apply_ACLDeny(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -74,8 +74,9 @@ func (s *Server) reconcileLocalConfig(ctx context.Context, configs []structs.Con
 
 	var merr error
 	for i, entry := range configs {
-		// Exported services only apply to the primary datacenter.
-		if entry.GetKind() == structs.ExportedServices {
+		// Exported services only apply to the primary datacenter, and imported
+		// services only apply to the peerings of the datacenter they were written in.
+		if kind := entry.GetKind(); kind == structs.ExportedServices || kind == structs.ImportedServices {
 			continue
 		}
 		req := structs.ConfigEntryRequest{
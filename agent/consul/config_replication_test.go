@@ -174,6 +174,105 @@ func TestReplication_ConfigEntries(t *testing.T) {
 	})
 }
 
+func TestReplication_ConfigEntries_ImportedServicesAreLocal(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	dir1, s1 := testServerWithConfig(t, func(c *Config) {
+		c.PrimaryDatacenter = "dc1"
+	})
+	defer os.RemoveAll(dir1)
+	defer s1.Shutdown()
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+
+	dir2, s2 := testServerWithConfig(t, func(c *Config) {
+		c.Datacenter = "dc2"
+		c.PrimaryDatacenter = "dc1"
+		c.ConfigReplicationRate = 100
+		c.ConfigReplicationBurst = 100
+		c.ConfigReplicationApplyLimit = 1000000
+	})
+	testrpc.WaitForLeader(t, s2.RPC, "dc2")
+	defer os.RemoveAll(dir2)
+	defer s2.Shutdown()
+
+	// Try to join.
+	joinWAN(t, s2, s1)
+	testrpc.WaitForLeader(t, s1.RPC, "dc1")
+	testrpc.WaitForLeader(t, s1.RPC, "dc2")
+
+	apply := func(t *testing.T, s *Server, dc string, entry structs.ConfigEntry) {
+		arg := structs.ConfigEntryRequest{
+			Datacenter: dc,
+			Op:         structs.ConfigEntryUpsert,
+			Entry:      entry,
+		}
+		out := false
+		require.NoError(t, s.RPC(context.Background(), "ConfigEntry.Apply", &arg, &out))
+		require.True(t, out)
+	}
+
+	// Both datacenters have a peering named "peer1" that refers to different clusters.
+	apply(t, s1, "dc1", &structs.ImportedServicesConfigEntry{
+		Name:  "peer1",
+		Allow: []structs.ImportedServiceFilter{{Name: "api"}},
+	})
+	// Writes to a secondary are not gated or forwarded to the primary.
+	apply(t, s2, "dc2", &structs.ImportedServicesConfigEntry{
+		Name:  "peer1",
+		Allow: []structs.ImportedServiceFilter{{Name: "web"}},
+	})
+
+	// Replicated entries are used to know when the replica has caught up.
+	apply(t, s1, "dc1", &structs.ServiceConfigEntry{
+		Kind:     structs.ServiceDefaults,
+		Name:     "api",
+		Protocol: "http",
+	})
+
+	retry.Run(t, func(r *retry.R) {
+		_, entry, err := s2.fsm.State().ConfigEntry(nil, structs.ServiceDefaults, "api", nil)
+		require.NoError(r, err)
+		require.NotNil(r, entry)
+	})
+
+	requireAllowed := func(t *testing.T, s *Server, name string) {
+		_, entry, err := s.fsm.State().ConfigEntry(nil, structs.ImportedServices, "peer1", nil)
+		require.NoError(t, err)
+		require.NotNil(t, entry)
+
+		imported, ok := entry.(*structs.ImportedServicesConfigEntry)
+		require.True(t, ok)
+		require.Len(t, imported.Allow, 1)
+		require.Equal(t, name, imported.Allow[0].Name)
+	}
+	requireAllowed(t, s1, "api")
+	requireAllowed(t, s2, "web")
+
+	// Deleting the primary's entry leaves the secondary's entry in place.
+	arg := structs.ConfigEntryRequest{
+		Datacenter: "dc1",
+		Op:         structs.ConfigEntryDelete,
+		Entry:      &structs.ImportedServicesConfigEntry{Name: "peer1"},
+	}
+	var out structs.ConfigEntryDeleteResponse
+	require.NoError(t, s1.RPC(context.Background(), "ConfigEntry.Delete", &arg, &out))
+
+	apply(t, s1, "dc1", &structs.ServiceConfigEntry{
+		Kind:     structs.ServiceDefaults,
+		Name:     "web",
+		Protocol: "http",
+	})
+	retry.Run(t, func(r *retry.R) {
+		_, entry, err := s2.fsm.State().ConfigEntry(nil, structs.ServiceDefaults, "web", nil)
+		require.NoError(r, err)
+		require.NotNil(r, entry)
+	})
+	requireAllowed(t, s2, "web")
+}
+
 func TestReplication_ConfigEntries_GraphValidationErrorDuringReplication(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
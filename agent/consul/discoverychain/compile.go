@@ -41,6 +41,10 @@ type CompileRequest struct {
 	// overridden for any resolver in the compiled chain.
 	OverrideConnectTimeout time.Duration
 
+	// Explain records the config entries that produced each node of the
+	// compiled chain.
+	Explain bool
+
 	Entries *configentry.DiscoveryChainSet
 
 	// AutoVirtualIPs and ManualVirtualIPs are lists of IPs associated with
@@ -100,6 +104,7 @@ func Compile(req CompileRequest) (*structs.CompiledDiscoveryChain, error) {
 		overrideMeshGateway:    req.OverrideMeshGateway,
 		overrideProtocol:       req.OverrideProtocol,
 		overrideConnectTimeout: req.OverrideConnectTimeout,
+		explain:                req.Explain,
 		entries:                entries,
 		autoVirtualIPs:         req.AutoVirtualIPs,
 		manualVirtualIPs:       req.ManualVirtualIPs,
@@ -138,6 +143,7 @@ type compiler struct {
 	overrideMeshGateway    structs.MeshGatewayConfig
 	overrideProtocol       string
 	overrideConnectTimeout time.Duration
+	explain                bool
 
 	// config entries that are being compiled (will be mutated during compilation)
 	//
@@ -220,6 +226,28 @@ func (m *customizationMarkers) IsZero() bool {
 	return !m.MeshGateway && !m.Protocol && !m.ConnectTimeout
 }
 
+// explainNode records that the config entry contributed to the node if
+// explanations were requested.
+func (c *compiler) explainNode(node *structs.DiscoveryGraphNode, entry structs.ConfigEntry, isDefault bool) {
+	if !c.explain {
+		return
+	}
+	entMeta := entry.GetEnterpriseMeta()
+	source := structs.DiscoveryGraphNodeSource{
+		Kind:      entry.GetKind(),
+		Name:      entry.GetName(),
+		Namespace: entMeta.NamespaceOrEmpty(),
+		Partition: entMeta.PartitionOrEmpty(),
+		Default:   isDefault,
+	}
+	for _, existing := range node.Sources {
+		if existing == source {
+			return
+		}
+	}
+	node.Sources = append(node.Sources, source)
+}
+
 // recordNode stores the node internally in the compiled chain.
 func (c *compiler) recordNode(node *structs.DiscoveryGraphNode) {
 	// Some types have their own type-specific lookups, so record those, too.
@@ -476,6 +504,7 @@ func (c *compiler) flattenAdjacentSplitterNodes() error {
 						Definition: newDef,
 						Weight:     structs.NormalizeServiceSplitWeight(effectiveWeight),
 						NextNode:   innerSplit.NextNode,
+						Mirrors:    mergeDiscoveryMirrors(split.Mirrors, innerSplit.Mirrors),
 					}
 
 					fixedSplits = append(fixedSplits, newDiscoverySplit)
@@ -535,10 +564,16 @@ func (c *compiler) removeUnusedNodes() error {
 		case structs.DiscoveryGraphNodeTypeRouter:
 			for _, route := range node.Routes {
 				todo[route.NextNode] = struct{}{}
+				for _, mirror := range route.Mirrors {
+					todo[mirror.NextNode] = struct{}{}
+				}
 			}
 		case structs.DiscoveryGraphNodeTypeSplitter:
 			for _, split := range node.Splits {
 				todo[split.NextNode] = struct{}{}
+				for _, mirror := range split.Mirrors {
+					todo[mirror.NextNode] = struct{}{}
+				}
 			}
 		case structs.DiscoveryGraphNodeTypeResolver:
 			// nothing special
@@ -618,6 +653,7 @@ func (c *compiler) assembleChain() error {
 		Name:   serviceIDString(routerID),
 		Routes: make([]*structs.DiscoveryRoute, 0, len(router.Routes)+1),
 	}
+	c.explainNode(routeNode, router, false)
 	c.usesAdvancedRoutingFeatures = true
 	if err := c.recordServiceProtocol(routerID); err != nil {
 		return err
@@ -673,6 +709,11 @@ func (c *compiler) assembleChain() error {
 			return err
 		}
 		compiledRoute.NextNode = node.MapKey()
+
+		compiledRoute.Mirrors, err = c.compileMirrors(dest.Mirror, router.NamespaceOrDefault(), router.PartitionOrDefault())
+		if err != nil {
+			return err
+		}
 	}
 
 	// If we have a router, we'll add a catch-all route at the end to send
@@ -699,6 +740,51 @@ func (c *compiler) assembleChain() error {
 	return nil
 }
 
+// compileMirrors resolves the targets of a route or split's mirrors. Mirrored
+// requests are never split further, so each mirror points directly at a
+// resolver node.
+func (c *compiler) compileMirrors(mirrors []structs.ServiceRouteMirror, namespace, partition string) ([]*structs.DiscoveryMirror, error) {
+	if len(mirrors) == 0 {
+		return nil, nil
+	}
+
+	out := make([]*structs.DiscoveryMirror, 0, len(mirrors))
+	for _, mirror := range mirrors {
+		opts := structs.DiscoveryTargetOpts{
+			Service:       mirror.Service,
+			ServiceSubset: mirror.ServiceSubset,
+			Namespace:     mirror.Namespace,
+			Partition:     mirror.Partition,
+			Peer:          mirror.Peer,
+		}
+		if opts.Peer == "" {
+			opts.Namespace = defaultIfEmpty(opts.Namespace, namespace)
+			opts.Partition = defaultIfEmpty(opts.Partition, partition)
+		}
+
+		node, err := c.getResolverNode(c.newTarget(opts), false)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &structs.DiscoveryMirror{
+			NextNode: node.MapKey(),
+			Percent:  mirror.EffectivePercent(),
+		})
+	}
+	return out, nil
+}
+
+// mergeDiscoveryMirrors returns the mirrors of a parent split followed by
+// those of one of its flattened children.
+func mergeDiscoveryMirrors(parent, child []*structs.DiscoveryMirror) []*structs.DiscoveryMirror {
+	if len(parent)+len(child) == 0 {
+		return nil
+	}
+	out := make([]*structs.DiscoveryMirror, 0, len(parent)+len(child))
+	out = append(out, parent...)
+	return append(out, child...)
+}
+
 func newDefaultServiceRoute(serviceName, namespace, partition string) *structs.ServiceRoute {
 	return &structs.ServiceRoute{
 		Match: &structs.ServiceRouteMatch{
@@ -828,6 +914,7 @@ func (c *compiler) getSplitterNode(sid structs.ServiceID) (*structs.DiscoveryGra
 		Name:   name,
 		Splits: make([]*structs.DiscoverySplit, 0, len(splitter.Splits)),
 	}
+	c.explainNode(splitNode, splitter, false)
 
 	// If we record this exists before recursing down it will short-circuit
 	// reasonably if there is some sort of graph loop below.
@@ -847,6 +934,12 @@ func (c *compiler) getSplitterNode(sid structs.ServiceID) (*structs.DiscoveryGra
 		}
 		splitNode.Splits = append(splitNode.Splits, compiledSplit)
 
+		mirrors, err := c.compileMirrors(split.Mirror, splitter.NamespaceOrDefault(), splitter.PartitionOrDefault())
+		if err != nil {
+			return nil, err
+		}
+		compiledSplit.Mirrors = mirrors
+
 		svc := defaultIfEmpty(split.Service, sid.ID)
 		splitID := structs.ServiceID{
 			ID:             svc,
@@ -902,6 +995,9 @@ func (c *compiler) getResolverNode(target *structs.DiscoveryTarget, recursedForF
 		// messages.
 		redirectHistory = make(map[string]struct{})
 		redirectOrder   []string
+
+		// Resolvers that were consulted while following redirects.
+		visitedResolvers []*structs.ServiceResolverConfigEntry
 	)
 
 RESOLVE_AGAIN:
@@ -929,6 +1025,7 @@ RESOLVE_AGAIN:
 		resolver = c.newDefaultServiceResolver(targetID, target.Peer)
 		c.resolvers[targetID] = resolver
 	}
+	visitedResolvers = append(visitedResolvers, resolver)
 
 	if _, ok := redirectHistory[target.ID]; ok {
 		redirectOrder = append(redirectOrder, target.ID)
@@ -1007,6 +1104,19 @@ RESOLVE_AGAIN:
 		LoadBalancer: resolver.LoadBalancer,
 	}
 
+	for _, visited := range visitedResolvers {
+		_, configured := c.entries.Resolvers[structs.NewServiceID(visited.Name, visited.GetEnterpriseMeta())]
+		c.explainNode(node, visited, !configured)
+	}
+
+	// Expose the effective health checking settings of the resolver.
+	if resolver.OutlierDetection != nil {
+		node.Resolver.OutlierDetection = resolver.OutlierDetection.Clone()
+	}
+	for _, hc := range resolver.HealthChecks {
+		node.Resolver.HealthChecks = append(node.Resolver.HealthChecks, hc.WithDefaults())
+	}
+
 	proxyDefault := c.entries.GetProxyDefaults(targetID.PartitionOrDefault())
 
 	// Only set PrioritizeByLocality for targets in the same partition.
@@ -41,6 +41,7 @@ func TestCompile(t *testing.T) {
 		"subset split":                                     testcase_SubsetSplit(),
 		"service split":                                    testcase_ServiceSplit(),
 		"split bypasses next splitter":                     testcase_SplitBypassesSplit(),
+		"route and split mirrors":                          testcase_RouteAndSplitMirrors(),
 		"service redirect":                                 testcase_ServiceRedirect(),
 		"service and subset redirect":                      testcase_ServiceAndSubsetRedirect(),
 		"datacenter redirect":                              testcase_DatacenterRedirect(),
@@ -62,6 +63,8 @@ func TestCompile(t *testing.T) {
 		"default resolver with proxy defaults":             testcase_DefaultResolver_WithProxyDefaults(),
 		"loadbalancer splitter and resolver":               testcase_LBSplitterAndResolver(),
 		"loadbalancer resolver":                            testcase_LBResolver(),
+		"resolver with health checks":                      testcase_ResolverHealthChecks(),
+		"explain router and redirect":                      testcase_ExplainRouterAndRedirect(),
 		"service redirect to service with default resolver is not a default chain": testcase_RedirectToDefaultResolverIsNotDefaultChain(),
 		"extensions":                            testcase_Extensions(),
 		"service meta projection":               testcase_ServiceMetaProjection(),
@@ -977,6 +980,159 @@ func testcase_SplitBypassesSplit() compileTestCase {
 	return compileTestCase{entries: entries, expect: expect}
 }
 
+func testcase_RouteAndSplitMirrors() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "http")
+	setServiceProtocol(entries, "other", "http")
+	setServiceProtocol(entries, "next", "http")
+	setServiceProtocol(entries, "shadow", "http")
+
+	entries.AddRouters(
+		&structs.ServiceRouterConfigEntry{
+			Kind: "service-router",
+			Name: "main",
+			Routes: []structs.ServiceRoute{
+				newSimpleRoute("other", func(r *structs.ServiceRoute) {
+					r.Destination.Mirror = []structs.ServiceRouteMirror{
+						{Service: "shadow", Percent: 25},
+					}
+				}),
+			},
+		},
+	)
+	entries.AddSplitters(
+		&structs.ServiceSplitterConfigEntry{
+			Kind: "service-splitter",
+			Name: "main",
+			Splits: []structs.ServiceSplit{
+				{
+					Weight:  100,
+					Service: "next",
+					Mirror:  []structs.ServiceRouteMirror{{Service: "shadow"}},
+				},
+			},
+		},
+		&structs.ServiceSplitterConfigEntry{
+			Kind: "service-splitter",
+			Name: "next",
+			Splits: []structs.ServiceSplit{
+				{
+					Weight: 100,
+					Mirror: []structs.ServiceRouteMirror{{Service: "shadow", ServiceSubset: "v2"}},
+				},
+			},
+		},
+	)
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind: "service-resolver",
+			Name: "shadow",
+			Subsets: map[string]structs.ServiceResolverSubset{
+				"v2": {
+					Filter: "Service.Meta.version == 2",
+				},
+			},
+		},
+	)
+
+	router := entries.GetRouter(structs.NewServiceID("main", nil))
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "http",
+		StartNode: "router:main.default.default",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"router:main.default.default": {
+				Type: structs.DiscoveryGraphNodeTypeRouter,
+				Name: "main.default.default",
+				Routes: []*structs.DiscoveryRoute{
+					{
+						Definition: &router.Routes[0],
+						NextNode:   "resolver:other.default.default.dc1",
+						Mirrors: []*structs.DiscoveryMirror{
+							{NextNode: "resolver:shadow.default.default.dc1", Percent: 25},
+						},
+					},
+					{
+						Definition: newDefaultServiceRoute("main", "default", "default"),
+						NextNode:   "splitter:main.default.default",
+					},
+				},
+			},
+			"splitter:main.default.default": {
+				Type: structs.DiscoveryGraphNodeTypeSplitter,
+				Name: "main.default.default",
+				Splits: []*structs.DiscoverySplit{
+					{
+						// The parent's mirrors are merged into the flattened split.
+						Definition: &structs.ServiceSplit{
+							Weight: 100,
+							Mirror: []structs.ServiceRouteMirror{
+								{Service: "shadow"},
+								{Service: "shadow", ServiceSubset: "v2"},
+							},
+						},
+						Weight:   100,
+						NextNode: "resolver:next.default.default.dc1",
+						Mirrors: []*structs.DiscoveryMirror{
+							{NextNode: "resolver:shadow.default.default.dc1", Percent: 100},
+							{NextNode: "resolver:v2.shadow.default.default.dc1", Percent: 100},
+						},
+					},
+				},
+			},
+			"resolver:other.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "other.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "other.default.default.dc1",
+				},
+			},
+			"resolver:next.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "next.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "next.default.default.dc1",
+				},
+			},
+			"resolver:shadow.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "shadow.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					ConnectTimeout: 5 * time.Second,
+					Target:         "shadow.default.default.dc1",
+				},
+			},
+			"resolver:v2.shadow.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "v2.shadow.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					ConnectTimeout: 5 * time.Second,
+					Target:         "v2.shadow.default.default.dc1",
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"other.default.default.dc1":  newTarget(structs.DiscoveryTargetOpts{Service: "other"}, nil),
+			"next.default.default.dc1":   newTarget(structs.DiscoveryTargetOpts{Service: "next"}, nil),
+			"shadow.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{Service: "shadow"}, nil),
+			"v2.shadow.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{
+				Service:       "shadow",
+				ServiceSubset: "v2",
+			}, func(t *structs.DiscoveryTarget) {
+				t.Subset = structs.ServiceResolverSubset{
+					Filter: "Service.Meta.version == 2",
+				}
+			}),
+		},
+	}
+
+	return compileTestCase{entries: entries, expect: expect}
+}
+
 func testcase_ServiceRedirect() compileTestCase {
 	entries := newEntries()
 	entries.AddResolvers(
@@ -3132,6 +3288,128 @@ func testcase_LBResolver() compileTestCase {
 	return compileTestCase{entries: entries, expect: expect}
 }
 
+func testcase_ExplainRouterAndRedirect() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "http")
+	setServiceProtocol(entries, "other", "http")
+
+	entries.AddRouters(
+		&structs.ServiceRouterConfigEntry{
+			Kind: "service-router",
+			Name: "main",
+		},
+	)
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind: "service-resolver",
+			Name: "main",
+			Redirect: &structs.ServiceResolverRedirect{
+				Service: "other",
+			},
+		},
+	)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "http",
+		StartNode: "router:main.default.default",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"router:main.default.default": {
+				Type: structs.DiscoveryGraphNodeTypeRouter,
+				Name: "main.default.default",
+				Routes: []*structs.DiscoveryRoute{
+					{
+						Definition: newDefaultServiceRoute("main", "default", "default"),
+						NextNode:   "resolver:other.default.default.dc1",
+					},
+				},
+				Sources: []structs.DiscoveryGraphNodeSource{
+					{Kind: structs.ServiceRouter, Name: "main"},
+				},
+			},
+			"resolver:other.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "other.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        true,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "other.default.default.dc1",
+				},
+				Sources: []structs.DiscoveryGraphNodeSource{
+					{Kind: structs.ServiceResolver, Name: "main"},
+					{Kind: structs.ServiceResolver, Name: "other", Default: true},
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"other.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{Service: "other"}, nil),
+		},
+	}
+
+	return compileTestCase{
+		entries: entries,
+		setup: func(req *CompileRequest) {
+			req.Explain = true
+		},
+		expect: expect,
+	}
+}
+
+func testcase_ResolverHealthChecks() compileTestCase {
+	entries := newEntries()
+	setServiceProtocol(entries, "main", "grpc")
+
+	entries.AddResolvers(
+		&structs.ServiceResolverConfigEntry{
+			Kind: "service-resolver",
+			Name: "main",
+			OutlierDetection: &structs.PassiveHealthCheck{
+				Interval:    10 * time.Second,
+				MaxFailures: 3,
+			},
+			HealthChecks: []structs.ActiveHealthCheck{
+				{
+					Interval: 2 * time.Second,
+					GRPC:     &structs.ActiveHealthCheckGRPC{ServiceName: "main"},
+				},
+			},
+		},
+	)
+
+	expect := &structs.CompiledDiscoveryChain{
+		Protocol:  "grpc",
+		StartNode: "resolver:main.default.default.dc1",
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"resolver:main.default.default.dc1": {
+				Type: structs.DiscoveryGraphNodeTypeResolver,
+				Name: "main.default.default.dc1",
+				Resolver: &structs.DiscoveryResolver{
+					Default:        false,
+					ConnectTimeout: 5 * time.Second,
+					Target:         "main.default.default.dc1",
+					OutlierDetection: &structs.PassiveHealthCheck{
+						Interval:    10 * time.Second,
+						MaxFailures: 3,
+					},
+					HealthChecks: []structs.ActiveHealthCheck{
+						{
+							Interval:           2 * time.Second,
+							Timeout:            5 * time.Second,
+							UnhealthyThreshold: 3,
+							HealthyThreshold:   2,
+							GRPC:               &structs.ActiveHealthCheckGRPC{ServiceName: "main"},
+						},
+					},
+				},
+			},
+		},
+		Targets: map[string]*structs.DiscoveryTarget{
+			"main.default.default.dc1": newTarget(structs.DiscoveryTargetOpts{Service: "main"}, nil),
+		},
+	}
+
+	return compileTestCase{entries: entries, expect: expect}
+}
+
 func testcase_ServiceDefaultsTProxy() compileTestCase {
 	entries := newEntries()
 	entries.AddServices(
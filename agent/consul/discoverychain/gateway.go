@@ -31,6 +31,7 @@ type hostnameMatch struct {
 	filters         structs.HTTPFilters
 	responseFilters structs.HTTPResponseFilters
 	services        []structs.HTTPService
+	cors            *structs.CORSPolicy
 }
 
 // NewGatewayChainSynthesizer creates a new GatewayChainSynthesizer for the
@@ -50,6 +51,20 @@ func (l *GatewayChainSynthesizer) AddTCPRoute(route structs.TCPRouteConfigEntry)
 	l.tcpRoutes = append(l.tcpRoutes, route)
 }
 
+// AddTLSRoute adds a TLSRoute to use in synthesizing a discovery chain. TLS
+// connections are passed through to their upstream without inspecting the
+// traffic, so the route is synthesized the same way as a TCPRoute.
+func (l *GatewayChainSynthesizer) AddTLSRoute(route structs.TLSRouteConfigEntry) {
+	l.tcpRoutes = append(l.tcpRoutes, structs.TCPRouteConfigEntry{
+		Kind:           structs.TCPRoute,
+		Name:           route.Name,
+		Parents:        route.Parents,
+		Services:       route.Services,
+		Meta:           route.Meta,
+		EnterpriseMeta: route.EnterpriseMeta,
+	})
+}
+
 // SetHostname sets the base hostname for a listener that this is being synthesized for
 func (l *GatewayChainSynthesizer) SetHostname(hostname string) {
 	l.hostname = hostname
@@ -63,6 +78,27 @@ func (l *GatewayChainSynthesizer) AddHTTPRoute(route structs.HTTPRouteConfigEntr
 	l.matchesByHostname = initHostMatches(l.hostname, &route, l.matchesByHostname)
 }
 
+// AddGRPCRoute adds a GRPCRoute to use in synthesizing a discovery chain. gRPC
+// methods are addressed by request path, so the route is flattened the same way
+// as its HTTPRoute equivalent.
+func (l *GatewayChainSynthesizer) AddGRPCRoute(route structs.GRPCRouteConfigEntry) {
+	l.AddHTTPRoute(*route.ToHTTPRoute())
+}
+
+// respondsDirectly returns true if any of the added HTTP rules are answered
+// by the gateway itself, in which case chains can be synthesized without
+// any upstream chains.
+func (l *GatewayChainSynthesizer) respondsDirectly() bool {
+	for _, matches := range l.matchesByHostname {
+		for _, match := range matches {
+			if match.filters.RequestRedirect != nil || match.filters.DirectResponse != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func initHostMatches(hostname string, route *structs.HTTPRouteConfigEntry, currentMatches map[string][]hostnameMatch) map[string][]hostnameMatch {
 	hostnames := route.FilteredHostnames(hostname)
 	for _, host := range hostnames {
@@ -92,6 +128,7 @@ func initHostMatches(hostname string, route *structs.HTTPRouteConfigEntry, curre
 					filters:         rule.Filters,
 					responseFilters: rule.ResponseFilters,
 					services:        rule.Services,
+					cors:            rule.CORS,
 				})
 			}
 		}
@@ -107,14 +144,14 @@ func initHostMatches(hostname string, route *structs.HTTPRouteConfigEntry, curre
 //
 // This is currently used to help API gateways masquarade as ingress gateways
 // by providing a set of virtual config entries that change the routing behavior
-// to upstreams referenced in the given HTTPRoutes or TCPRoutes.
+// to upstreams referenced in the given HTTPRoutes, GRPCRoutes or TCPRoutes.
 func (l *GatewayChainSynthesizer) Synthesize(chains ...*structs.CompiledDiscoveryChain) ([]structs.IngressService, []*structs.CompiledDiscoveryChain, error) {
-	if len(chains) == 0 {
+	services, set := l.synthesizeEntries()
+
+	if len(chains) == 0 && !l.respondsDirectly() {
 		return nil, nil, fmt.Errorf("must provide at least one compiled discovery chain")
 	}
 
-	services, set := l.synthesizeEntries()
-
 	if len(set) == 0 {
 		// we can't actually compile a discovery chain, i.e. we're using a TCPRoute-based listener, instead, just return the ingresses
 		// and the pre-compiled discovery chains
@@ -152,6 +189,13 @@ func (l *GatewayChainSynthesizer) Synthesize(chains ...*structs.CompiledDiscover
 			// clean out the route rules that'll get added for the router
 			filtered := []*structs.DiscoveryRoute{}
 			for _, route := range node.Routes {
+				if route.Definition.Destination.RespondsDirectly() {
+					// requests are answered by the gateway itself, so the
+					// route doesn't lead to any other node
+					route.NextNode = ""
+					filtered = append(filtered, route)
+					continue
+				}
 				if strings.HasPrefix(route.NextNode, resolverPrefix) {
 					continue
 				}
@@ -237,6 +281,7 @@ func consolidateHTTPRoutes(matchesByHostname map[string][]hostnameMatch, listene
 				Filters:         rule.filters,
 				ResponseFilters: rule.responseFilters,
 				Services:        rule.services,
+				CORS:            rule.cors,
 			})
 		}
 
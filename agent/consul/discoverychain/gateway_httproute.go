@@ -195,6 +195,20 @@ func httpRouteToDiscoveryChain(route structs.HTTPRouteConfigEntry) (*structs.Ser
 			destination.RequestTimeout = rule.Filters.TimeoutFilter.RequestTimeout
 		}
 
+		destination.CORS = rule.CORS
+		destination.RequestRedirect = rule.Filters.RequestRedirect
+		destination.DirectResponse = rule.Filters.DirectResponse
+		if destination.RespondsDirectly() {
+			// the gateway answers these requests itself, but the virtual
+			// destination still needs a protocol to pass chain compilation
+			defaults = append(defaults, &structs.ServiceConfigEntry{
+				Kind:           structs.ServiceDefaults,
+				Name:           destination.Service,
+				Protocol:       "http",
+				EnterpriseMeta: route.EnterpriseMeta,
+			})
+		}
+
 		// for each match rule a ServiceRoute is created for the service-router
 		// if there are no rules a single route with the destination is set
 		if len(rule.Matches) == 0 {
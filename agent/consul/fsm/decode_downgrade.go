@@ -613,6 +613,8 @@ func MakeShadowConfigEntry(kind, name string) (structs.ConfigEntry, error) {
 		return &ShadowMeshConfigEntry{MeshConfigEntry: &structs.MeshConfigEntry{}}, nil
 	case structs.ExportedServices:
 		return &ShadowExportedServicesConfigEntry{ExportedServicesConfigEntry: &structs.ExportedServicesConfigEntry{Name: name}}, nil
+	case structs.ImportedServices:
+		return &ShadowImportedServicesConfigEntry{ImportedServicesConfigEntry: &structs.ImportedServicesConfigEntry{Name: name}}, nil
 	case structs.SamenessGroup:
 		return &ShadowSamenessGroupConfigEntry{SamenessGroupConfigEntry: &structs.SamenessGroupConfigEntry{Name: name}}, nil
 	case structs.APIGateway:
@@ -623,10 +625,16 @@ func MakeShadowConfigEntry(kind, name string) (structs.ConfigEntry, error) {
 		return &ShadowFileSystemCertificateConfigEntry{FileSystemCertificateConfigEntry: &structs.FileSystemCertificateConfigEntry{Name: name}}, nil
 	case structs.InlineCertificate:
 		return &ShadowInlineCertificateConfigEntry{InlineCertificateConfigEntry: &structs.InlineCertificateConfigEntry{Name: name}}, nil
+	case structs.ACMECertificate:
+		return &ShadowACMECertificateConfigEntry{ACMECertificateConfigEntry: &structs.ACMECertificateConfigEntry{Name: name}}, nil
 	case structs.HTTPRoute:
 		return &ShadowHTTPRouteConfigEntry{HTTPRouteConfigEntry: &structs.HTTPRouteConfigEntry{Name: name}}, nil
+	case structs.GRPCRoute:
+		return &ShadowGRPCRouteConfigEntry{GRPCRouteConfigEntry: &structs.GRPCRouteConfigEntry{Name: name}}, nil
 	case structs.TCPRoute:
 		return &ShadowTCPRouteConfigEntry{TCPRouteConfigEntry: &structs.TCPRouteConfigEntry{Name: name}}, nil
+	case structs.TLSRoute:
+		return &ShadowTLSRouteConfigEntry{TLSRouteConfigEntry: &structs.TLSRouteConfigEntry{Name: name}}, nil
 	case structs.JWTProvider:
 		return &ShadowJWTProviderConfigEntry{JWTProviderConfigEntry: &structs.JWTProviderConfigEntry{Name: name}}, nil
 	default:
@@ -887,6 +895,15 @@ func (s ShadowExportedServicesConfigEntry) GetRealConfigEntry() structs.ConfigEn
 	return s.ExportedServicesConfigEntry
 }
 
+type ShadowImportedServicesConfigEntry struct {
+	ShadowBase
+	*structs.ImportedServicesConfigEntry
+}
+
+func (s ShadowImportedServicesConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	return s.ImportedServicesConfigEntry
+}
+
 type ShadowSamenessGroupConfigEntry struct {
 	ShadowBase
 	*structs.SamenessGroupConfigEntry
@@ -951,6 +968,15 @@ func (s ShadowInlineCertificateConfigEntry) GetRealConfigEntry() structs.ConfigE
 	return s.InlineCertificateConfigEntry
 }
 
+type ShadowACMECertificateConfigEntry struct {
+	ShadowBase
+	*structs.ACMECertificateConfigEntry
+}
+
+func (s ShadowACMECertificateConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	return s.ACMECertificateConfigEntry
+}
+
 type ShadowHTTPService struct {
 	ShadowBase
 	*structs.HTTPService
@@ -987,6 +1013,34 @@ func (s ShadowHTTPRouteConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
 	return s.HTTPRouteConfigEntry
 }
 
+type ShadowGRPCRouteRule struct {
+	Services []ShadowHTTPService
+	*structs.GRPCRouteRule
+}
+type ShadowGRPCRouteConfigEntry struct {
+	ShadowBase
+	Parents []ShadowResourceReference
+	Rules   []ShadowGRPCRouteRule
+	*structs.GRPCRouteConfigEntry
+}
+
+func (s ShadowGRPCRouteConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	for _, parent := range s.Parents {
+		if !IsEnterpriseData(parent.Namespace, parent.Partition) {
+			s.GRPCRouteConfigEntry.Parents = append(s.GRPCRouteConfigEntry.Parents, *parent.ResourceReference)
+		}
+	}
+	for _, rule := range s.Rules {
+		for _, svc := range rule.Services {
+			if !IsEnterpriseData(svc.Namespace, svc.Partition) {
+				rule.GRPCRouteRule.Services = append(rule.GRPCRouteRule.Services, *svc.HTTPService)
+			}
+		}
+		s.GRPCRouteConfigEntry.Rules = append(s.GRPCRouteConfigEntry.Rules, *rule.GRPCRouteRule)
+	}
+	return s.GRPCRouteConfigEntry
+}
+
 type ShadowTCPService struct {
 	ShadowBase
 	*structs.TCPService
@@ -1012,6 +1066,27 @@ func (s ShadowTCPRouteConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
 	return s.TCPRouteConfigEntry
 }
 
+type ShadowTLSRouteConfigEntry struct {
+	ShadowBase
+	Parents  []ShadowResourceReference
+	Services []ShadowTCPService
+	*structs.TLSRouteConfigEntry
+}
+
+func (s ShadowTLSRouteConfigEntry) GetRealConfigEntry() structs.ConfigEntry {
+	for _, parent := range s.Parents {
+		if !IsEnterpriseData(parent.Namespace, parent.Partition) {
+			s.TLSRouteConfigEntry.Parents = append(s.TLSRouteConfigEntry.Parents, *parent.ResourceReference)
+		}
+	}
+	for _, svc := range s.Services {
+		if !IsEnterpriseData(svc.Namespace, svc.Partition) {
+			s.TLSRouteConfigEntry.Services = append(s.TLSRouteConfigEntry.Services, *svc.TCPService)
+		}
+	}
+	return s.TLSRouteConfigEntry
+}
+
 type ShadowJWTProviderConfigEntry struct {
 	ShadowBase
 	*structs.JWTProviderConfigEntry
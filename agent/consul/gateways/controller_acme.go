@@ -0,0 +1,493 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package gateways
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"golang.org/x/crypto/acme"
+
+	"github.com/hashicorp/consul/agent/consul/controller"
+	"github.com/hashicorp/consul/agent/consul/fsm"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/consul/stream"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	// acmeChallengePropagationDelay is how long the controller waits after
+	// publishing HTTP-01 challenges before asking the certificate authority
+	// to validate them, giving gateways time to receive the new routes.
+	acmeChallengePropagationDelay = 10 * time.Second
+
+	// acmeOrderTimeout bounds how long the controller waits for the
+	// certificate authority to validate challenges and issue a certificate.
+	acmeOrderTimeout = 2 * time.Minute
+
+	// acmeRequestTimeout bounds registering an account and creating an
+	// order, and each HTTP request made to the certificate authority, so an
+	// unresponsive certificate authority can't stall the controller.
+	acmeRequestTimeout = 30 * time.Second
+
+	acmeRetryBaseBackoff = time.Minute
+	acmeRetryMaxBackoff  = time.Hour
+)
+
+// acmeCertificateReconciler obtains and renews certificates for
+// acme-certificate config entries and stores them in inline-certificate
+// config entries of the same name.
+type acmeCertificateReconciler struct {
+	fsm     *fsm.FSM
+	logger  hclog.Logger
+	updater *Updater
+
+	httpClient       *http.Client
+	propagationDelay time.Duration
+	orderTimeout     time.Duration
+	requestTimeout   time.Duration
+	retryBaseBackoff time.Duration
+	retryMaxBackoff  time.Duration
+
+	mu sync.Mutex
+	// clients holds an ACME client, and so an account, for each directory
+	// and contact email combination. Account keys are only kept in memory,
+	// so a new leader registers a new account on first use. Certificate
+	// authorities allow this, but it counts against their account rate
+	// limits.
+	clients map[string]*acme.Client
+	// orders tracks in-flight orders and failures keyed by request.
+	orders map[string]*acmeOrderState
+}
+
+// acmeOrderState is the in-memory state of issuing a certificate for an
+// acme-certificate config entry.
+type acmeOrderState struct {
+	// hash is the hash of the acme-certificate config entry the state was
+	// created for, a user modification of the entry discards the state.
+	hash uint64
+
+	client        *acme.Client
+	orderURL      string
+	finalizeURL   string
+	challengeURLs []string
+	// readyAt is when the published challenges are expected to be served
+	// by the gateways.
+	readyAt time.Time
+
+	failures int
+	retryAt  time.Time
+}
+
+// Reconcile issues or renews the certificate for the acme-certificate
+// referenced by the request, or deletes the managed inline-certificate when
+// the acme-certificate no longer exists.
+func (r *acmeCertificateReconciler) Reconcile(ctx context.Context, req controller.Request) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	logger := requestLogger(r.logger, req)
+	store := r.fsm.State()
+
+	_, entry, err := store.ConfigEntry(nil, structs.ACMECertificate, req.Name, req.Meta)
+	if err != nil {
+		logger.Warn("error fetching config entry for reconciliation request", "error", err)
+		return err
+	}
+
+	_, certEntry, err := store.ConfigEntry(nil, structs.InlineCertificate, req.Name, req.Meta)
+	if err != nil {
+		logger.Warn("error fetching inline certificate", "error", err)
+		return err
+	}
+	var cert *structs.InlineCertificateConfigEntry
+	if certEntry != nil {
+		cert = certEntry.(*structs.InlineCertificateConfigEntry)
+	}
+
+	if entry == nil {
+		delete(r.orders, req.Key())
+		return r.cleanupCertificate(logger, req, cert)
+	}
+	acmeCert := entry.(*structs.ACMECertificateConfigEntry)
+
+	if cert != nil && cert.Meta[structs.ACMECertificateMetaKey] != acmeCert.Name {
+		delete(r.orders, req.Key())
+		return r.updateStatus(acmeCert, acmeCertificateConflict())
+	}
+
+	if renewAt, ok := certificateRenewalTime(acmeCert, cert); ok && time.Now().Before(renewAt) {
+		delete(r.orders, req.Key())
+		if err := r.updateStatus(acmeCert, acmeCertificateIssued(renewAt.Add(acmeCert.RenewBefore))); err != nil {
+			return err
+		}
+		logger.Trace("certificate is up to date", "renew_at", renewAt)
+		return controller.RequeueAfter(time.Until(renewAt))
+	}
+
+	order, ok := r.orders[req.Key()]
+	if !ok || order.hash != acmeCert.Hash {
+		order = &acmeOrderState{hash: acmeCert.Hash}
+		r.orders[req.Key()] = order
+	}
+
+	if wait := time.Until(order.retryAt); wait > 0 {
+		return controller.RequeueAfter(wait)
+	}
+
+	switch {
+	case order.orderURL == "":
+		if err = r.startOrder(ctx, acmeCert, order); err == nil {
+			return controller.RequeueAfter(r.propagationDelay)
+		}
+	case time.Now().Before(order.readyAt):
+		return controller.RequeueAfter(time.Until(order.readyAt))
+	default:
+		if err = r.finishOrder(ctx, acmeCert, cert, order); err == nil {
+			// Reconcile again to clear the challenges and record the issued
+			// certificate in the status.
+			return controller.RequeueNow()
+		}
+	}
+
+	order.orderURL = ""
+	order.failures++
+	backoff := r.retryBaseBackoff << (order.failures - 1)
+	if backoff > r.retryMaxBackoff || backoff <= 0 {
+		backoff = r.retryMaxBackoff
+	}
+	order.retryAt = time.Now().Add(backoff)
+
+	logger.Error("failed to obtain certificate", "error", err, "retry_in", backoff)
+	if err := r.updateStatus(acmeCert, acmeCertificateFailed(err)); err != nil {
+		return err
+	}
+	return controller.RequeueAfter(backoff)
+}
+
+// startOrder creates an order for the hostnames of the acme-certificate and
+// publishes the HTTP-01 challenges for the gateways to serve.
+func (r *acmeCertificateReconciler) startOrder(ctx context.Context, entry *structs.ACMECertificateConfigEntry, order *acmeOrderState) error {
+	// Reconcile holds r.mu, so bound the requests to the certificate
+	// authority, including registering the account.
+	ctx, cancel := context.WithTimeout(ctx, r.requestTimeout)
+	defer cancel()
+
+	client, err := r.client(ctx, entry)
+	if err != nil {
+		return err
+	}
+
+	o, err := client.AuthorizeOrder(ctx, acme.DomainIDs(entry.Hostnames...))
+	if err != nil {
+		return fmt.Errorf("failed to create order: %w", err)
+	}
+
+	var (
+		challenges    []structs.ACMEChallenge
+		challengeURLs []string
+	)
+	for _, authzURL := range o.AuthzURLs {
+		authz, err := client.GetAuthorization(ctx, authzURL)
+		if err != nil {
+			return fmt.Errorf("failed to fetch authorization: %w", err)
+		}
+		if authz.Status == acme.StatusValid {
+			continue
+		}
+
+		var challenge *acme.Challenge
+		for _, c := range authz.Challenges {
+			if c.Type == "http-01" {
+				challenge = c
+				break
+			}
+		}
+		if challenge == nil {
+			return fmt.Errorf("certificate authority did not offer an http-01 challenge for %q", authz.Identifier.Value)
+		}
+
+		keyAuth, err := client.HTTP01ChallengeResponse(challenge.Token)
+		if err != nil {
+			return err
+		}
+		challenges = append(challenges, structs.ACMEChallenge{
+			Token:            challenge.Token,
+			KeyAuthorization: keyAuth,
+		})
+		challengeURLs = append(challengeURLs, challenge.URI)
+	}
+
+	order.client = client
+	order.orderURL = o.URI
+	order.finalizeURL = o.FinalizeURL
+	order.challengeURLs = challengeURLs
+	order.readyAt = time.Now().Add(r.propagationDelay)
+
+	return r.updateEntry(entry, challenges, acmeCertificatePending())
+}
+
+// finishOrder has the certificate authority validate the published challenges
+// and stores the issued certificate in the managed inline-certificate.
+func (r *acmeCertificateReconciler) finishOrder(ctx context.Context, entry *structs.ACMECertificateConfigEntry, existing *structs.InlineCertificateConfigEntry, order *acmeOrderState) error {
+	ctx, cancel := context.WithTimeout(ctx, r.orderTimeout)
+	defer cancel()
+
+	for _, challengeURL := range order.challengeURLs {
+		if _, err := order.client.Accept(ctx, &acme.Challenge{URI: challengeURL}); err != nil {
+			return fmt.Errorf("failed to accept challenge: %w", err)
+		}
+	}
+
+	if _, err := order.client.WaitOrder(ctx, order.orderURL); err != nil {
+		return fmt.Errorf("order was not fulfilled: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: entry.Hostnames[0]},
+		DNSNames: entry.Hostnames,
+	}, key)
+	if err != nil {
+		return err
+	}
+
+	chain, _, err := order.client.CreateOrderCert(ctx, order.finalizeURL, csr, true)
+	if err != nil {
+		return fmt.Errorf("failed to finalize order: %w", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	var certPEM bytes.Buffer
+	for _, der := range chain {
+		if err := pem.Encode(&certPEM, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+			return err
+		}
+	}
+
+	cert := &structs.InlineCertificateConfigEntry{
+		Kind:           structs.InlineCertificate,
+		Name:           entry.Name,
+		Certificate:    certPEM.String(),
+		PrivateKey:     string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+		Meta:           map[string]string{structs.ACMECertificateMetaKey: entry.Name},
+		EnterpriseMeta: entry.EnterpriseMeta,
+	}
+	if existing != nil {
+		cert.RaftIndex = existing.RaftIndex
+	}
+	if err := cert.Normalize(); err != nil {
+		return err
+	}
+	if err := cert.Validate(); err != nil {
+		return fmt.Errorf("issued certificate is invalid: %w", err)
+	}
+	if err := r.updater.Update(cert); err != nil {
+		return err
+	}
+
+	delete(r.orders, requestForEntry(entry).Key())
+	return nil
+}
+
+// client returns the ACME client for the directory and contact email of the
+// acme-certificate, registering an account if necessary.
+func (r *acmeCertificateReconciler) client(ctx context.Context, entry *structs.ACMECertificateConfigEntry) (*acme.Client, error) {
+	clientKey := entry.DirectoryURL + "|" + entry.Email
+	if client, ok := r.clients[clientKey]; ok {
+		return client, nil
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	client := &acme.Client{
+		Key:          key,
+		DirectoryURL: entry.DirectoryURL,
+		HTTPClient:   r.httpClient,
+		UserAgent:    "consul",
+	}
+
+	account := &acme.Account{}
+	if entry.Email != "" {
+		account.Contact = []string{"mailto:" + entry.Email}
+	}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, fmt.Errorf("failed to register account: %w", err)
+	}
+
+	r.clients[clientKey] = client
+	return client, nil
+}
+
+// cleanupCertificate deletes the inline-certificate managed for a deleted
+// acme-certificate.
+func (r *acmeCertificateReconciler) cleanupCertificate(logger hclog.Logger, req controller.Request, cert *structs.InlineCertificateConfigEntry) error {
+	if cert == nil || cert.Meta[structs.ACMECertificateMetaKey] != req.Name {
+		return nil
+	}
+
+	logger.Debug("deleting certificate of removed acme-certificate")
+	return r.updater.Delete(cert)
+}
+
+// updateStatus sets the condition on the acme-certificate and clears any
+// pending challenges.
+func (r *acmeCertificateReconciler) updateStatus(entry *structs.ACMECertificateConfigEntry, condition structs.Condition) error {
+	return r.updateEntry(entry, nil, condition)
+}
+
+// updateEntry writes the challenges and condition to a copy of the
+// acme-certificate if either changed.
+func (r *acmeCertificateReconciler) updateEntry(entry *structs.ACMECertificateConfigEntry, challenges []structs.ACMEChallenge, condition structs.Condition) error {
+	updated := *entry
+	updated.Challenges = challenges
+
+	updater := structs.NewStatusUpdater(&updated)
+	updater.SetCondition(condition)
+	if _, ok := updater.UpdateEntry(); !ok && sameChallenges(entry.Challenges, challenges) {
+		return nil
+	}
+	return r.updater.UpdateWithStatus(&updated)
+}
+
+// certificateRenewalTime returns when the managed certificate must be renewed,
+// or false if it doesn't cover the hostnames of the acme-certificate.
+func certificateRenewalTime(entry *structs.ACMECertificateConfigEntry, cert *structs.InlineCertificateConfigEntry) (time.Time, bool) {
+	if cert == nil {
+		return time.Time{}, false
+	}
+
+	block, _ := pem.Decode([]byte(cert.Certificate))
+	if block == nil {
+		return time.Time{}, false
+	}
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+	for _, hostname := range entry.Hostnames {
+		if leaf.VerifyHostname(hostname) != nil {
+			return time.Time{}, false
+		}
+	}
+
+	return leaf.NotAfter.Add(-entry.RenewBefore), true
+}
+
+func sameChallenges(a, b []structs.ACMEChallenge) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func requestForEntry(entry structs.ConfigEntry) controller.Request {
+	return controller.Request{
+		Kind: structs.ACMECertificate,
+		Name: entry.GetName(),
+		Meta: entry.GetEnterpriseMeta(),
+	}
+}
+
+func acmeCertificateIssued(notAfter time.Time) structs.Condition {
+	return structs.NewACMECertificateCondition(
+		api.ACMECertificateConditionIssued,
+		api.ConditionStatusTrue,
+		api.ACMECertificateReasonIssued,
+		fmt.Sprintf("certificate issued, valid until %s", notAfter.UTC().Format(time.RFC3339)),
+	)
+}
+
+func acmeCertificatePending() structs.Condition {
+	return structs.NewACMECertificateCondition(
+		api.ACMECertificateConditionIssued,
+		api.ConditionStatusFalse,
+		api.ACMECertificateReasonPending,
+		"waiting for the certificate authority to validate challenges",
+	)
+}
+
+func acmeCertificateFailed(err error) structs.Condition {
+	return structs.NewACMECertificateCondition(
+		api.ACMECertificateConditionIssued,
+		api.ConditionStatusFalse,
+		api.ACMECertificateReasonFailed,
+		err.Error(),
+	)
+}
+
+func acmeCertificateConflict() structs.Condition {
+	return structs.NewACMECertificateCondition(
+		api.ACMECertificateConditionIssued,
+		api.ConditionStatusFalse,
+		api.ACMECertificateReasonCertificateConflict,
+		"an inline-certificate with the same name exists that is not managed by this acme-certificate",
+	)
+}
+
+// NewACMECertificateController creates a controller that obtains and renews
+// certificates for acme-certificate config entries.
+func NewACMECertificateController(fsm *fsm.FSM, publisher state.EventPublisher, updater *Updater, logger hclog.Logger) controller.Controller {
+	return newACMECertificateController(publisher, &acmeCertificateReconciler{
+		fsm:              fsm,
+		logger:           logger,
+		updater:          updater,
+		propagationDelay: acmeChallengePropagationDelay,
+		httpClient:       &http.Client{Timeout: acmeRequestTimeout},
+		orderTimeout:     acmeOrderTimeout,
+		requestTimeout:   acmeRequestTimeout,
+		retryBaseBackoff: acmeRetryBaseBackoff,
+		retryMaxBackoff:  acmeRetryMaxBackoff,
+	})
+}
+
+func newACMECertificateController(publisher state.EventPublisher, reconciler *acmeCertificateReconciler) controller.Controller {
+	reconciler.clients = make(map[string]*acme.Client)
+	reconciler.orders = make(map[string]*acmeOrderState)
+
+	c := controller.New(publisher, reconciler).
+		WithLogger(reconciler.logger.With("controller", "acmeCertificateController"))
+	return c.Subscribe(
+		&stream.SubscribeRequest{
+			Topic:   state.EventTopicACMECertificate,
+			Subject: stream.SubjectWildcard,
+		},
+	).Subscribe(
+		&stream.SubscribeRequest{
+			Topic:   state.EventTopicInlineCertificate,
+			Subject: stream.SubjectWildcard,
+		},
+		// Changes to an inline-certificate are reconciled through the
+		// acme-certificate of the same name.
+		func(entry structs.ConfigEntry) []controller.Request {
+			return []controller.Request{requestForEntry(entry)}
+		},
+	)
+}
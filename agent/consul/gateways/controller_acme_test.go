@@ -0,0 +1,689 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package gateways
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/acme"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/consul/controller"
+	"github.com/hashicorp/consul/agent/consul/fsm"
+	"github.com/hashicorp/consul/agent/consul/state"
+	"github.com/hashicorp/consul/agent/consul/stream"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+)
+
+func TestACMECertificateReconciler(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (*fsm.FSM, *Updater, *testACMEServer, *acmeCertificateReconciler) {
+		fsm, _, updater := newTestControllerFSM(t, context.Background())
+		server := newTestACMEServer(t, gatewayChallengeResponder(fsm))
+
+		reconciler := &acmeCertificateReconciler{
+			fsm:              fsm,
+			logger:           hclog.NewNullLogger(),
+			updater:          updater,
+			httpClient:       server.srv.Client(),
+			orderTimeout:     10 * time.Second,
+			requestTimeout:   10 * time.Second,
+			retryBaseBackoff: time.Minute,
+			retryMaxBackoff:  time.Hour,
+			clients:          make(map[string]*acme.Client),
+			orders:           make(map[string]*acmeOrderState),
+		}
+		return fsm, updater, server, reconciler
+	}
+
+	newEntry := func(server *testACMEServer) *structs.ACMECertificateConfigEntry {
+		entry := &structs.ACMECertificateConfigEntry{
+			Kind:         structs.ACMECertificate,
+			Name:         "certificate",
+			DirectoryURL: server.srv.URL + "/directory",
+			Email:        "admin@example.com",
+			Hostnames:    []string{"example.com", "www.example.com"},
+		}
+		require.NoError(t, entry.Normalize())
+		require.NoError(t, entry.Validate())
+		return entry
+	}
+
+	req := controller.Request{
+		Kind: structs.ACMECertificate,
+		Name: "certificate",
+		Meta: acl.DefaultEnterpriseMeta(),
+	}
+
+	t.Run("issues and renews certificate", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		fsm, updater, server, reconciler := setup(t)
+		require.NoError(t, updater.Update(newEntry(server)))
+
+		// The first pass publishes the challenges for gateways to serve.
+		requireRequeueAfter(t, reconciler.Reconcile(ctx, req))
+		entry := getACMECertificate(t, fsm)
+		require.Len(t, entry.Challenges, 2)
+		requireACMECondition(t, entry, api.ConditionStatusFalse, api.ACMECertificateReasonPending)
+
+		// The second pass has the challenges validated and stores the certificate.
+		err := reconciler.Reconcile(ctx, req)
+		require.Equal(t, controller.RequeueNow(), err)
+		cert := getInlineCertificate(t, fsm)
+		require.NotNil(t, cert)
+		require.Equal(t, "certificate", cert.Meta[structs.ACMECertificateMetaKey])
+
+		leaf := server.verify(t, cert)
+		require.ElementsMatch(t, []string{"example.com", "www.example.com"}, leaf.DNSNames)
+
+		// The third pass records the issued certificate and schedules renewal.
+		renewIn := requireRequeueAfter(t, reconciler.Reconcile(ctx, req))
+		require.InDelta(t, time.Until(leaf.NotAfter.Add(-structs.DefaultACMERenewBefore)), renewIn, float64(time.Minute))
+		entry = getACMECertificate(t, fsm)
+		require.Empty(t, entry.Challenges)
+		requireACMECondition(t, entry, api.ConditionStatusTrue, api.ACMECertificateReasonIssued)
+
+		// Reconciling an up to date certificate doesn't touch it.
+		requireRequeueAfter(t, reconciler.Reconcile(ctx, req))
+		require.Equal(t, cert.ModifyIndex, getInlineCertificate(t, fsm).ModifyIndex)
+
+		// Renewing before the certificate expires issues a new one.
+		entry.RenewBefore = 100 * 24 * time.Hour
+		require.NoError(t, entry.Normalize())
+		require.NoError(t, updater.Update(entry))
+
+		requireRequeueAfter(t, reconciler.Reconcile(ctx, req))
+		require.Len(t, getACMECertificate(t, fsm).Challenges, 2)
+		require.Equal(t, controller.RequeueNow(), reconciler.Reconcile(ctx, req))
+		require.NotEqual(t, cert.Certificate, getInlineCertificate(t, fsm).Certificate)
+	})
+
+	t.Run("failed validation", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		fsm, updater, server, reconciler := setup(t)
+		server.respond = func(string, string) string { return "not found" }
+		require.NoError(t, updater.Update(newEntry(server)))
+
+		requireRequeueAfter(t, reconciler.Reconcile(ctx, req))
+		backoff := requireRequeueAfter(t, reconciler.Reconcile(ctx, req))
+		require.Equal(t, time.Minute, backoff)
+
+		entry := getACMECertificate(t, fsm)
+		require.Empty(t, entry.Challenges)
+		requireACMECondition(t, entry, api.ConditionStatusFalse, api.ACMECertificateReasonFailed)
+		require.Nil(t, getInlineCertificate(t, fsm))
+
+		// Failures back off until the entry is modified.
+		require.Greater(t, requireRequeueAfter(t, reconciler.Reconcile(ctx, req)), 50*time.Second)
+
+		entry.Email = "ops@example.com"
+		require.NoError(t, entry.Normalize())
+		require.NoError(t, updater.Update(entry))
+		requireRequeueAfter(t, reconciler.Reconcile(ctx, req))
+		require.Len(t, getACMECertificate(t, fsm).Challenges, 2)
+	})
+
+	t.Run("unresponsive certificate authority", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		fsm, updater, server, reconciler := setup(t)
+		reconciler.requestTimeout = 100 * time.Millisecond
+
+		unblock := make(chan struct{})
+		hung := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-unblock:
+			}
+		}))
+		t.Cleanup(hung.Close)
+		t.Cleanup(func() { close(unblock) })
+
+		entry := newEntry(server)
+		entry.DirectoryURL = hung.URL + "/directory"
+		require.NoError(t, updater.Update(entry))
+
+		backoff := requireRequeueAfter(t, reconciler.Reconcile(ctx, req))
+		require.Equal(t, time.Minute, backoff)
+		requireACMECondition(t, getACMECertificate(t, fsm), api.ConditionStatusFalse, api.ACMECertificateReasonFailed)
+	})
+
+	t.Run("unmanaged certificate conflict", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		fsm, updater, server, reconciler := setup(t)
+		require.NoError(t, updater.Update(newEntry(server)))
+		require.NoError(t, updater.Update(&structs.InlineCertificateConfigEntry{
+			Kind:        structs.InlineCertificate,
+			Name:        "certificate",
+			Certificate: "cert",
+			PrivateKey:  "key",
+		}))
+
+		require.NoError(t, reconciler.Reconcile(ctx, req))
+		requireACMECondition(t, getACMECertificate(t, fsm), api.ConditionStatusFalse, api.ACMECertificateReasonCertificateConflict)
+		require.Equal(t, "cert", getInlineCertificate(t, fsm).Certificate)
+	})
+
+	t.Run("deleting the entry deletes its certificate", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		fsm, updater, server, reconciler := setup(t)
+		require.NoError(t, updater.Update(newEntry(server)))
+
+		requireRequeueAfter(t, reconciler.Reconcile(ctx, req))
+		require.Equal(t, controller.RequeueNow(), reconciler.Reconcile(ctx, req))
+		require.NotNil(t, getInlineCertificate(t, fsm))
+
+		require.NoError(t, updater.Delete(getACMECertificate(t, fsm)))
+		require.NoError(t, reconciler.Reconcile(ctx, req))
+		require.Nil(t, getInlineCertificate(t, fsm))
+	})
+}
+
+func TestACMECertificateController(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	fsm, publisher, updater := newTestControllerFSM(t, ctx)
+	server := newTestACMEServer(t, gatewayChallengeResponder(fsm))
+
+	c := newACMECertificateController(publisher, &acmeCertificateReconciler{
+		fsm:              fsm,
+		logger:           hclog.NewNullLogger(),
+		updater:          updater,
+		httpClient:       server.srv.Client(),
+		propagationDelay: 50 * time.Millisecond,
+		orderTimeout:     10 * time.Second,
+		requestTimeout:   10 * time.Second,
+		retryBaseBackoff: time.Minute,
+		retryMaxBackoff:  time.Hour,
+	})
+	go c.Run(ctx)
+
+	entry := &structs.ACMECertificateConfigEntry{
+		Kind:         structs.ACMECertificate,
+		Name:         "certificate",
+		DirectoryURL: server.srv.URL + "/directory",
+		Hostnames:    []string{"example.com"},
+	}
+	require.NoError(t, entry.Normalize())
+	require.NoError(t, updater.Update(entry))
+
+	require.Eventually(t, func() bool {
+		entry := getACMECertificate(t, fsm)
+		return entry.Status.MatchesConditionStatus(structs.Condition{
+			Type:   string(api.ACMECertificateConditionIssued),
+			Status: string(api.ConditionStatusTrue),
+		})
+	}, 10*time.Second, 50*time.Millisecond)
+
+	server.verify(t, getInlineCertificate(t, fsm))
+}
+
+// newTestControllerFSM returns an FSM, the publisher of its events and an
+// Updater that writes directly to its state store.
+func newTestControllerFSM(t *testing.T, ctx context.Context) (*fsm.FSM, *stream.EventPublisher, *Updater) {
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+
+	publisher := stream.NewEventPublisher(1 * time.Millisecond)
+	go publisher.Run(ctx)
+
+	fsm := fsm.NewFromDeps(fsm.Deps{
+		Logger: hclog.New(nil),
+		NewStateStore: func() *state.Store {
+			return state.NewStateStoreWithEventPublisher(nil, publisher)
+		},
+		Publisher:      publisher,
+		StorageBackend: fsm.NullStorageBackend,
+	})
+
+	var (
+		mu    sync.Mutex
+		index uint64
+	)
+	nextIndex := func() uint64 {
+		mu.Lock()
+		defer mu.Unlock()
+		index++
+		return index
+	}
+	updater := &Updater{
+		UpdateWithStatus: func(entry structs.ControlledConfigEntry) error {
+			_, err := fsm.State().EnsureConfigEntryWithStatusCAS(nextIndex(), entry.GetRaftIndex().ModifyIndex, entry)
+			return err
+		},
+		Update: func(entry structs.ConfigEntry) error {
+			_, err := fsm.State().EnsureConfigEntryCAS(nextIndex(), entry.GetRaftIndex().ModifyIndex, entry)
+			return err
+		},
+		Delete: func(entry structs.ConfigEntry) error {
+			_, err := fsm.State().DeleteConfigEntryCAS(nextIndex(), entry.GetRaftIndex().ModifyIndex, entry)
+			return err
+		},
+	}
+	return fsm, publisher, updater
+}
+
+// gatewayChallengeResponder responds to HTTP-01 challenges the way a gateway
+// would, from the challenges published on the acme-certificate.
+func gatewayChallengeResponder(fsm *fsm.FSM) func(hostname, token string) string {
+	return func(_, token string) string {
+		_, entries, err := fsm.State().ConfigEntriesByKind(nil, structs.ACMECertificate, acl.WildcardEnterpriseMeta())
+		if err != nil {
+			return ""
+		}
+		for _, entry := range entries {
+			for _, challenge := range entry.(*structs.ACMECertificateConfigEntry).Challenges {
+				if challenge.Token == token {
+					return challenge.KeyAuthorization
+				}
+			}
+		}
+		return ""
+	}
+}
+
+func getACMECertificate(t *testing.T, fsm *fsm.FSM) *structs.ACMECertificateConfigEntry {
+	t.Helper()
+
+	_, entry, err := fsm.State().ConfigEntry(nil, structs.ACMECertificate, "certificate", nil)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	copied := *entry.(*structs.ACMECertificateConfigEntry)
+	return &copied
+}
+
+func getInlineCertificate(t *testing.T, fsm *fsm.FSM) *structs.InlineCertificateConfigEntry {
+	t.Helper()
+
+	_, entry, err := fsm.State().ConfigEntry(nil, structs.InlineCertificate, "certificate", nil)
+	require.NoError(t, err)
+	if entry == nil {
+		return nil
+	}
+	return entry.(*structs.InlineCertificateConfigEntry)
+}
+
+func requireRequeueAfter(t *testing.T, err error) time.Duration {
+	t.Helper()
+
+	var requeue controller.RequeueAfterError
+	require.ErrorAs(t, err, &requeue)
+	return time.Duration(requeue)
+}
+
+func requireACMECondition(t *testing.T, entry *structs.ACMECertificateConfigEntry, status api.ConditionStatus, reason api.ACMECertificateConditionReason) {
+	t.Helper()
+
+	require.Len(t, entry.Status.Conditions, 1)
+	condition := entry.Status.Conditions[0]
+	require.Equal(t, string(api.ACMECertificateConditionIssued), condition.Type)
+	require.Equal(t, string(status), condition.Status)
+	require.Equal(t, string(reason), condition.Reason, condition.Message)
+}
+
+// testACMEServer is a minimal Pebble-style ACME certificate authority. It
+// issues certificates from an in-memory CA once every HTTP-01 challenge of
+// an order has been answered with the right key authorization by respond.
+// JWS signatures and nonces are not verified.
+type testACMEServer struct {
+	srv    *httptest.Server
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+
+	// respond returns the body a gateway serves for the HTTP-01 challenge
+	// token of the hostname.
+	respond func(hostname, token string) string
+
+	mu         sync.Mutex
+	nextID     int
+	accountKey *ecdsa.PublicKey
+	authzs     map[string]*testACMEAuthz
+	orders     map[string]*testACMEOrder
+	certs      map[string][]byte
+}
+
+type testACMEAuthz struct {
+	hostname string
+	token    string
+	status   string
+}
+
+type testACMEOrder struct {
+	hostnames []string
+	authzIDs  []string
+	certID    string
+}
+
+func newTestACMEServer(t *testing.T, respond func(hostname, token string) string) *testACMEServer {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ACME root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * 365 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, caKey.Public(), caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	s := &testACMEServer{
+		caKey:   caKey,
+		caCert:  caCert,
+		respond: respond,
+		authzs:  make(map[string]*testACMEAuthz),
+		orders:  make(map[string]*testACMEOrder),
+		certs:   make(map[string][]byte),
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+// verify checks that the inline-certificate was issued by the server and
+// returns its leaf certificate.
+func (s *testACMEServer) verify(t *testing.T, cert *structs.InlineCertificateConfigEntry) *x509.Certificate {
+	t.Helper()
+
+	require.NoError(t, cert.Validate())
+	block, _ := pem.Decode([]byte(cert.Certificate))
+	require.NotNil(t, block)
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(s.caCert)
+	_, err = leaf.Verify(x509.VerifyOptions{Roots: roots})
+	require.NoError(t, err)
+	return leaf
+}
+
+func (s *testACMEServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Header().Set("Replay-Nonce", fmt.Sprintf("nonce-%d", time.Now().UnixNano()))
+
+	path := strings.Trim(r.URL.Path, "/")
+	resource, id, _ := strings.Cut(path, "/")
+	switch resource {
+	case "directory":
+		s.writeJSON(w, http.StatusOK, map[string]string{
+			"newNonce":   s.url("nonce"),
+			"newAccount": s.url("account"),
+			"newOrder":   s.url("new-order"),
+			"revokeCert": s.url("revoke"),
+			"keyChange":  s.url("key-change"),
+		})
+	case "nonce":
+		w.WriteHeader(http.StatusOK)
+	case "account":
+		jwk, _ := s.readJWS(r, nil)
+		if jwk != nil {
+			s.accountKey = jwk
+		}
+		w.Header().Set("Location", s.url("account/1"))
+		s.writeJSON(w, http.StatusCreated, map[string]string{"status": "valid"})
+	case "new-order":
+		var payload struct {
+			Identifiers []acme.AuthzID `json:"identifiers"`
+		}
+		s.readJWS(r, &payload)
+
+		order := &testACMEOrder{}
+		for _, identifier := range payload.Identifiers {
+			authzID := s.newID()
+			s.authzs[authzID] = &testACMEAuthz{
+				hostname: identifier.Value,
+				token:    "token-" + authzID,
+				status:   acme.StatusPending,
+			}
+			order.hostnames = append(order.hostnames, identifier.Value)
+			order.authzIDs = append(order.authzIDs, authzID)
+		}
+		orderID := s.newID()
+		s.orders[orderID] = order
+
+		w.Header().Set("Location", s.url("order/"+orderID))
+		s.writeJSON(w, http.StatusCreated, s.orderJSON(orderID))
+	case "authz":
+		authz, ok := s.authzs[id]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, map[string]any{
+			"status":     authz.status,
+			"identifier": acme.AuthzID{Type: "dns", Value: authz.hostname},
+			"challenges": []any{s.challengeJSON(id)},
+		})
+	case "chal":
+		authz, ok := s.authzs[id]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		s.readJWS(r, nil)
+
+		expected, err := acme.JWKThumbprint(s.accountKey)
+		if err == nil && s.respond(authz.hostname, authz.token) == authz.token+"."+expected {
+			authz.status = acme.StatusValid
+		} else {
+			authz.status = acme.StatusInvalid
+		}
+		s.writeJSON(w, http.StatusOK, s.challengeJSON(id))
+	case "order":
+		if _, ok := s.orders[id]; !ok {
+			http.NotFound(w, r)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, s.orderJSON(id))
+	case "finalize":
+		order, ok := s.orders[id]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if s.orderStatus(order) != acme.StatusReady {
+			s.writeJSON(w, http.StatusForbidden, map[string]string{
+				"type":   "urn:ietf:params:acme:error:orderNotReady",
+				"detail": "order is not ready",
+			})
+			return
+		}
+
+		var payload struct {
+			CSR string `json:"csr"`
+		}
+		s.readJWS(r, &payload)
+		csrDER, err := base64.RawURLEncoding.DecodeString(payload.CSR)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		csr, err := x509.ParseCertificateRequest(csrDER)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		certID := s.newID()
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(int64(s.nextID)),
+			Subject:      pkix.Name{CommonName: order.hostnames[0]},
+			DNSNames:     order.hostnames,
+			NotBefore:    time.Now().Add(-time.Minute),
+			NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, s.caCert, csr.PublicKey, s.caKey)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		s.certs[certID] = der
+		order.certID = certID
+
+		w.Header().Set("Location", s.url("order/"+id))
+		s.writeJSON(w, http.StatusOK, s.orderJSON(id))
+	case "cert":
+		der, ok := s.certs[id]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/pem-certificate-chain")
+		w.WriteHeader(http.StatusOK)
+		pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+		pem.Encode(w, &pem.Block{Type: "CERTIFICATE", Bytes: s.caCert.Raw})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *testACMEServer) orderStatus(order *testACMEOrder) string {
+	if order.certID != "" {
+		return acme.StatusValid
+	}
+	status := acme.StatusReady
+	for _, authzID := range order.authzIDs {
+		switch s.authzs[authzID].status {
+		case acme.StatusInvalid:
+			return acme.StatusInvalid
+		case acme.StatusPending:
+			status = acme.StatusPending
+		}
+	}
+	return status
+}
+
+func (s *testACMEServer) orderJSON(id string) map[string]any {
+	order := s.orders[id]
+	var (
+		identifiers    []acme.AuthzID
+		authorizations []string
+	)
+	for i, hostname := range order.hostnames {
+		identifiers = append(identifiers, acme.AuthzID{Type: "dns", Value: hostname})
+		authorizations = append(authorizations, s.url("authz/"+order.authzIDs[i]))
+	}
+	v := map[string]any{
+		"status":         s.orderStatus(order),
+		"identifiers":    identifiers,
+		"authorizations": authorizations,
+		"finalize":       s.url("finalize/" + id),
+	}
+	if order.certID != "" {
+		v["certificate"] = s.url("cert/" + order.certID)
+	}
+	return v
+}
+
+func (s *testACMEServer) challengeJSON(authzID string) map[string]any {
+	authz := s.authzs[authzID]
+	return map[string]any{
+		"type":   "http-01",
+		"url":    s.url("chal/" + authzID),
+		"token":  authz.token,
+		"status": authz.status,
+	}
+}
+
+// readJWS decodes the payload of the flattened JWS request body into v and
+// returns the JWK from the protected header, if any.
+func (s *testACMEServer) readJWS(r *http.Request, v any) (*ecdsa.PublicKey, error) {
+	var body struct {
+		Protected string `json:"protected"`
+		Payload   string `json:"payload"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	if payload, err := base64.RawURLEncoding.DecodeString(body.Payload); err == nil && len(payload) > 0 && v != nil {
+		if err := json.Unmarshal(payload, v); err != nil {
+			return nil, err
+		}
+	}
+
+	protected, err := base64.RawURLEncoding.DecodeString(body.Protected)
+	if err != nil {
+		return nil, err
+	}
+	var header struct {
+		JWK *struct {
+			X string `json:"x"`
+			Y string `json:"y"`
+		} `json:"jwk"`
+	}
+	if err := json.Unmarshal(protected, &header); err != nil || header.JWK == nil {
+		return nil, err
+	}
+	x, err := base64.RawURLEncoding.DecodeString(header.JWK.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := base64.RawURLEncoding.DecodeString(header.JWK.Y)
+	if err != nil {
+		return nil, err
+	}
+	return &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}, nil
+}
+
+func (s *testACMEServer) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func (s *testACMEServer) url(path string) string {
+	return s.srv.URL + "/" + path
+}
+
+func (s *testACMEServer) newID() string {
+	s.nextID++
+	return fmt.Sprintf("%d", s.nextID)
+}
@@ -59,8 +59,12 @@ func (r *apiGatewayReconciler) Reconcile(ctx context.Context, req controller.Req
 		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileBoundGateway, r.cleanupBoundGateway)
 	case structs.HTTPRoute:
 		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileHTTPRoute, r.cleanupRoute)
+	case structs.GRPCRoute:
+		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileGRPCRoute, r.cleanupRoute)
 	case structs.TCPRoute:
 		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileTCPRoute, r.cleanupRoute)
+	case structs.TLSRoute:
+		return reconcileEntry(r.fsm.State(), r.logger, ctx, req, r.reconcileTLSRoute, r.cleanupRoute)
 	case structs.InlineCertificate, structs.FileSystemCertificate:
 		return r.enqueueCertificateReferencedGateways(r.fsm.State(), ctx, req)
 	case structs.JWTProvider:
@@ -477,7 +481,7 @@ func (r *apiGatewayReconciler) reconcileRoute(_ context.Context, req controller.
 			continue
 		}
 
-		if chain.Protocol != string(route.GetProtocol()) {
+		if !routeMatchesServiceProtocol(route, chain.Protocol) {
 			updater.SetCondition(routeInvalidDiscoveryChain(errInvalidProtocol))
 			continue
 		}
@@ -528,11 +532,21 @@ func (r *apiGatewayReconciler) reconcileHTTPRoute(ctx context.Context, req contr
 	return r.reconcileRoute(ctx, req, store, route)
 }
 
+// reconcileGRPCRoute is a thin wrapper around recnocileRoute for a GRPCRoutes
+func (r *apiGatewayReconciler) reconcileGRPCRoute(ctx context.Context, req controller.Request, store *state.Store, route *structs.GRPCRouteConfigEntry) error {
+	return r.reconcileRoute(ctx, req, store, route)
+}
+
 // reconcileTCPRoute is a thin wrapper around recnocileRoute for a TCPRoutes
 func (r *apiGatewayReconciler) reconcileTCPRoute(ctx context.Context, req controller.Request, store *state.Store, route *structs.TCPRouteConfigEntry) error {
 	return r.reconcileRoute(ctx, req, store, route)
 }
 
+// reconcileTLSRoute is a thin wrapper around recnocileRoute for a TLSRoutes
+func (r *apiGatewayReconciler) reconcileTLSRoute(ctx context.Context, req controller.Request, store *state.Store, route *structs.TLSRouteConfigEntry) error {
+	return r.reconcileRoute(ctx, req, store, route)
+}
+
 // NewAPIGatewayController initializes a controller that reconciles all APIGateway objects
 func NewAPIGatewayController(fsm *fsm.FSM, publisher state.EventPublisher, updater *Updater, logger hclog.Logger) controller.Controller {
 	reconciler := &apiGatewayReconciler{
@@ -552,11 +566,21 @@ func NewAPIGatewayController(fsm *fsm.FSM, publisher state.EventPublisher, updat
 			Topic:   state.EventTopicHTTPRoute,
 			Subject: stream.SubjectWildcard,
 		},
+	).Subscribe(
+		&stream.SubscribeRequest{
+			Topic:   state.EventTopicGRPCRoute,
+			Subject: stream.SubjectWildcard,
+		},
 	).Subscribe(
 		&stream.SubscribeRequest{
 			Topic:   state.EventTopicTCPRoute,
 			Subject: stream.SubjectWildcard,
 		},
+	).Subscribe(
+		&stream.SubscribeRequest{
+			Topic:   state.EventTopicTLSRoute,
+			Subject: stream.SubjectWildcard,
+		},
 	).Subscribe(
 		&stream.SubscribeRequest{
 			Topic:   state.EventTopicBoundAPIGateway,
@@ -693,7 +717,7 @@ func (g *gatewayMeta) updateRouteBinding(route structs.BoundRoute) (bool, []stru
 			}
 
 			isValidJWT := true
-			if httpRoute, ok := route.(*structs.HTTPRouteConfigEntry); ok {
+			if httpRoute := toHTTPRoute(route); httpRoute != nil {
 				var jwtErrors map[structs.ResourceReference]error
 				isValidJWT, jwtErrors = g.validateJWTForRoute(httpRoute)
 				for ref, err := range jwtErrors {
@@ -764,7 +788,7 @@ func (g *gatewayMeta) bindRoute(listener *structs.APIGatewayListener, bound *str
 		return false, fmt.Errorf("failed to bind route to gateway %s: route has not been accepted", g.Gateway.Name)
 	}
 
-	if route, ok := route.(*structs.HTTPRouteConfigEntry); ok {
+	if route := toHTTPRoute(route); route != nil {
 		// check our hostnames
 		hostnames := route.FilteredHostnames(listener.GetHostname())
 		if len(hostnames) == 0 {
@@ -772,7 +796,25 @@ func (g *gatewayMeta) bindRoute(listener *structs.APIGatewayListener, bound *str
 		}
 	}
 
-	if listener.Protocol == route.GetProtocol() && bound.BindRoute(structs.ResourceReference{
+	if route, ok := route.(*structs.TLSRouteConfigEntry); ok {
+		// check our SNI hostnames
+		hostnames := route.FilteredHostnames(listener.GetHostname())
+		if len(hostnames) == 0 {
+			return false, fmt.Errorf("failed to bind route to gateway %s: listener %s is does not have any hostnames that match the route", g.Gateway.Name, listener.Name)
+		}
+	}
+
+	if !listener.AcceptsRouteKind(route.GetKind()) {
+		if ref.SectionName != "" {
+			if listener.TLS.Mode == structs.APIGatewayTLSModePassthrough {
+				return false, fmt.Errorf("failed to bind route %s to gateway %s: listener %s only accepts tls-route", route.GetName(), g.Gateway.Name, bound.Name)
+			}
+			return false, fmt.Errorf("failed to bind route %s to gateway %s: listener %s is not a TLS passthrough listener", route.GetName(), g.Gateway.Name, bound.Name)
+		}
+		return false, nil
+	}
+
+	if listener.Protocol.AcceptsRoute(route.GetProtocol()) && bound.BindRoute(structs.ResourceReference{
 		Kind:           route.GetKind(),
 		Name:           route.GetName(),
 		EnterpriseMeta: *route.GetEnterpriseMeta(),
@@ -787,6 +829,30 @@ func (g *gatewayMeta) bindRoute(listener *structs.APIGatewayListener, bound *str
 	return false, nil
 }
 
+// toHTTPRoute returns the HTTPRoute equivalent of the given route, or nil if
+// the route is not HTTP-based. GRPCRoutes are converted so that they share the
+// hostname and JWT validation of HTTPRoutes.
+func toHTTPRoute(route structs.BoundRoute) *structs.HTTPRouteConfigEntry {
+	switch route := route.(type) {
+	case *structs.HTTPRouteConfigEntry:
+		return route
+	case *structs.GRPCRouteConfigEntry:
+		return route.ToHTTPRoute()
+	default:
+		return nil
+	}
+}
+
+// routeMatchesServiceProtocol returns whether a service using the given
+// protocol can be targeted by the route. gRPC runs over HTTP/2, so GRPCRoutes
+// accept services using either protocol.
+func routeMatchesServiceProtocol(route structs.BoundRoute, protocol string) bool {
+	if route.GetProtocol() == structs.ListenerProtocolGRPC {
+		return protocol == "grpc" || protocol == "http2"
+	}
+	return protocol == string(route.GetProtocol())
+}
+
 // unbindRoute takes a route and unbinds it from all of the listeners on a gateway.
 // It returns true if the route was unbound and false if it was not.
 func (g *gatewayMeta) unbindRoute(route structs.ResourceReference) bool {
@@ -1168,28 +1234,46 @@ func requestToResourceRef(req controller.Request) structs.ResourceReference {
 	return ref
 }
 
-// retrieveAllRoutesFromStore retrieves all HTTP and TCP routes from the given store
+// retrieveAllRoutesFromStore retrieves all HTTP, gRPC, TCP and TLS routes from the given store
 func retrieveAllRoutesFromStore(store *state.Store) ([]structs.BoundRoute, error) {
 	_, httpRoutes, err := store.ConfigEntriesByKind(nil, structs.HTTPRoute, wildcardMeta())
 	if err != nil {
 		return nil, err
 	}
 
+	_, grpcRoutes, err := store.ConfigEntriesByKind(nil, structs.GRPCRoute, wildcardMeta())
+	if err != nil {
+		return nil, err
+	}
+
 	_, tcpRoutes, err := store.ConfigEntriesByKind(nil, structs.TCPRoute, wildcardMeta())
 	if err != nil {
 		return nil, err
 	}
 
-	routes := make([]structs.BoundRoute, 0, len(tcpRoutes)+len(httpRoutes))
+	_, tlsRoutes, err := store.ConfigEntriesByKind(nil, structs.TLSRoute, wildcardMeta())
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]structs.BoundRoute, 0, len(tcpRoutes)+len(httpRoutes)+len(grpcRoutes)+len(tlsRoutes))
 
 	for _, route := range httpRoutes {
 		routes = append(routes, route.(*structs.HTTPRouteConfigEntry))
 	}
 
+	for _, route := range grpcRoutes {
+		routes = append(routes, route.(*structs.GRPCRouteConfigEntry))
+	}
+
 	for _, route := range tcpRoutes {
 		routes = append(routes, route.(*structs.TCPRouteConfigEntry))
 	}
 
+	for _, route := range tlsRoutes {
+		routes = append(routes, route.(*structs.TLSRouteConfigEntry))
+	}
+
 	return routes, nil
 }
 
@@ -1302,6 +1302,369 @@ func TestBindRoutesToGateways(t *testing.T) {
 				}: fmt.Errorf("failed to bind route TCP Route to gateway Gateway: listener Listener is not a tcp listener"),
 			},
 		},
+		"gRPC Route binds to a listener with an HTTP/2 protocol": {
+			gateways: []*gatewayMeta{
+				{
+					BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.BoundAPIGatewayListener{
+							{
+								Name: "Listener",
+							},
+						},
+					},
+					Gateway: &structs.APIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.APIGatewayListener{
+							{
+								Name:     "Listener",
+								Protocol: structs.ListenerProtocolHTTP2,
+							},
+						},
+						Status: structs.Status{
+							Conditions: []structs.Condition{
+								gatewayAccepted(),
+							},
+						},
+					},
+				},
+			},
+			routes: []structs.BoundRoute{
+				&structs.GRPCRouteConfigEntry{
+					Kind: structs.GRPCRoute,
+					Name: "gRPC Route",
+					Parents: []structs.ResourceReference{
+						{
+							Name:        "Gateway",
+							Kind:        structs.APIGateway,
+							SectionName: "Listener",
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							routeAccepted(),
+						},
+					},
+				},
+			},
+			expectedBoundAPIGateways: []*structs.BoundAPIGatewayConfigEntry{
+				{
+					Name: "Gateway",
+					Listeners: []structs.BoundAPIGatewayListener{
+						{
+							Name: "Listener",
+							Routes: []structs.ResourceReference{
+								{
+									Name:        "gRPC Route",
+									Kind:        structs.GRPCRoute,
+									SectionName: "",
+								},
+							},
+						},
+					},
+					Services: make(structs.ServiceRouteReferences),
+				},
+			},
+			expectedReferenceErrors: map[structs.ResourceReference]error{},
+		},
+		"gRPC Route cannot be bound to a listener with an HTTP protocol": {
+			gateways: []*gatewayMeta{
+				{
+					BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.BoundAPIGatewayListener{
+							{
+								Name:   "Listener",
+								Routes: []structs.ResourceReference{},
+							},
+						},
+					},
+					Gateway: &structs.APIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.APIGatewayListener{
+							{
+								Name:     "Listener",
+								Protocol: structs.ListenerProtocolHTTP,
+							},
+						},
+						Status: structs.Status{
+							Conditions: []structs.Condition{
+								gatewayAccepted(),
+							},
+						},
+					},
+				},
+			},
+			routes: []structs.BoundRoute{
+				&structs.GRPCRouteConfigEntry{
+					Name: "gRPC Route",
+					Kind: structs.GRPCRoute,
+					Parents: []structs.ResourceReference{
+						{
+							Name:        "Gateway",
+							Kind:        structs.APIGateway,
+							SectionName: "Listener",
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							routeAccepted(),
+						},
+					},
+				},
+			},
+			expectedBoundAPIGateways: []*structs.BoundAPIGatewayConfigEntry{},
+			expectedReferenceErrors: map[structs.ResourceReference]error{
+				{
+					Name:        "Gateway",
+					Kind:        structs.APIGateway,
+					SectionName: "Listener",
+				}: fmt.Errorf("failed to bind route gRPC Route to gateway Gateway: listener Listener is not a grpc listener"),
+			},
+		},
+		"TLS Route binds to a passthrough listener": {
+			gateways: []*gatewayMeta{
+				{
+					BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.BoundAPIGatewayListener{
+							{
+								Name: "Listener",
+							},
+						},
+					},
+					Gateway: &structs.APIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.APIGatewayListener{
+							{
+								Name:     "Listener",
+								Protocol: structs.ListenerProtocolTCP,
+								Hostname: "*.example.com",
+								TLS: structs.APIGatewayTLSConfiguration{
+									Mode: structs.APIGatewayTLSModePassthrough,
+								},
+							},
+						},
+						Status: structs.Status{
+							Conditions: []structs.Condition{
+								gatewayAccepted(),
+							},
+						},
+					},
+				},
+			},
+			routes: []structs.BoundRoute{
+				&structs.TLSRouteConfigEntry{
+					Kind:      structs.TLSRoute,
+					Name:      "TLS Route",
+					Hostnames: []string{"foo.example.com"},
+					Parents: []structs.ResourceReference{
+						{
+							Name:        "Gateway",
+							Kind:        structs.APIGateway,
+							SectionName: "Listener",
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							routeAccepted(),
+						},
+					},
+				},
+			},
+			expectedBoundAPIGateways: []*structs.BoundAPIGatewayConfigEntry{
+				{
+					Name: "Gateway",
+					Listeners: []structs.BoundAPIGatewayListener{
+						{
+							Name: "Listener",
+							Routes: []structs.ResourceReference{
+								{
+									Name:        "TLS Route",
+									Kind:        structs.TLSRoute,
+									SectionName: "",
+								},
+							},
+						},
+					},
+					Services: make(structs.ServiceRouteReferences),
+				},
+			},
+			expectedReferenceErrors: map[structs.ResourceReference]error{},
+		},
+		"TLS Route cannot be bound to a passthrough listener without matching hostnames": {
+			gateways: []*gatewayMeta{
+				{
+					BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.BoundAPIGatewayListener{
+							{
+								Name:   "Listener",
+								Routes: []structs.ResourceReference{},
+							},
+						},
+					},
+					Gateway: &structs.APIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.APIGatewayListener{
+							{
+								Name:     "Listener",
+								Protocol: structs.ListenerProtocolTCP,
+								Hostname: "*.example.com",
+								TLS: structs.APIGatewayTLSConfiguration{
+									Mode: structs.APIGatewayTLSModePassthrough,
+								},
+							},
+						},
+						Status: structs.Status{
+							Conditions: []structs.Condition{
+								gatewayAccepted(),
+							},
+						},
+					},
+				},
+			},
+			routes: []structs.BoundRoute{
+				&structs.TLSRouteConfigEntry{
+					Kind:      structs.TLSRoute,
+					Name:      "TLS Route",
+					Hostnames: []string{"foo.example.org"},
+					Parents: []structs.ResourceReference{
+						{
+							Name:        "Gateway",
+							Kind:        structs.APIGateway,
+							SectionName: "Listener",
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							routeAccepted(),
+						},
+					},
+				},
+			},
+			expectedBoundAPIGateways: []*structs.BoundAPIGatewayConfigEntry{},
+			expectedReferenceErrors: map[structs.ResourceReference]error{
+				{
+					Name:        "Gateway",
+					Kind:        structs.APIGateway,
+					SectionName: "Listener",
+				}: fmt.Errorf("failed to bind route to gateway Gateway: listener Listener is does not have any hostnames that match the route"),
+			},
+		},
+		"TLS Route cannot be bound to a TCP listener that terminates TLS": {
+			gateways: []*gatewayMeta{
+				{
+					BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.BoundAPIGatewayListener{
+							{
+								Name:   "Listener",
+								Routes: []structs.ResourceReference{},
+							},
+						},
+					},
+					Gateway: &structs.APIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.APIGatewayListener{
+							{
+								Name:     "Listener",
+								Protocol: structs.ListenerProtocolTCP,
+							},
+						},
+						Status: structs.Status{
+							Conditions: []structs.Condition{
+								gatewayAccepted(),
+							},
+						},
+					},
+				},
+			},
+			routes: []structs.BoundRoute{
+				&structs.TLSRouteConfigEntry{
+					Kind: structs.TLSRoute,
+					Name: "TLS Route",
+					Parents: []structs.ResourceReference{
+						{
+							Name:        "Gateway",
+							Kind:        structs.APIGateway,
+							SectionName: "Listener",
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							routeAccepted(),
+						},
+					},
+				},
+			},
+			expectedBoundAPIGateways: []*structs.BoundAPIGatewayConfigEntry{},
+			expectedReferenceErrors: map[structs.ResourceReference]error{
+				{
+					Name:        "Gateway",
+					Kind:        structs.APIGateway,
+					SectionName: "Listener",
+				}: fmt.Errorf("failed to bind route TLS Route to gateway Gateway: listener Listener is not a TLS passthrough listener"),
+			},
+		},
+		"TCP Route cannot be bound to a passthrough listener": {
+			gateways: []*gatewayMeta{
+				{
+					BoundGateway: &structs.BoundAPIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.BoundAPIGatewayListener{
+							{
+								Name:   "Listener",
+								Routes: []structs.ResourceReference{},
+							},
+						},
+					},
+					Gateway: &structs.APIGatewayConfigEntry{
+						Name: "Gateway",
+						Listeners: []structs.APIGatewayListener{
+							{
+								Name:     "Listener",
+								Protocol: structs.ListenerProtocolTCP,
+								TLS: structs.APIGatewayTLSConfiguration{
+									Mode: structs.APIGatewayTLSModePassthrough,
+								},
+							},
+						},
+						Status: structs.Status{
+							Conditions: []structs.Condition{
+								gatewayAccepted(),
+							},
+						},
+					},
+				},
+			},
+			routes: []structs.BoundRoute{
+				&structs.TCPRouteConfigEntry{
+					Kind: structs.TCPRoute,
+					Name: "TCP Route",
+					Parents: []structs.ResourceReference{
+						{
+							Name:        "Gateway",
+							Kind:        structs.APIGateway,
+							SectionName: "Listener",
+						},
+					},
+					Status: structs.Status{
+						Conditions: []structs.Condition{
+							routeAccepted(),
+						},
+					},
+				},
+			},
+			expectedBoundAPIGateways: []*structs.BoundAPIGatewayConfigEntry{},
+			expectedReferenceErrors: map[structs.ResourceReference]error{
+				{
+					Name:        "Gateway",
+					Kind:        structs.APIGateway,
+					SectionName: "Listener",
+				}: fmt.Errorf("failed to bind route TCP Route to gateway Gateway: listener Listener only accepts tls-route"),
+			},
+		},
 		"If a route/listener protocol mismatch occurs with the wildcard, but a bind to another listener was possible, no error is returned": {
 			gateways: []*gatewayMeta{
 				{
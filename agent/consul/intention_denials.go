@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// maxIntentionDenials bounds the number of distinct source, destination and
+// intention combinations tracked. The least recently seen combination is
+// evicted once the limit is reached.
+const maxIntentionDenials = 4096
+
+type intentionDenialKey struct {
+	sourceName, sourceNS, sourcePartition, sourcePeer    string
+	destinationName, destinationNS, destinationPartition string
+	intention, intentionPeer                             string
+}
+
+// intentionDenialTracker aggregates the intention denials reported by
+// proxies. Denials are only held in memory.
+type intentionDenialTracker struct {
+	limit int
+
+	lock    sync.Mutex
+	denials map[intentionDenialKey]*structs.IntentionDenialSummary
+}
+
+func newIntentionDenialTracker(limit int) *intentionDenialTracker {
+	return &intentionDenialTracker{
+		limit:   limit,
+		denials: make(map[intentionDenialKey]*structs.IntentionDenialSummary),
+	}
+}
+
+// normalizeIntentionDenial fills in the source from SourceURI and checks that
+// the denial identifies its destination and, if set, a deny intention.
+func normalizeIntentionDenial(d *structs.IntentionDenial) error {
+	if d.DestinationName == "" {
+		return fmt.Errorf("DestinationName is required")
+	}
+
+	if d.SourceName == "" {
+		if d.SourceURI == "" {
+			return fmt.Errorf("one of SourceName or SourceURI is required")
+		}
+		uri, err := connect.ParseCertURIFromString(d.SourceURI)
+		if err != nil {
+			return fmt.Errorf("invalid SourceURI %q: %w", d.SourceURI, err)
+		}
+		svc, ok := uri.(*connect.SpiffeIDService)
+		if !ok {
+			return fmt.Errorf("SourceURI %q does not identify a service", d.SourceURI)
+		}
+		entMeta := svc.GetEnterpriseMeta()
+		d.SourceName = svc.Service
+		d.SourceNS = entMeta.NamespaceOrEmpty()
+		d.SourcePartition = entMeta.PartitionOrEmpty()
+	}
+
+	if d.PolicyID != "" {
+		if _, _, ok := structs.ParseIntentionDenyPolicyID(d.PolicyID); !ok {
+			return fmt.Errorf("PolicyID %q was not generated for a deny intention", d.PolicyID)
+		}
+	}
+	return nil
+}
+
+// Record adds a denial that was already normalized. now is used if the
+// denial has no timestamp.
+func (t *intentionDenialTracker) Record(d structs.IntentionDenial, now time.Time) {
+	key := intentionDenialKey{
+		sourceName:           d.SourceName,
+		sourceNS:             d.SourceNS,
+		sourcePartition:      d.SourcePartition,
+		sourcePeer:           d.SourcePeer,
+		destinationName:      d.DestinationName,
+		destinationNS:        d.DestinationNS,
+		destinationPartition: d.DestinationPartition,
+	}
+	if src, peer, ok := structs.ParseIntentionDenyPolicyID(d.PolicyID); ok {
+		key.intention = src.String()
+		key.intentionPeer = peer
+	}
+
+	seen := d.Timestamp
+	if seen.IsZero() {
+		seen = now
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	summary, ok := t.denials[key]
+	if !ok {
+		if len(t.denials) >= t.limit {
+			t.evictOldestLocked()
+		}
+		summary = &structs.IntentionDenialSummary{
+			SourceName:           key.sourceName,
+			SourceNS:             key.sourceNS,
+			SourcePartition:      key.sourcePartition,
+			SourcePeer:           key.sourcePeer,
+			DestinationName:      key.destinationName,
+			DestinationNS:        key.destinationNS,
+			DestinationPartition: key.destinationPartition,
+			Intention:            key.intention,
+			IntentionPeer:        key.intentionPeer,
+			FirstSeen:            seen,
+			LastSeen:             seen,
+		}
+		t.denials[key] = summary
+	}
+
+	summary.Count++
+	if seen.Before(summary.FirstSeen) {
+		summary.FirstSeen = seen
+	}
+	if seen.After(summary.LastSeen) {
+		summary.LastSeen = seen
+	}
+}
+
+func (t *intentionDenialTracker) evictOldestLocked() {
+	var (
+		oldestKey intentionDenialKey
+		oldest    time.Time
+		found     bool
+	)
+	for key, summary := range t.denials {
+		if !found || summary.LastSeen.Before(oldest) {
+			oldestKey, oldest, found = key, summary.LastSeen, true
+		}
+	}
+	if found {
+		delete(t.denials, oldestKey)
+	}
+}
+
+// List returns a copy of the aggregated denials, most recently seen first.
+func (t *intentionDenialTracker) List() []structs.IntentionDenialSummary {
+	t.lock.Lock()
+	out := make([]structs.IntentionDenialSummary, 0, len(t.denials))
+	for _, summary := range t.denials {
+		out = append(out, *summary)
+	}
+	t.lock.Unlock()
+
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].LastSeen.Equal(out[j].LastSeen) {
+			return out[i].LastSeen.After(out[j].LastSeen)
+		}
+		if out[i].DestinationName != out[j].DestinationName {
+			return out[i].DestinationName < out[j].DestinationName
+		}
+		return out[i].SourceName < out[j].SourceName
+	})
+	return out
+}
+
+// Reset drops all the aggregated denials.
+func (t *intentionDenialTracker) Reset() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.denials = make(map[intentionDenialKey]*structs.IntentionDenialSummary)
+}
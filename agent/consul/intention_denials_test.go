@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package consul
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestIntentionDenialTracker(t *testing.T) {
+	tracker := newIntentionDenialTracker(2)
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	denyWeb := structs.IntentionDenyPolicyID(structs.NewServiceName("web", nil), "")
+	tracker.Record(structs.IntentionDenial{SourceName: "web", DestinationName: "db", PolicyID: denyWeb}, start)
+	tracker.Record(structs.IntentionDenial{SourceName: "web", DestinationName: "db", PolicyID: denyWeb}, start.Add(time.Minute))
+	tracker.Record(structs.IntentionDenial{SourceName: "api", DestinationName: "db", SourcePeer: "peer1"}, start.Add(2*time.Minute))
+
+	denials := tracker.List()
+	require.Equal(t, []structs.IntentionDenialSummary{
+		{
+			SourceName:      "api",
+			SourcePeer:      "peer1",
+			DestinationName: "db",
+			Count:           1,
+			FirstSeen:       start.Add(2 * time.Minute),
+			LastSeen:        start.Add(2 * time.Minute),
+		},
+		{
+			SourceName:      "web",
+			DestinationName: "db",
+			Intention:       "web",
+			Count:           2,
+			FirstSeen:       start,
+			LastSeen:        start.Add(time.Minute),
+		},
+	}, denials)
+
+	// The least recently seen denial is evicted once the limit is reached.
+	tracker.Record(structs.IntentionDenial{SourceName: "cache", DestinationName: "db"}, start.Add(3*time.Minute))
+	denials = tracker.List()
+	require.Len(t, denials, 2)
+	require.Equal(t, "cache", denials[0].SourceName)
+	require.Equal(t, "api", denials[1].SourceName)
+
+	tracker.Reset()
+	require.Empty(t, tracker.List())
+}
+
+func TestNormalizeIntentionDenial(t *testing.T) {
+	cases := map[string]struct {
+		denial   structs.IntentionDenial
+		expected structs.IntentionDenial
+		err      string
+	}{
+		"source from uri": {
+			denial: structs.IntentionDenial{
+				SourceURI:       "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web",
+				DestinationName: "db",
+			},
+			expected: structs.IntentionDenial{
+				SourceName:      "web",
+				SourceURI:       "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web",
+				DestinationName: "db",
+			},
+		},
+		"missing destination": {
+			denial: structs.IntentionDenial{SourceName: "web"},
+			err:    "DestinationName is required",
+		},
+		"missing source": {
+			denial: structs.IntentionDenial{DestinationName: "db"},
+			err:    "one of SourceName or SourceURI is required",
+		},
+		"uri is not a service": {
+			denial: structs.IntentionDenial{
+				SourceURI:       "spiffe://11111111-2222-3333-4444-555555555555.consul/agent/client/dc/dc1/id/123",
+				DestinationName: "db",
+			},
+			err: "does not identify a service",
+		},
+		"unknown policy": {
+			denial: structs.IntentionDenial{
+				SourceName:      "web",
+				DestinationName: "db",
+				PolicyID:        "consul-intentions-layer4",
+			},
+			err: "was not generated for a deny intention",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := normalizeIntentionDenial(&tc.denial)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, tc.denial)
+		})
+	}
+}
@@ -785,6 +785,91 @@ func (s *Intention) Check(args *structs.IntentionQueryRequest, reply *structs.In
 	return nil
 }
 
+// ReportDenials records denials observed by proxies. Reports are forwarded
+// to the leader which aggregates them in memory.
+func (s *Intention) ReportDenials(args *structs.IntentionDenialsReportRequest, reply *struct{}) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	if done, err := s.srv.ForwardRPC("Intention.ReportDenials", args, reply); done {
+		return err
+	}
+
+	authz, err := s.srv.ResolveToken(args.Token)
+	if err != nil {
+		return err
+	}
+
+	for i := range args.Denials {
+		denial := &args.Denials[i]
+		if err := normalizeIntentionDenial(denial); err != nil {
+			return fmt.Errorf("Invalid denial at index %d: %w", i, err)
+		}
+
+		// Denials are reported by the destination's proxy, so require the
+		// same permission that proxy needs.
+		var authzContext acl.AuthorizerContext
+		dst := structs.IntentionDenialSummary{
+			DestinationName:      denial.DestinationName,
+			DestinationNS:        denial.DestinationNS,
+			DestinationPartition: denial.DestinationPartition,
+		}
+		dstName := dst.DestinationServiceName()
+		dstName.FillAuthzContext(&authzContext)
+		if err := authz.ToAllowAuthorizer().ServiceWriteAllowed(denial.DestinationName, &authzContext); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	for _, denial := range args.Denials {
+		s.srv.intentionDenials.Record(denial, now)
+	}
+	return nil
+}
+
+// Denials returns the denials reported to the leader, filtered to the
+// destinations the token can read intentions for.
+func (s *Intention) Denials(args *structs.IntentionDenialsRequest, reply *structs.IndexedIntentionDenials) error {
+	// Exit early if Connect hasn't been enabled.
+	if !s.srv.config.ConnectEnabled {
+		return ErrConnectNotEnabled
+	}
+
+	if done, err := s.srv.ForwardRPC("Intention.Denials", args, reply); done {
+		return err
+	}
+
+	authz, err := s.srv.ResolveTokenAndDefaultMeta(args.Token, &args.EnterpriseMeta, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := s.srv.validateEnterpriseRequest(&args.EnterpriseMeta, false); err != nil {
+		return err
+	}
+
+	reply.Denials = make([]structs.IntentionDenialSummary, 0)
+	for _, denial := range s.srv.intentionDenials.List() {
+		dstName := denial.DestinationServiceName()
+		if !args.EnterpriseMeta.Matches(&dstName.EnterpriseMeta) {
+			continue
+		}
+
+		var authzContext acl.AuthorizerContext
+		dstName.FillAuthzContext(&authzContext)
+		if authz.IntentionRead(denial.DestinationName, &authzContext) != acl.Allow {
+			reply.QueryMeta.ResultsFilteredByACLs = true
+			continue
+		}
+		reply.Denials = append(reply.Denials, denial)
+	}
+	s.srv.SetQueryMeta(&reply.QueryMeta, args.Token)
+	return nil
+}
+
 func (s *Intention) validateEnterpriseIntention(ixn *structs.Intention) error {
 	if err := s.srv.validateEnterpriseIntentionPartition(ixn.SourcePartition); err != nil {
 		return fmt.Errorf("Invalid source partition %q: %v", ixn.SourcePartition, err)
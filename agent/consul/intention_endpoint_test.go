@@ -2203,3 +2203,99 @@ func TestEqualStringMaps(t *testing.T) {
 		}
 	}
 }
+
+func TestIntention_Denials(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	_, srv, codec := testACLServerWithConfig(t, nil, false)
+	waitForLeaderEstablishment(t, srv)
+
+	dbToken, err := upsertTestTokenWithPolicyRules(codec, TestDefaultInitialManagementToken, "dc1", `service "db" { policy = "write" }`)
+	require.NoError(t, err)
+	readToken, err := upsertTestTokenWithPolicyRules(codec, TestDefaultInitialManagementToken, "dc1", `service "db" { policy = "read" intentions = "read" }`)
+	require.NoError(t, err)
+	webToken, err := upsertTestTokenWithPolicyRules(codec, TestDefaultInitialManagementToken, "dc1", `service "web" { policy = "write" }`)
+	require.NoError(t, err)
+
+	report := func(token string, denials ...structs.IntentionDenial) error {
+		args := structs.IntentionDenialsReportRequest{
+			Datacenter:   "dc1",
+			Denials:      denials,
+			WriteRequest: structs.WriteRequest{Token: token},
+		}
+		var reply struct{}
+		return msgpackrpc.CallWithCodec(codec, "Intention.ReportDenials", &args, &reply)
+	}
+
+	testutil.RunStep(t, "report", func(t *testing.T) {
+		err := report(dbToken.SecretID,
+			structs.IntentionDenial{
+				SourceName:      "web",
+				DestinationName: "db",
+				PolicyID:        structs.IntentionDenyPolicyID(structs.NewServiceName("web", nil), ""),
+			},
+			structs.IntentionDenial{
+				SourceURI:       "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web",
+				DestinationName: "db",
+				PolicyID:        structs.IntentionDenyPolicyID(structs.NewServiceName("web", nil), ""),
+			},
+			structs.IntentionDenial{
+				SourceName:      "api",
+				DestinationName: "db",
+			},
+		)
+		require.NoError(t, err)
+	})
+
+	testutil.RunStep(t, "report requires service write on the destination", func(t *testing.T) {
+		err := report(dbToken.SecretID, structs.IntentionDenial{
+			SourceName:      "web",
+			DestinationName: "cache",
+		})
+		require.True(t, acl.IsErrPermissionDenied(err), "unexpected error: %v", err)
+	})
+
+	testutil.RunStep(t, "report rejects unknown policies", func(t *testing.T) {
+		err := report(dbToken.SecretID, structs.IntentionDenial{
+			SourceName:      "web",
+			DestinationName: "db",
+			PolicyID:        "consul-intentions-layer4",
+		})
+		require.ErrorContains(t, err, "was not generated for a deny intention")
+	})
+
+	testutil.RunStep(t, "list", func(t *testing.T) {
+		args := structs.IntentionDenialsRequest{
+			Datacenter:   "dc1",
+			QueryOptions: structs.QueryOptions{Token: readToken.SecretID},
+		}
+		var reply structs.IndexedIntentionDenials
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Intention.Denials", &args, &reply))
+		require.Len(t, reply.Denials, 2)
+
+		byIntention := make(map[string]structs.IntentionDenialSummary)
+		for _, d := range reply.Denials {
+			require.Equal(t, "db", d.DestinationName)
+			byIntention[d.Intention] = d
+		}
+		require.Equal(t, "web", byIntention["web"].SourceName)
+		require.Equal(t, uint64(2), byIntention["web"].Count)
+		require.Equal(t, "api", byIntention[""].SourceName)
+		require.Equal(t, uint64(1), byIntention[""].Count)
+	})
+
+	testutil.RunStep(t, "list is filtered by ACLs", func(t *testing.T) {
+		args := structs.IntentionDenialsRequest{
+			Datacenter:   "dc1",
+			QueryOptions: structs.QueryOptions{Token: webToken.SecretID},
+		}
+		var reply structs.IndexedIntentionDenials
+		require.NoError(t, msgpackrpc.CallWithCodec(codec, "Intention.Denials", &args, &reply))
+		require.Empty(t, reply.Denials)
+		require.True(t, reply.QueryMeta.ResultsFilteredByACLs)
+	})
+}
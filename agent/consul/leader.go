@@ -361,6 +361,9 @@ func (s *Server) revokeLeadership() {
 	// are no longer responsible for session expirations.
 	s.clearAllSessionTimers()
 
+	// Reported denials are only kept on the leader.
+	s.intentionDenials.Reset()
+
 	s.revokeEnterpriseLeadership()
 
 	s.stopDeferredDeletion()
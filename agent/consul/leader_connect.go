@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
 
 	"github.com/hashicorp/go-version"
@@ -96,8 +97,17 @@ func (s *Server) runConfigEntryControllers(ctx context.Context) error {
 			return err
 		},
 	}
-	logger := s.logger.Named(logging.APIGatewayController)
-	return gateways.NewAPIGatewayController(s.fsm, s.publisher, updater, logger).Run(ctx)
+
+	group, ctx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		logger := s.logger.Named(logging.APIGatewayController)
+		return gateways.NewAPIGatewayController(s.fsm, s.publisher, updater, logger).Run(ctx)
+	})
+	group.Go(func() error {
+		logger := s.logger.Named(logging.ACMECertificate)
+		return gateways.NewACMECertificateController(s.fsm, s.publisher, updater, logger).Run(ctx)
+	})
+	return group.Wait()
 }
 
 func (s *Server) runCARootPruning(ctx context.Context) error {
@@ -368,6 +368,7 @@ func (s *Server) establishStream(ctx context.Context,
 		}
 
 		logger.Trace("dialing peer", "addr", addr)
+		streamStatus.TrackDial(addr)
 		conn, err := grpc.DialContext(streamCtx, addr, opts...)
 
 		if err != nil {
@@ -381,6 +382,12 @@ func (s *Server) establishStream(ctx context.Context,
 			return err
 		}
 
+		// Read the secret on every attempt since the accepting peer may have rotated it.
+		secret, err := s.fsm.State().PeeringSecretsRead(nil, peer.ID)
+		if err != nil {
+			return fmt.Errorf("failed to read secret for peering: %w", err)
+		}
+
 		initialReq := &pbpeerstream.ReplicationMessage{
 			Payload: &pbpeerstream.ReplicationMessage_Open_{
 				Open: &pbpeerstream.ReplicationMessage_Open{
@@ -394,6 +394,10 @@ type Server struct {
 	// for the KV tombstones
 	tombstoneGC *state.TombstoneGC
 
+	// intentionDenials aggregates the intention denials reported by proxies.
+	// Only the tracker on the leader receives reports.
+	intentionDenials *intentionDenialTracker
+
 	// aclReplicationStatus (and its associated lock) provide information
 	// about the health of the ACL replication goroutine.
 	aclReplicationStatus     structs.ACLReplicationStatus
@@ -520,6 +524,7 @@ func NewServer(config *Config, flat Deps, externalGRPCServer *grpc.Server,
 		reassertLeaderCh:        make(chan chan error),
 		sessionTimers:           NewSessionTimers(),
 		tombstoneGC:             gc,
+		intentionDenials:        newIntentionDenialTracker(maxIntentionDenials),
 		serverLookup:            NewServerLookup(),
 		shutdownCh:              shutdownCh,
 		leaderRoutineManager:    routine.NewManager(logger.Named(logging.Leader)),
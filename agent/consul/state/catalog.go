@@ -3765,6 +3765,7 @@ func terminatingConfigGatewayServices(
 			SNI:             svc.SNI,
 			ServiceKind:     kind,
 			AutoHostRewrite: !svc.DisableAutoHostRewrite,
+			HealthCheck:     svc.HealthCheck,
 		}
 
 		gatewayServices = append(gatewayServices, mapping)
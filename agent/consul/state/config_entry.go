@@ -260,6 +260,11 @@ func ensureConfigEntryTxn(tx WriteTxn, idx uint64, statusUpdate bool, conf struc
 			if controlledConf, ok := conf.(structs.ControlledConfigEntry); ok {
 				controlledConf.SetStatus(existing.(structs.ControlledConfigEntry).GetStatus())
 			}
+			// Pending ACME challenges are managed by the leader alongside
+			// the status.
+			if acmeConf, ok := conf.(*structs.ACMECertificateConfigEntry); ok {
+				acmeConf.Challenges = existing.(*structs.ACMECertificateConfigEntry).Challenges
+			}
 		}
 	} else {
 		if !statusUpdate {
@@ -621,6 +626,12 @@ func validateProposedConfigEntryInGraph(
 			}
 		}
 	case structs.ServiceRouter:
+		if newEntry != nil {
+			err := validateJWTProvidersExist(tx, kindName, newEntry)
+			if err != nil {
+				return err
+			}
+		}
 	case structs.ServiceSplitter:
 	case structs.ServiceResolver:
 	case structs.IngressGateway:
@@ -644,12 +655,16 @@ func validateProposedConfigEntryInGraph(
 		}
 	case structs.MeshConfig:
 	case structs.ExportedServices:
+	case structs.ImportedServices:
 	case structs.APIGateway: // TODO Consider checkGatewayClash
 	case structs.BoundAPIGateway:
 	case structs.FileSystemCertificate:
 	case structs.InlineCertificate:
+	case structs.ACMECertificate:
 	case structs.HTTPRoute:
+	case structs.GRPCRoute:
 	case structs.TCPRoute:
+	case structs.TLSRoute:
 	case structs.RateLimitIPConfig:
 	case structs.JWTProvider:
 		if newEntry == nil && existingEntry != nil {
@@ -753,7 +768,17 @@ func validateJWTProviderIsReferenced(tx ReadTxn, kn configentry.KindName, ce str
 		return err
 	}
 
-	return nil
+	// Routers may live in any namespace of the partition.
+	routerMeta := acl.NewEnterpriseMetaWithPartition(
+		kn.EnterpriseMeta.PartitionOrDefault(),
+		acl.WildcardName,
+	)
+	_, routerEntries, err := configEntriesByKindTxn(tx, nil, structs.ServiceRouter, &routerMeta)
+	if err != nil {
+		return err
+	}
+
+	return findJWTProviderRouterReferences(routerEntries, entry.Name)
 }
 
 func findJWTProviderNameReferences(entries []structs.ConfigEntry, pName string) error {
@@ -788,18 +813,49 @@ func findJWTProviderNameReferences(entries []structs.ConfigEntry, pName string)
 	return nil
 }
 
+func findJWTProviderRouterReferences(entries []structs.ConfigEntry, pName string) error {
+	errMsg := "cannot delete jwt provider config entry referenced by a service router. Provider name: %s, service router name: %s"
+	for _, entry := range entries {
+		router, ok := entry.(*structs.ServiceRouterConfigEntry)
+		if !ok {
+			return fmt.Errorf("type %T is not a service router config entry", entry)
+		}
+
+		if _, ok := getRouterReferencedProviderNames(router)[pName]; ok {
+			return fmt.Errorf(errMsg, pName, router.Name)
+		}
+	}
+	return nil
+}
+
+// getRouterReferencedProviderNames returns the names of the providers used by
+// the JWT matches of a service router's routes.
+func getRouterReferencedProviderNames(router *structs.ServiceRouterConfigEntry) map[string]struct{} {
+	providerNames := make(map[string]struct{})
+	for _, route := range router.Routes {
+		if route.Match == nil || route.Match.HTTP == nil || route.Match.HTTP.JWT == nil {
+			continue
+		}
+		providerNames[route.Match.HTTP.JWT.Provider] = struct{}{}
+	}
+	return providerNames
+}
+
 // This fetches all the jwt-providers config entries and iterates over them
 // to validate that any provider referenced exists.
 // This is okay because we assume there are very few jwt-providers per partition
 func validateJWTProvidersExist(tx ReadTxn, kn configentry.KindName, ce structs.ConfigEntry) error {
 	var result error
-	entry, ok := ce.(*structs.ServiceIntentionsConfigEntry)
-	if !ok {
-		return fmt.Errorf("Invalid service intention config entry: %T", entry)
+	var referencedProvidersNames map[string]struct{}
+	switch entry := ce.(type) {
+	case *structs.ServiceIntentionsConfigEntry:
+		referencedProvidersNames = getReferencedProviderNames(entry.JWT, entry.Sources)
+	case *structs.ServiceRouterConfigEntry:
+		referencedProvidersNames = getRouterReferencedProviderNames(entry)
+	default:
+		return fmt.Errorf("Invalid config entry referencing jwt providers: %T", entry)
 	}
 
-	referencedProvidersNames := getReferencedProviderNames(entry.JWT, entry.Sources)
-
 	if len(referencedProvidersNames) > 0 {
 		jwtProvidersNames, err := getExistingJWTProvidersByName(tx, kn)
 		if err != nil {
@@ -1021,8 +1077,8 @@ func validateProposedConfigEntryInServiceGraph(
 
 		return nil
 
-	case structs.MeshConfig:
-		// Exported services and mesh config do not influence discovery chains.
+	case structs.MeshConfig, structs.ImportedServices:
+		// Exported services, imported services, and mesh config do not influence discovery chains.
 		return nil
 
 	case structs.SamenessGroup:
@@ -1366,6 +1422,9 @@ func validateChainIsPeerExportSafe(
 			if !emptyOrMatchesEntryPartition(e, route.Destination.Partition) {
 				return fmt.Errorf("peer exported service %q contains cross-partition route destination", exportedSvc)
 			}
+			if err := validateMirrorsArePeerExportSafe(exportedSvc, e, route.Destination.Mirror); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -1374,6 +1433,9 @@ func validateChainIsPeerExportSafe(
 			if !emptyOrMatchesEntryPartition(e, split.Partition) {
 				return fmt.Errorf("peer exported service %q contains cross-partition split destination", exportedSvc)
 			}
+			if err := validateMirrorsArePeerExportSafe(exportedSvc, e, split.Mirror); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -1411,6 +1473,20 @@ func validateChainIsPeerExportSafe(
 	return nil
 }
 
+// validateMirrorsArePeerExportSafe checks that a router or splitter of an
+// exported service only mirrors requests to targets within its own partition.
+func validateMirrorsArePeerExportSafe(exportedSvc structs.ServiceName, entry structs.ConfigEntry, mirrors []structs.ServiceRouteMirror) error {
+	for _, mirror := range mirrors {
+		if mirror.Peer != "" {
+			return fmt.Errorf("peer exported service %q contains cross-peer mirror", exportedSvc)
+		}
+		if mirror.Partition != "" && !acl.EqualPartitions(entry.GetEnterpriseMeta().PartitionOrEmpty(), mirror.Partition) {
+			return fmt.Errorf("peer exported service %q contains cross-partition mirror", exportedSvc)
+		}
+	}
+	return nil
+}
+
 // testCompileDiscoveryChain speculatively compiles a discovery chain with
 // pending modifications to see if it would be valid. Also returns the computed
 // protocol and topmost discovery chain node.
@@ -1724,6 +1800,9 @@ func readDiscoveryChainConfigEntriesTxn(
 		for _, svc := range router.ListRelatedServices() {
 			todoSplitters[svc] = struct{}{}
 		}
+		for _, peer := range router.RelatedPeers() {
+			todoPeers[peer] = struct{}{}
+		}
 	} else {
 		// Next hop in the chain is the splitter.
 		todoSplitters[sid] = struct{}{}
@@ -1767,6 +1846,9 @@ func readDiscoveryChainConfigEntriesTxn(
 			// after another iteration.
 			todoSplitters[svc] = struct{}{}
 		}
+		for _, peer := range splitter.RelatedPeers() {
+			todoPeers[peer] = struct{}{}
+		}
 	}
 
 	processSamenessGroup := func(sg *structs.SamenessGroupConfigEntry, resolverID structs.ServiceID) error {
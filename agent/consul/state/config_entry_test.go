@@ -3903,3 +3903,65 @@ func TestStore_ValidateJWTProviderIsReferenced(t *testing.T) {
 	// successfully delete the provider after deleting the intention
 	require.NoError(t, s.DeleteConfigEntry(0, structs.JWTProvider, provider.Name, nil))
 }
+
+func TestStore_ServiceRouterJWTProviderReferences(t *testing.T) {
+	s := testStateStore(t)
+
+	require.NoError(t, s.EnsureConfigEntry(0, &structs.ProxyConfigEntry{
+		Kind: structs.ProxyDefaults,
+		Name: structs.ProxyConfigGlobal,
+		Config: map[string]interface{}{
+			"protocol": "http",
+		},
+	}))
+
+	router := &structs.ServiceRouterConfigEntry{
+		Kind: structs.ServiceRouter,
+		Name: "api",
+		Routes: []structs.ServiceRoute{
+			{
+				Match: &structs.ServiceRouteMatch{
+					HTTP: &structs.ServiceRouteHTTPMatch{
+						JWT: &structs.ServiceRouteHTTPMatchJWT{
+							Provider: "okta",
+							Claims: []structs.ServiceRouteHTTPMatchJWTClaim{
+								{Path: []string{"tier"}, Value: "premium"},
+							},
+						},
+					},
+				},
+				Destination: &structs.ServiceRouteDestination{
+					ServiceSubset: "premium",
+				},
+			},
+		},
+	}
+
+	// a router cannot reference a provider that doesn't exist
+	err := s.EnsureConfigEntry(1, router)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `Referenced JWT Provider does not exist. Provider Name: okta`)
+
+	provider := &structs.JWTProviderConfigEntry{
+		Kind: structs.JWTProvider,
+		Name: "okta",
+	}
+	require.NoError(t, s.EnsureConfigEntry(2, provider))
+	require.NoError(t, s.EnsureConfigEntry(3, &structs.ServiceResolverConfigEntry{
+		Kind: structs.ServiceResolver,
+		Name: "api",
+		Subsets: map[string]structs.ServiceResolverSubset{
+			"premium": {Filter: "Service.Meta.tier == premium"},
+		},
+	}))
+	require.NoError(t, s.EnsureConfigEntry(4, router))
+
+	// attempt deleting a referenced provider
+	err = s.DeleteConfigEntry(5, structs.JWTProvider, provider.Name, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `cannot delete jwt provider config entry referenced by a service router. Provider name: okta, service router name: api`)
+
+	// successfully delete the provider after deleting the router
+	require.NoError(t, s.DeleteConfigEntry(6, structs.ServiceRouter, router.Name, nil))
+	require.NoError(t, s.DeleteConfigEntry(7, structs.JWTProvider, provider.Name, nil))
+}
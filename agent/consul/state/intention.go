@@ -1186,3 +1186,234 @@ func (s *Store) intentionTopologyTxn(
 	}
 	return maxIdx, result, err
 }
+
+// IntentionDryRun evaluates the L4 intention decision between every known
+// source and every destination covered by the proposed service-intentions
+// config entry, once with the stored intentions and once as if proposed had
+// replaced the stored config entry of the same name. Sources include local
+// services and ingress gateways as well as services imported from peers.
+//
+// Only pairs whose decision changes are returned.
+func (s *Store) IntentionDryRun(
+	ws memdb.WatchSet,
+	proposed *structs.ServiceIntentionsConfigEntry,
+	defaultAllow bool,
+) (uint64, *structs.IntentionDryRunResponse, error) {
+	tx := s.db.ReadTxn()
+	defer tx.Abort()
+
+	maxIdx := maxIndexTxn(tx, tableConfigEntries)
+
+	idx, destinations, err := intentionDryRunDestinationsTxn(tx, ws, proposed)
+	if err != nil {
+		return 0, nil, err
+	}
+	if idx > maxIdx {
+		maxIdx = idx
+	}
+
+	idx, sources, err := intentionDryRunSourcesTxn(tx, ws)
+	if err != nil {
+		return 0, nil, err
+	}
+	if idx > maxIdx {
+		maxIdx = idx
+	}
+
+	resp := &structs.IntentionDryRunResponse{}
+	for _, dst := range destinations {
+		before, err := intentionDryRunDestinationIntentionsTxn(tx, ws, dst, nil)
+		if err != nil {
+			return 0, nil, err
+		}
+		after, err := intentionDryRunDestinationIntentionsTxn(tx, ws, dst, proposed)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		for _, src := range sources {
+			if src.Peer == "" && src.ServiceName.Matches(dst) {
+				continue
+			}
+			resp.Evaluated++
+
+			opts := IntentionDecisionOpts{
+				Target:           src.ServiceName.Name,
+				Namespace:        src.ServiceName.NamespaceOrDefault(),
+				Partition:        src.ServiceName.PartitionOrDefault(),
+				Peer:             src.Peer,
+				MatchType:        structs.IntentionMatchSource,
+				DefaultAllow:     defaultAllow,
+				AllowPermissions: false,
+			}
+
+			opts.Intentions = before
+			beforeDecision, err := s.IntentionDecision(opts)
+			if err != nil {
+				return 0, nil, fmt.Errorf("failed to get intention decision from (%s) to (%s): %v",
+					src.String(), dst.String(), err)
+			}
+
+			opts.Intentions = after
+			afterDecision, err := s.IntentionDecision(opts)
+			if err != nil {
+				return 0, nil, fmt.Errorf("failed to get intention decision from (%s) to (%s): %v",
+					src.String(), dst.String(), err)
+			}
+
+			if beforeDecision.Allowed == afterDecision.Allowed &&
+				beforeDecision.HasPermissions == afterDecision.HasPermissions {
+				continue
+			}
+
+			resp.Changes = append(resp.Changes, structs.IntentionDecisionChange{
+				SourceName:           src.ServiceName.Name,
+				SourceNS:             src.ServiceName.NamespaceOrEmpty(),
+				SourcePartition:      src.ServiceName.PartitionOrEmpty(),
+				SourcePeer:           src.Peer,
+				DestinationName:      dst.Name,
+				DestinationNS:        dst.NamespaceOrEmpty(),
+				DestinationPartition: dst.PartitionOrEmpty(),
+				Before:               beforeDecision,
+				After:                afterDecision,
+			})
+		}
+	}
+
+	sort.Slice(resp.Changes, func(i, j int) bool {
+		a, b := resp.Changes[i], resp.Changes[j]
+		if a.DestinationName != b.DestinationName {
+			return a.DestinationName < b.DestinationName
+		}
+		if a.SourcePeer != b.SourcePeer {
+			return a.SourcePeer < b.SourcePeer
+		}
+		return a.SourceName < b.SourceName
+	})
+
+	return maxIdx, resp, nil
+}
+
+// intentionDryRunDestinationsTxn returns the destinations whose intentions
+// are affected by the proposed config entry. A wildcard entry applies to
+// every service in its namespace.
+func intentionDryRunDestinationsTxn(
+	tx ReadTxn,
+	ws memdb.WatchSet,
+	proposed *structs.ServiceIntentionsConfigEntry,
+) (uint64, []structs.ServiceName, error) {
+	if !proposed.HasWildcardDestination() {
+		return 0, []structs.ServiceName{proposed.DestinationServiceName()}, nil
+	}
+
+	idx, names, err := serviceNamesOfKindTxn(tx, ws, structs.ServiceKindTypical, proposed.EnterpriseMeta)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to list service names: %v", err)
+	}
+
+	var result []structs.ServiceName
+	for _, n := range names {
+		if n.Service.Name == structs.ConsulServiceName {
+			continue
+		}
+		result = append(result, n.Service)
+	}
+	return idx, result, nil
+}
+
+// intentionDryRunSourcesTxn returns every local service and ingress gateway
+// along with every service imported from a peer.
+func intentionDryRunSourcesTxn(tx ReadTxn, ws memdb.WatchSet) (uint64, []structs.PeeredServiceName, error) {
+	var (
+		maxIdx uint64
+		result []structs.PeeredServiceName
+	)
+
+	wildcardMeta := structs.WildcardEnterpriseMetaInPartition(structs.WildcardSpecifier)
+	for _, kind := range []structs.ServiceKind{structs.ServiceKindTypical, structs.ServiceKindIngressGateway} {
+		idx, names, err := serviceNamesOfKindTxn(tx, ws, kind, *wildcardMeta)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to list service names: %v", err)
+		}
+		if idx > maxIdx {
+			maxIdx = idx
+		}
+		for _, n := range names {
+			if n.Service.Name == structs.ConsulServiceName {
+				continue
+			}
+			result = append(result, structs.PeeredServiceName{ServiceName: n.Service})
+		}
+	}
+
+	idx, peerings, err := peeringListTxn(ws, tx, *wildcardMeta)
+	if err != nil {
+		return 0, nil, err
+	}
+	if idx > maxIdx {
+		maxIdx = idx
+	}
+
+	for _, peering := range peerings {
+		iter, err := tx.Get(tableServices, indexID+"_prefix", Query{
+			EnterpriseMeta: *structs.WildcardEnterpriseMetaInPartition(peering.Partition),
+			PeerName:       peering.Name,
+		})
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed querying services: %s", err)
+		}
+		ws.Add(iter.WatchCh())
+
+		unique := make(map[structs.ServiceName]struct{})
+		for raw := iter.Next(); raw != nil; raw = iter.Next() {
+			svc := raw.(*structs.ServiceNode)
+			if svc.ServiceKind != structs.ServiceKindTypical {
+				continue
+			}
+			sn := svc.CompoundServiceName().ServiceName
+			if _, ok := unique[sn]; ok {
+				continue
+			}
+			unique[sn] = struct{}{}
+			result = append(result, structs.PeeredServiceName{Peer: peering.Name, ServiceName: sn})
+		}
+	}
+
+	return maxIdx, result, nil
+}
+
+// intentionDryRunDestinationIntentionsTxn returns the intentions that apply
+// to dst sorted by precedence. If override is non-nil it is used in place of
+// the stored config entry with the same name.
+func intentionDryRunDestinationIntentionsTxn(
+	tx ReadTxn,
+	ws memdb.WatchSet,
+	dst structs.ServiceName,
+	override *structs.ServiceIntentionsConfigEntry,
+) (structs.SimplifiedIntentions, error) {
+	var results structs.Intentions
+
+	names := getIntentionPrecedenceMatchServiceNames(dst.Name, &dst.EnterpriseMeta)
+	for _, sn := range names {
+		if override != nil && override.DestinationServiceName().Matches(sn) {
+			results = append(results, override.ToIntentions()...)
+			continue
+		}
+
+		_, entry, err := getServiceIntentionsConfigEntryTxn(tx, ws, sn.Name, nil, &sn.EnterpriseMeta)
+		if err != nil {
+			return nil, err
+		} else if entry != nil {
+			results = append(results, entry.ToIntentions()...)
+		}
+	}
+
+	_, results, err := getSimplifiedIntentions(tx, ws, results)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Sort(structs.IntentionPrecedenceSorter(results))
+
+	return structs.SimplifiedIntentions(results), nil
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/consul/acl"
 	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/proto/private/pbpeering"
 	"github.com/hashicorp/consul/sdk/testutil"
 )
 
@@ -2736,3 +2737,119 @@ func TestStore_IntentionTopology_Watches(t *testing.T) {
 	}
 	require.Equal(t, expect, got)
 }
+
+func TestStore_IntentionDryRun(t *testing.T) {
+	s := testConfigStateStore(t)
+
+	testRegisterNode(t, s, 10, "foo")
+	for _, svc := range []string{"api", "web", "db"} {
+		testRegisterService(t, s, 11, "foo", svc)
+	}
+
+	require.NoError(t, s.PeeringWrite(12, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:   testFooPeerID,
+			Name: "billing",
+		},
+	}))
+	testRegisterNodeOpts(t, s, 13, "bar", func(node *structs.Node) error {
+		node.PeerName = "billing"
+		return nil
+	})
+	require.NoError(t, s.EnsureService(14, "bar", &structs.NodeService{
+		ID:       "invoices-1",
+		Service:  "invoices",
+		PeerName: "billing",
+	}))
+
+	require.NoError(t, s.EnsureConfigEntry(15, &structs.ServiceIntentionsConfigEntry{
+		Kind: structs.ServiceIntentions,
+		Name: "db",
+		Sources: []*structs.SourceIntention{
+			{Name: "api", Action: structs.IntentionActionAllow},
+			{Name: "web", Action: structs.IntentionActionAllow},
+		},
+	}))
+
+	normalize := func(t *testing.T, entry *structs.ServiceIntentionsConfigEntry) *structs.ServiceIntentionsConfigEntry {
+		t.Helper()
+		require.NoError(t, entry.Normalize())
+		require.NoError(t, entry.Validate())
+		return entry
+	}
+
+	t.Run("deny one source", func(t *testing.T) {
+		proposed := normalize(t, &structs.ServiceIntentionsConfigEntry{
+			Kind: structs.ServiceIntentions,
+			Name: "db",
+			Sources: []*structs.SourceIntention{
+				{Name: "api", Action: structs.IntentionActionAllow},
+				{Name: "web", Action: structs.IntentionActionDeny},
+				{Name: "invoices", Peer: "billing", Action: structs.IntentionActionAllow},
+			},
+		})
+
+		_, resp, err := s.IntentionDryRun(nil, proposed, false)
+		require.NoError(t, err)
+
+		// api, web and the imported invoices service are the sources for db.
+		require.Equal(t, 3, resp.Evaluated)
+		require.Len(t, resp.Changes, 2)
+
+		require.Equal(t, "web", resp.Changes[0].SourceName)
+		require.Empty(t, resp.Changes[0].SourcePeer)
+		require.Equal(t, "db", resp.Changes[0].DestinationName)
+		require.True(t, resp.Changes[0].Before.Allowed)
+		require.False(t, resp.Changes[0].After.Allowed)
+
+		require.Equal(t, "invoices", resp.Changes[1].SourceName)
+		require.Equal(t, "billing", resp.Changes[1].SourcePeer)
+		require.Equal(t, "db", resp.Changes[1].DestinationName)
+		require.False(t, resp.Changes[1].Before.Allowed)
+		require.True(t, resp.Changes[1].After.Allowed)
+	})
+
+	t.Run("wildcard destination", func(t *testing.T) {
+		proposed := normalize(t, &structs.ServiceIntentionsConfigEntry{
+			Kind: structs.ServiceIntentions,
+			Name: "*",
+			Sources: []*structs.SourceIntention{
+				{Name: "*", Action: structs.IntentionActionAllow},
+			},
+		})
+
+		_, resp, err := s.IntentionDryRun(nil, proposed, false)
+		require.NoError(t, err)
+
+		// Three destinations each with two local sources and one peered source.
+		require.Equal(t, 9, resp.Evaluated)
+
+		// The db intentions take precedence over the wildcard and a local
+		// wildcard source never matches services imported from a peer.
+		var changed []string
+		for _, c := range resp.Changes {
+			require.False(t, c.Before.Allowed)
+			require.True(t, c.After.Allowed)
+			changed = append(changed, c.SourceName+"->"+c.DestinationName)
+		}
+		require.Equal(t, []string{
+			"db->api", "web->api", "api->web", "db->web",
+		}, changed)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		proposed := normalize(t, &structs.ServiceIntentionsConfigEntry{
+			Kind: structs.ServiceIntentions,
+			Name: "db",
+			Sources: []*structs.SourceIntention{
+				{Name: "web", Action: structs.IntentionActionAllow},
+				{Name: "api", Action: structs.IntentionActionAllow},
+			},
+		})
+
+		_, resp, err := s.IntentionDryRun(nil, proposed, false)
+		require.NoError(t, err)
+		require.Equal(t, 3, resp.Evaluated)
+		require.Empty(t, resp.Changes)
+	})
+}
@@ -207,8 +207,11 @@ var (
 	EventTopicAPIGateway            = pbsubscribe.Topic_APIGateway
 	EventTopicTCPRoute              = pbsubscribe.Topic_TCPRoute
 	EventTopicHTTPRoute             = pbsubscribe.Topic_HTTPRoute
+	EventTopicGRPCRoute             = pbsubscribe.Topic_GRPCRoute
+	EventTopicTLSRoute              = pbsubscribe.Topic_TLSRoute
 	EventTopicFileSystemCertificate = pbsubscribe.Topic_FileSystemCertificate
 	EventTopicInlineCertificate     = pbsubscribe.Topic_InlineCertificate
+	EventTopicACMECertificate       = pbsubscribe.Topic_ACMECertificate
 	EventTopicBoundAPIGateway       = pbsubscribe.Topic_BoundAPIGateway
 	EventTopicIPRateLimit           = pbsubscribe.Topic_IPRateLimit
 	EventTopicSamenessGroup         = pbsubscribe.Topic_SamenessGroup
@@ -241,6 +241,10 @@ func (s *Store) peeringSecretsWriteTxn(tx WriteTxn, req *pbpeering.SecretsWriteR
 	// Active stream secrets doesn't need to be checked for uniqueness because it is only ever promoted from pending.
 	case *pbpeering.SecretsWriteRequest_ExchangeSecret:
 		newSecretID = r.ExchangeSecret.PendingStreamSecret
+
+	// When rotating secrets a new pending stream secret is generated.
+	case *pbpeering.SecretsWriteRequest_RotateSecret:
+		newSecretID = r.RotateSecret.PendingStreamSecret
 	}
 
 	if newSecretID != "" {
@@ -350,6 +354,27 @@ func (s *Store) peeringSecretsWriteTxn(tx WriteTxn, req *pbpeering.SecretsWriteR
 			toDelete = append(toDelete, existingActive)
 		}
 
+	case *pbpeering.SecretsWriteRequest_RotateSecret:
+		if existing.GetStream().GetActiveSecretID() == "" {
+			return fmt.Errorf("cannot rotate peering secret: peering was not established")
+		}
+
+		secrets.Stream = &pbpeering.PeeringSecrets_Stream{
+			// Store the newly-generated pending stream secret, overwriting any that existed.
+			PendingSecretID: r.RotateSecret.GetPendingStreamSecret(),
+
+			// The active secret stays valid until the dialing peer starts using the pending secret.
+			ActiveSecretID: existing.GetStream().GetActiveSecretID(),
+		}
+
+		// Avoid invalidating existing establishment secrets when rotating stream secrets.
+		secrets.Establishment = existing.GetEstablishment()
+
+		// Unused pending secrets are overwritten by the rotated one.
+		if existingPending := existing.GetStream().GetPendingSecretID(); existingPending != "" {
+			toDelete = append(toDelete, existingPending)
+		}
+
 	case *pbpeering.SecretsWriteRequest_Establish:
 		// This should never happen. Dialing peers are the only ones that can call Establish,
 		// and the peering secrets for dialing peers should have been inserted earlier in the function.
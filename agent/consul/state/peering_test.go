@@ -395,6 +395,14 @@ func TestStore_PeeringSecretsWrite(t *testing.T) {
 			},
 			expectErr: "missing secret ID",
 		},
+		{
+			name: "no secret IDs were embedded when rotating secret",
+			input: &pbpeering.SecretsWriteRequest{
+				PeerID:  testFooPeerID,
+				Request: &pbpeering.SecretsWriteRequest_RotateSecret{},
+			},
+			expectErr: "missing secret ID",
+		},
 		{
 			name: "dialing peer invalid request type - generate token",
 			seed: &testSeed{
@@ -799,6 +807,70 @@ func TestStore_PeeringSecretsWrite(t *testing.T) {
 			},
 			expectUUIDs: []string{testSecretTwo, testSecretThree},
 		},
+		{
+			name: "cannot rotate secret without active secret",
+			seed: &testSeed{
+				peering: &pbpeering.Peering{
+					Name: "foo",
+					ID:   testFooPeerID,
+				},
+				secrets: &pbpeering.PeeringSecrets{
+					PeerID: testFooPeerID,
+					Establishment: &pbpeering.PeeringSecrets_Establishment{
+						SecretID: testSecretOne,
+					},
+				},
+			},
+			input: &pbpeering.SecretsWriteRequest{
+				PeerID: testFooPeerID,
+				Request: &pbpeering.SecretsWriteRequest_RotateSecret{
+					RotateSecret: &pbpeering.SecretsWriteRequest_RotateSecretRequest{
+						PendingStreamSecret: testSecretTwo,
+					},
+				},
+			},
+			expectErr: "peering was not established",
+		},
+		{
+			name: "rotate secret replaces pending stream secret",
+			seed: &testSeed{
+				peering: &pbpeering.Peering{
+					Name: "foo",
+					ID:   testFooPeerID,
+				},
+				secrets: &pbpeering.PeeringSecrets{
+					PeerID: testFooPeerID,
+					Establishment: &pbpeering.PeeringSecrets_Establishment{
+						SecretID: testSecretFour,
+					},
+					Stream: &pbpeering.PeeringSecrets_Stream{
+						PendingSecretID: testSecretTwo,
+						ActiveSecretID:  testSecretOne,
+					},
+				},
+			},
+			input: &pbpeering.SecretsWriteRequest{
+				PeerID: testFooPeerID,
+				Request: &pbpeering.SecretsWriteRequest_RotateSecret{
+					RotateSecret: &pbpeering.SecretsWriteRequest_RotateSecretRequest{
+						PendingStreamSecret: testSecretThree,
+					},
+				},
+			},
+			expect: &pbpeering.PeeringSecrets{
+				PeerID: testFooPeerID,
+				Establishment: &pbpeering.PeeringSecrets_Establishment{
+					// Establishment secret remains valid when rotating a stream secret.
+					SecretID: testSecretFour,
+				},
+				Stream: &pbpeering.PeeringSecrets_Stream{
+					// Active secret is not deleted until the new pending secret is promoted
+					ActiveSecretID:  testSecretOne,
+					PendingSecretID: testSecretThree,
+				},
+			},
+			expectUUIDs: []string{testSecretOne, testSecretThree, testSecretFour},
+		},
 	}
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
@@ -29,6 +29,9 @@ func (s *HTTPHandlers) DiscoveryChainRead(resp http.ResponseWriter, req *http.Re
 	}
 
 	args.EvaluateInDatacenter = req.URL.Query().Get("compile-dc")
+	if _, ok := req.URL.Query()["explain"]; ok {
+		args.Explain = true
+	}
 	var entMeta acl.EnterpriseMeta
 	if err := s.parseEntMetaNoWildcard(req, &entMeta); err != nil {
 		return nil, err
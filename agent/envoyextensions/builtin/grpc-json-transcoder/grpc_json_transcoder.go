@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package grpcjsontranscoder
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_grpc_json_transcoder_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/hashicorp/consul/api"
+	ext_cmn "github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+const filterName = "envoy.filters.http.grpc_json_transcoder"
+
+type grpcJSONTranscoder struct {
+	ext_cmn.BasicExtensionAdapter
+
+	// ProxyType identifies the type of Envoy proxy that this extension applies to.
+	// The extension patches the inbound listener of connect proxies and the
+	// HTTP listeners of ingress gateways.
+	ProxyType api.ServiceKind
+	// InsertOptions controls how the extension inserts the filter.
+	InsertOptions ext_cmn.InsertOptions
+	// Config holds the extension configuration.
+	Config transcoderConfig
+}
+
+var _ ext_cmn.BasicExtension = (*grpcJSONTranscoder)(nil)
+
+// transcoderConfig holds the configuration of the gRPC-JSON transcoder filter.
+type transcoderConfig struct {
+	// ProtoDescriptor is the descriptor set of the gRPC services to transcode.
+	ProtoDescriptor ProtoDescriptor
+	// Services is the list of fully qualified gRPC service names to transcode.
+	Services []string
+	// PrintOptions controls how gRPC responses are printed as JSON.
+	PrintOptions *PrintOptions
+	// MatchIncomingRequestRoute routes requests using the incoming HTTP path
+	// rather than the transcoded gRPC path.
+	MatchIncomingRequestRoute bool
+	// IgnoredQueryParameters is a list of query parameters that are not
+	// mapped to fields of the gRPC request message.
+	IgnoredQueryParameters []string
+	// AutoMapping maps requests to gRPC methods that have no HTTP annotations
+	// using the path "/<package>.<service>/<method>".
+	AutoMapping bool
+	// IgnoreUnknownQueryParameters ignores query parameters that can't be
+	// mapped to a field of the gRPC request message instead of rejecting the
+	// request.
+	IgnoreUnknownQueryParameters bool
+	// ConvertGrpcStatus converts gRPC status headers into a JSON response body.
+	ConvertGrpcStatus bool
+}
+
+// ProtoDescriptor identifies a protobuf descriptor set, either inline or as
+// a file on the proxy's host. Exactly one of the fields must be set.
+type ProtoDescriptor struct {
+	// Inline is the base64 encoded content of the descriptor set.
+	Inline string
+	// Filename is the path to the descriptor set on the proxy's host.
+	Filename string
+}
+
+// PrintOptions controls how gRPC responses are printed as JSON.
+type PrintOptions struct {
+	AddWhitespace              bool
+	AlwaysPrintPrimitiveFields bool
+	AlwaysPrintEnumsAsInts     bool
+	PreserveProtoFieldNames    bool
+}
+
+func Constructor(ext api.EnvoyExtension) (ext_cmn.EnvoyExtender, error) {
+	t, err := newGRPCJSONTranscoder(ext)
+	if err != nil {
+		return nil, err
+	}
+	return &ext_cmn.BasicEnvoyExtender{
+		Extension: t,
+	}, nil
+}
+
+func newGRPCJSONTranscoder(ext api.EnvoyExtension) (*grpcJSONTranscoder, error) {
+	t := &grpcJSONTranscoder{}
+	if ext.Name != api.BuiltinGRPCJSONTranscoderExtension {
+		return t, fmt.Errorf("expected extension name %q but got %q", api.BuiltinGRPCJSONTranscoderExtension, ext.Name)
+	}
+	if err := t.fromArguments(ext.Arguments); err != nil {
+		return t, err
+	}
+	return t, nil
+}
+
+func (t *grpcJSONTranscoder) fromArguments(args map[string]any) error {
+	if err := mapstructure.Decode(args, t); err != nil {
+		return err
+	}
+	t.normalize()
+	return t.validate()
+}
+
+func (t *grpcJSONTranscoder) normalize() {
+	if t.ProxyType == "" {
+		t.ProxyType = api.ServiceKindConnectProxy
+	}
+	if t.InsertOptions.Location == "" {
+		t.InsertOptions.Location = ext_cmn.InsertBeforeFirstMatch
+		t.InsertOptions.FilterName = "envoy.filters.http.router"
+	}
+}
+
+func (t *grpcJSONTranscoder) validate() error {
+	var resultErr error
+	if t.ProxyType != api.ServiceKindConnectProxy && t.ProxyType != api.ServiceKindIngressGateway {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("unsupported ProxyType %q, only %q and %q are supported",
+			t.ProxyType,
+			api.ServiceKindConnectProxy,
+			api.ServiceKindIngressGateway))
+	}
+
+	if err := t.Config.validate(); err != nil {
+		resultErr = multierror.Append(resultErr, err)
+	}
+
+	return resultErr
+}
+
+func (c *transcoderConfig) validate() error {
+	var resultErr error
+	if len(c.Services) == 0 {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("Config.Services must contain at least one service"))
+	}
+
+	inline, file := c.ProtoDescriptor.Inline != "", c.ProtoDescriptor.Filename != ""
+	if inline == file {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("exactly one of Config.ProtoDescriptor.Inline or Config.ProtoDescriptor.Filename must be set"))
+		return resultErr
+	}
+	if !inline {
+		return resultErr
+	}
+
+	// The services of an inline descriptor set can be checked up front rather
+	// than leaving Envoy to reject the listener.
+	fds, err := c.ProtoDescriptor.decode()
+	if err != nil {
+		return multierror.Append(resultErr, fmt.Errorf("failed to decode Config.ProtoDescriptor.Inline: %w", err))
+	}
+	known := make(map[string]struct{})
+	for _, f := range fds.GetFile() {
+		for _, svc := range f.GetService() {
+			name := svc.GetName()
+			if pkg := f.GetPackage(); pkg != "" {
+				name = pkg + "." + name
+			}
+			known[name] = struct{}{}
+		}
+	}
+	for _, svc := range c.Services {
+		if _, ok := known[svc]; !ok {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("service %q not found in Config.ProtoDescriptor.Inline", svc))
+		}
+	}
+
+	return resultErr
+}
+
+func (d ProtoDescriptor) decode() (*descriptorpb.FileDescriptorSet, error) {
+	raw, err := base64.StdEncoding.DecodeString(d.Inline)
+	if err != nil {
+		return nil, err
+	}
+	var fds descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(raw, &fds); err != nil {
+		return nil, err
+	}
+	return &fds, nil
+}
+
+// CanApply indicates if the extension can be applied to the given extension runtime configuration.
+func (t *grpcJSONTranscoder) CanApply(config *ext_cmn.RuntimeConfig) bool {
+	return config.Kind == t.ProxyType
+}
+
+// PatchFilters inserts a gRPC-JSON transcoder filter into the filter chain of the HTTP connection manager
+// of the inbound listener for connect proxies, or of each HTTP listener for ingress gateways.
+func (t *grpcJSONTranscoder) PatchFilters(cfg *ext_cmn.RuntimeConfig, filters []*envoy_listener_v3.Filter, isInboundListener bool) ([]*envoy_listener_v3.Filter, error) {
+	switch t.ProxyType {
+	case api.ServiceKindConnectProxy:
+		if !isInboundListener {
+			return filters, nil
+		}
+		if cfg.Protocol != "grpc" {
+			return filters, fmt.Errorf("the %s extension requires the service protocol to be %q, not %q",
+				api.BuiltinGRPCJSONTranscoderExtension, "grpc", cfg.Protocol)
+		}
+	case api.ServiceKindIngressGateway:
+		// Ingress gateways serve TCP listeners alongside HTTP ones, which
+		// are left untouched.
+		if _, _, err := ext_cmn.GetHTTPConnectionManager(filters...); err != nil {
+			return filters, nil
+		}
+	}
+
+	filter, err := t.Config.toEnvoyHTTPFilter()
+	if err != nil {
+		return filters, err
+	}
+	return ext_cmn.InsertHTTPFilter(filters, filter, t.InsertOptions)
+}
+
+func (c *transcoderConfig) toEnvoyHTTPFilter() (*envoy_http_v3.HttpFilter, error) {
+	transcoder := &envoy_grpc_json_transcoder_v3.GrpcJsonTranscoder{
+		Services:                     c.Services,
+		MatchIncomingRequestRoute:    c.MatchIncomingRequestRoute,
+		IgnoredQueryParameters:       c.IgnoredQueryParameters,
+		AutoMapping:                  c.AutoMapping,
+		IgnoreUnknownQueryParameters: c.IgnoreUnknownQueryParameters,
+		ConvertGrpcStatus:            c.ConvertGrpcStatus,
+	}
+
+	if c.ProtoDescriptor.Filename != "" {
+		transcoder.DescriptorSet = &envoy_grpc_json_transcoder_v3.GrpcJsonTranscoder_ProtoDescriptor{
+			ProtoDescriptor: c.ProtoDescriptor.Filename,
+		}
+	} else {
+		raw, err := base64.StdEncoding.DecodeString(c.ProtoDescriptor.Inline)
+		if err != nil {
+			return nil, err
+		}
+		transcoder.DescriptorSet = &envoy_grpc_json_transcoder_v3.GrpcJsonTranscoder_ProtoDescriptorBin{
+			ProtoDescriptorBin: raw,
+		}
+	}
+
+	if p := c.PrintOptions; p != nil {
+		transcoder.PrintOptions = &envoy_grpc_json_transcoder_v3.GrpcJsonTranscoder_PrintOptions{
+			AddWhitespace:              p.AddWhitespace,
+			AlwaysPrintPrimitiveFields: p.AlwaysPrintPrimitiveFields,
+			AlwaysPrintEnumsAsInts:     p.AlwaysPrintEnumsAsInts,
+			PreserveProtoFieldNames:    p.PreserveProtoFieldNames,
+		}
+	}
+
+	return ext_cmn.MakeEnvoyHTTPFilter(filterName, transcoder)
+}
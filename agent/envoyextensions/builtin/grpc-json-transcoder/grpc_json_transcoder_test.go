@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package grpcjsontranscoder
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func TestConstructor(t *testing.T) {
+	t.Parallel()
+
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(healthpb.File_grpc_health_v1_health_proto),
+		},
+	}
+	raw, err := proto.Marshal(fds)
+	require.NoError(t, err)
+	inline := base64.StdEncoding.EncodeToString(raw)
+
+	cases := map[string]struct {
+		extName string
+		args    map[string]any
+		errMsg  string
+	}{
+		"invalid name": {
+			extName: "invalid",
+			errMsg:  `expected extension name "builtin/grpc-json-transcoder"`,
+		},
+		"invalid proxy type": {
+			args: map[string]any{
+				"ProxyType": "terminating-gateway",
+				"Config": map[string]any{
+					"ProtoDescriptor": map[string]any{"Filename": "/etc/proto/health.pb"},
+					"Services":        []string{"grpc.health.v1.Health"},
+				},
+			},
+			errMsg: `unsupported ProxyType "terminating-gateway"`,
+		},
+		"no services": {
+			args: map[string]any{
+				"Config": map[string]any{
+					"ProtoDescriptor": map[string]any{"Filename": "/etc/proto/health.pb"},
+				},
+			},
+			errMsg: `Config.Services must contain at least one service`,
+		},
+		"no descriptor": {
+			args: map[string]any{
+				"Config": map[string]any{
+					"Services": []string{"grpc.health.v1.Health"},
+				},
+			},
+			errMsg: `exactly one of Config.ProtoDescriptor.Inline or Config.ProtoDescriptor.Filename must be set`,
+		},
+		"both descriptors": {
+			args: map[string]any{
+				"Config": map[string]any{
+					"ProtoDescriptor": map[string]any{
+						"Inline":   inline,
+						"Filename": "/etc/proto/health.pb",
+					},
+					"Services": []string{"grpc.health.v1.Health"},
+				},
+			},
+			errMsg: `exactly one of Config.ProtoDescriptor.Inline or Config.ProtoDescriptor.Filename must be set`,
+		},
+		"invalid inline descriptor": {
+			args: map[string]any{
+				"Config": map[string]any{
+					"ProtoDescriptor": map[string]any{"Inline": "not base64!"},
+					"Services":        []string{"grpc.health.v1.Health"},
+				},
+			},
+			errMsg: `failed to decode Config.ProtoDescriptor.Inline`,
+		},
+		"unknown service": {
+			args: map[string]any{
+				"Config": map[string]any{
+					"ProtoDescriptor": map[string]any{"Inline": inline},
+					"Services":        []string{"grpc.health.v1.Health", "foo.Bar"},
+				},
+			},
+			errMsg: `service "foo.Bar" not found in Config.ProtoDescriptor.Inline`,
+		},
+		"valid inline descriptor": {
+			args: map[string]any{
+				"Config": map[string]any{
+					"ProtoDescriptor": map[string]any{"Inline": inline},
+					"Services":        []string{"grpc.health.v1.Health"},
+					"PrintOptions": map[string]any{
+						"AddWhitespace": true,
+					},
+				},
+			},
+		},
+		"valid filename descriptor for ingress gateway": {
+			args: map[string]any{
+				"ProxyType": "ingress-gateway",
+				"Config": map[string]any{
+					"ProtoDescriptor": map[string]any{"Filename": "/etc/proto/health.pb"},
+					"Services":        []string{"grpc.health.v1.Health"},
+					"AutoMapping":     true,
+				},
+			},
+		},
+	}
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			extName := api.BuiltinGRPCJSONTranscoderExtension
+			if c.extName != "" {
+				extName = c.extName
+			}
+			ext, err := newGRPCJSONTranscoder(api.EnvoyExtension{Name: extName, Arguments: c.args})
+			if c.errMsg == "" {
+				require.NoError(t, err)
+
+				httpFilter, err := ext.Config.toEnvoyHTTPFilter()
+				require.NoError(t, err)
+				require.NotNil(t, httpFilter)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), c.errMsg)
+			}
+		})
+	}
+}
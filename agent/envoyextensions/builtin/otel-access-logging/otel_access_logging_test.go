@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/hashicorp/consul/agent/envoyextensions/builtin/otelcommon"
 	"github.com/hashicorp/consul/api"
 	"github.com/hashicorp/consul/envoyextensions/extensioncommon"
 )
@@ -19,8 +20,8 @@ func TestConstructor(t *testing.T) {
 			"ListenerType": "inbound",
 			"Config": AccessLog{
 				LogName: "access.log",
-				GrpcService: &GrpcService{
-					Target: &Target{
+				GrpcService: &otelcommon.GrpcService{
+					Target: &otelcommon.Target{
 						Service: api.CompoundServiceName{
 							Name:      "otel-collector",
 							Namespace: "default",
@@ -68,8 +69,8 @@ func TestConstructor(t *testing.T) {
 				ListenerType: "inbound",
 				Config: AccessLog{
 					LogName: "access.log",
-					GrpcService: &GrpcService{
-						Target: &Target{
+					GrpcService: &otelcommon.GrpcService{
+						Target: &otelcommon.Target{
 							Service: api.CompoundServiceName{
 								Name:      "otel-collector",
 								Namespace: "default",
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oteltracing
+
+import (
+	"testing"
+
+	envoy_trace_v3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/envoyextensions/builtin/otelcommon"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/envoyextensions/extensioncommon"
+)
+
+func TestConstructor(t *testing.T) {
+	makeArguments := func(overrides map[string]interface{}) map[string]interface{} {
+		m := map[string]interface{}{
+			"ProxyType":    "connect-proxy",
+			"ListenerType": "inbound",
+			"Config": map[string]interface{}{
+				"GrpcService": map[string]interface{}{
+					"Target": map[string]interface{}{
+						"Service": map[string]interface{}{
+							"Name": "otel-collector",
+						},
+					},
+				},
+			},
+		}
+
+		for k, v := range overrides {
+			m[k] = v
+		}
+
+		return m
+	}
+
+	samplingRate := 25.0
+
+	cases := map[string]struct {
+		extensionName string
+		arguments     map[string]interface{}
+		expected      otelTracing
+		ok            bool
+	}{
+		"with no arguments": {
+			arguments: nil,
+			ok:        false,
+		},
+		"with an invalid name": {
+			arguments:     makeArguments(map[string]interface{}{}),
+			extensionName: "bad",
+			ok:            false,
+		},
+		"invalid proxy type": {
+			arguments: makeArguments(map[string]interface{}{"ProxyType": "terminating-gateway"}),
+			ok:        false,
+		},
+		"invalid listener": {
+			arguments: makeArguments(map[string]interface{}{"ListenerType": "invalid"}),
+			ok:        false,
+		},
+		"missing target": {
+			arguments: makeArguments(map[string]interface{}{
+				"Config": map[string]interface{}{
+					"GrpcService": map[string]interface{}{},
+				},
+			}),
+			ok: false,
+		},
+		"invalid target URI": {
+			arguments: makeArguments(map[string]interface{}{
+				"Config": map[string]interface{}{
+					"GrpcService": map[string]interface{}{
+						"Target": map[string]interface{}{"URI": "otel.example.com:4317"},
+					},
+				},
+			}),
+			ok: false,
+		},
+		"sampling rate out of range": {
+			arguments: makeArguments(map[string]interface{}{
+				"Config": map[string]interface{}{
+					"GrpcService": map[string]interface{}{
+						"Target": map[string]interface{}{"URI": "localhost:4317"},
+					},
+					"SamplingRate": 101,
+				},
+			}),
+			ok: false,
+		},
+		"default proxy type": {
+			arguments: makeArguments(map[string]interface{}{"ProxyType": ""}),
+			expected: otelTracing{
+				ProxyType:    "connect-proxy",
+				ListenerType: "inbound",
+				Config: Tracing{
+					GrpcService: &otelcommon.GrpcService{
+						Target: &otelcommon.Target{
+							Service: api.CompoundServiceName{
+								Name:      "otel-collector",
+								Namespace: "default",
+								Partition: "default",
+							},
+						},
+					},
+				},
+			},
+			ok: true,
+		},
+		"sampling rate and attributes": {
+			arguments: makeArguments(map[string]interface{}{
+				"Config": map[string]interface{}{
+					"GrpcService": map[string]interface{}{
+						"Target": map[string]interface{}{
+							"Service": map[string]interface{}{"Name": "otel-collector"},
+						},
+					},
+					"ServiceName":  "frontend",
+					"SamplingRate": 25,
+					"SpanTags":     map[string]string{"env": "prod"},
+				},
+			}),
+			expected: otelTracing{
+				ProxyType:    "connect-proxy",
+				ListenerType: "inbound",
+				Config: Tracing{
+					GrpcService: &otelcommon.GrpcService{
+						Target: &otelcommon.Target{
+							Service: api.CompoundServiceName{
+								Name:      "otel-collector",
+								Namespace: "default",
+								Partition: "default",
+							},
+						},
+					},
+					ServiceName:  "frontend",
+					SamplingRate: &samplingRate,
+					SpanTags:     map[string]string{"env": "prod"},
+				},
+			},
+			ok: true,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+
+			extensionName := api.BuiltinOTELTracingExtension
+			if tc.extensionName != "" {
+				extensionName = tc.extensionName
+			}
+
+			svc := api.CompoundServiceName{Name: "svc"}
+			ext := extensioncommon.RuntimeConfig{
+				ServiceName: svc,
+				EnvoyExtension: api.EnvoyExtension{
+					Name:      extensionName,
+					Arguments: tc.arguments,
+				},
+			}
+
+			e, err := Constructor(ext.EnvoyExtension)
+
+			if tc.ok {
+				require.NoError(t, err)
+				require.Equal(t, &extensioncommon.BasicEnvoyExtender{Extension: &tc.expected}, e)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestToEnvoyTracing(t *testing.T) {
+	cfg := &extensioncommon.RuntimeConfig{
+		ServiceName: api.CompoundServiceName{Name: "web"},
+	}
+
+	cases := map[string]struct {
+		config              Tracing
+		expectServiceName   string
+		expectSamplingRate  float64
+		expectTags          []string
+		expectCluster       string
+		expectInsertCluster bool
+	}{
+		"service name from proxy": {
+			config: Tracing{
+				GrpcService: &otelcommon.GrpcService{Target: &otelcommon.Target{URI: "localhost:4317"}},
+			},
+			expectServiceName:   "web",
+			expectCluster:       LocalTracingClusterName,
+			expectInsertCluster: true,
+		},
+		"explicit service name, sampling and attributes": {
+			config: Tracing{
+				GrpcService: &otelcommon.GrpcService{Target: &otelcommon.Target{URI: "127.0.0.1:4317"}},
+				ServiceName: "frontend",
+				SamplingRate: func() *float64 {
+					v := 12.5
+					return &v
+				}(),
+				SpanTags: map[string]string{"zone": "a", "env": "prod"},
+			},
+			expectServiceName:   "frontend",
+			expectSamplingRate:  12.5,
+			expectTags:          []string{"env", "zone"},
+			expectCluster:       LocalTracingClusterName,
+			expectInsertCluster: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, tc.config.validate())
+
+			tracing, err := tc.config.toEnvoyTracing(cfg)
+			require.NoError(t, err)
+
+			require.Equal(t, "envoy.tracers.opentelemetry", tracing.Provider.Name)
+			otelConfig := &envoy_trace_v3.OpenTelemetryConfig{}
+			require.NoError(t, tracing.Provider.GetTypedConfig().UnmarshalTo(otelConfig))
+			require.Equal(t, tc.expectServiceName, otelConfig.ServiceName)
+			require.Equal(t, tc.expectCluster, otelConfig.GrpcService.GetEnvoyGrpc().ClusterName)
+
+			if tc.expectSamplingRate != 0 {
+				require.Equal(t, tc.expectSamplingRate, tracing.RandomSampling.Value)
+			} else {
+				require.Nil(t, tracing.RandomSampling)
+			}
+
+			var tags []string
+			for _, tag := range tracing.CustomTags {
+				tags = append(tags, tag.Tag)
+			}
+			require.Equal(t, tc.expectTags, tags)
+
+			cluster, err := tc.config.toEnvoyCluster(cfg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectInsertCluster, cluster != nil)
+		})
+	}
+}
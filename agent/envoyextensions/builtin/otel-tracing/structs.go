@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package oteltracing
+
+import (
+	"fmt"
+	"sort"
+
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_trace_v3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tracing_v3 "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/hashicorp/consul/agent/envoyextensions/builtin/otelcommon"
+	cmn "github.com/hashicorp/consul/envoyextensions/extensioncommon"
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const LocalTracingClusterName = "local_otel_tracing"
+
+type Tracing struct {
+	GrpcService *otelcommon.GrpcService
+	// ServiceName is the name reported to the collector for spans emitted by
+	// the proxy. It defaults to the name of the service the proxy represents.
+	ServiceName string
+	// SamplingRate is the percentage of requests, between 0 and 100, that
+	// are randomly selected for tracing. Envoy samples every request when
+	// it is not set.
+	SamplingRate *float64
+	// MaxPathTagLength is the maximum length of the request path recorded
+	// on a span.
+	MaxPathTagLength uint32
+	// SpanTags are added as literal tags to every span emitted by the proxy.
+	SpanTags map[string]string
+}
+
+func (t *Tracing) normalize() {
+	if t.GrpcService != nil {
+		t.GrpcService.Normalize()
+	}
+}
+
+func (t *Tracing) validate() error {
+	t.normalize()
+
+	if t.GrpcService == nil {
+		return fmt.Errorf("missing GrpcService")
+	}
+
+	var resultErr error
+
+	if err := t.GrpcService.Validate(); err != nil {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("failed to validate Config.GrpcService: %w", err))
+	}
+
+	if t.SamplingRate != nil && (*t.SamplingRate < 0 || *t.SamplingRate > 100) {
+		resultErr = multierror.Append(resultErr, fmt.Errorf("Config.SamplingRate must be between 0 and 100, got %v", *t.SamplingRate))
+	}
+
+	for key := range t.SpanTags {
+		if key == "" {
+			resultErr = multierror.Append(resultErr, fmt.Errorf("Config.SpanTags keys must not be empty"))
+			break
+		}
+	}
+
+	return resultErr
+}
+
+func (t *Tracing) envoyGrpcService(cfg *cmn.RuntimeConfig) (*envoy_core_v3.GrpcService, error) {
+	return t.GrpcService.ToEnvoy(cfg, LocalTracingClusterName)
+}
+
+// toEnvoyCluster returns an Envoy cluster for connecting to a collector running on localhost,
+// or nil if the collector is an upstream.
+func (t *Tracing) toEnvoyCluster(_ *cmn.RuntimeConfig) (*envoy_cluster_v3.Cluster, error) {
+	return t.GrpcService.ToEnvoyCluster(LocalTracingClusterName)
+}
+
+// toEnvoyTracing returns the tracing configuration for the HTTP connection manager.
+func (t *Tracing) toEnvoyTracing(cfg *cmn.RuntimeConfig) (*envoy_http_v3.HttpConnectionManager_Tracing, error) {
+	grpcSvc, err := t.envoyGrpcService(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := t.ServiceName
+	if serviceName == "" {
+		serviceName = cfg.ServiceName.Name
+	}
+
+	otelConfig, err := anypb.New(&envoy_trace_v3.OpenTelemetryConfig{
+		GrpcService: grpcSvc,
+		ServiceName: serviceName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OpenTelemetryConfig: %w", err)
+	}
+
+	tracing := &envoy_http_v3.HttpConnectionManager_Tracing{
+		Provider: &envoy_trace_v3.Tracing_Http{
+			Name: "envoy.tracers.opentelemetry",
+			ConfigType: &envoy_trace_v3.Tracing_Http_TypedConfig{
+				TypedConfig: otelConfig,
+			},
+		},
+		CustomTags: t.customTags(),
+	}
+
+	if t.SamplingRate != nil {
+		tracing.RandomSampling = &envoy_type_v3.Percent{Value: *t.SamplingRate}
+	}
+
+	if t.MaxPathTagLength > 0 {
+		tracing.MaxPathTagLength = wrapperspb.UInt32(t.MaxPathTagLength)
+	}
+
+	return tracing, nil
+}
+
+// customTags returns the span tags as literal custom tags, sorted by
+// key so the generated configuration is stable.
+func (t *Tracing) customTags() []*envoy_tracing_v3.CustomTag {
+	keys := make([]string, 0, len(t.SpanTags))
+	for key := range t.SpanTags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var tags []*envoy_tracing_v3.CustomTag
+	for _, key := range keys {
+		tags = append(tags, &envoy_tracing_v3.CustomTag{
+			Tag: key,
+			Type: &envoy_tracing_v3.CustomTag_Literal_{
+				Literal: &envoy_tracing_v3.CustomTag_Literal{Value: t.SpanTags[key]},
+			},
+		})
+	}
+	return tags
+}
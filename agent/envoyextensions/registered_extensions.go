@@ -11,8 +11,10 @@ import (
 
 	awslambda "github.com/hashicorp/consul/agent/envoyextensions/builtin/aws-lambda"
 	extauthz "github.com/hashicorp/consul/agent/envoyextensions/builtin/ext-authz"
+	grpcjsontranscoder "github.com/hashicorp/consul/agent/envoyextensions/builtin/grpc-json-transcoder"
 	"github.com/hashicorp/consul/agent/envoyextensions/builtin/lua"
 	otelaccesslogging "github.com/hashicorp/consul/agent/envoyextensions/builtin/otel-access-logging"
+	oteltracing "github.com/hashicorp/consul/agent/envoyextensions/builtin/otel-tracing"
 	propertyoverride "github.com/hashicorp/consul/agent/envoyextensions/builtin/property-override"
 	"github.com/hashicorp/consul/agent/envoyextensions/builtin/wasm"
 	"github.com/hashicorp/consul/api"
@@ -22,12 +24,14 @@ import (
 type extensionConstructor func(api.EnvoyExtension) (extensioncommon.EnvoyExtender, error)
 
 var extensionConstructors = map[string]extensionConstructor{
-	api.BuiltinOTELAccessLoggingExtension: otelaccesslogging.Constructor,
-	api.BuiltinLuaExtension:               lua.Constructor,
-	api.BuiltinAWSLambdaExtension:         awslambda.Constructor,
-	api.BuiltinPropertyOverrideExtension:  propertyoverride.Constructor,
-	api.BuiltinWasmExtension:              wasm.Constructor,
-	api.BuiltinExtAuthzExtension:          extauthz.Constructor,
+	api.BuiltinOTELAccessLoggingExtension:  otelaccesslogging.Constructor,
+	api.BuiltinOTELTracingExtension:        oteltracing.Constructor,
+	api.BuiltinLuaExtension:                lua.Constructor,
+	api.BuiltinAWSLambdaExtension:          awslambda.Constructor,
+	api.BuiltinPropertyOverrideExtension:   propertyoverride.Constructor,
+	api.BuiltinWasmExtension:               wasm.Constructor,
+	api.BuiltinExtAuthzExtension:           extauthz.Constructor,
+	api.BuiltinGRPCJSONTranscoderExtension: grpcjsontranscoder.Constructor,
 }
 
 // ConstructExtension attempts to lookup and build an extension from the registry with the
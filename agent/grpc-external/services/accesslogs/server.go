@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package accesslogs implements an OpenTelemetry logs collector so that Envoy
+// proxies can send their access logs to the local agent with the builtin
+// otel-access-logging extension. The agent reports the log records of
+// requests and connections denied by intentions to the servers, which
+// aggregate them for /v1/connect/intentions/denials.
+package accesslogs
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	"google.golang.org/grpc"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+// The attributes a log record must carry for the agent to recognize a denial.
+// The names and values match Consul's default JSON access log format, so they
+// can be configured as the Attributes of the otel-access-logging extension.
+const (
+	// AttributeResponseCodeDetails is set to %RESPONSE_CODE_DETAILS%.
+	AttributeResponseCodeDetails = "response_code_details"
+
+	// AttributeConnectionTerminationDetails is set to
+	// %CONNECTION_TERMINATION_DETAILS%.
+	AttributeConnectionTerminationDetails = "connection_termination_details"
+
+	// AttributeDownstreamPeerURISAN is set to %DOWNSTREAM_PEER_URI_SAN%.
+	AttributeDownstreamPeerURISAN = "downstream_peer_uri_san"
+
+	// AttributeIntentionHTTPDenyPolicy is set to
+	// %DYNAMIC_METADATA(envoy.filters.http.rbac:shadow_effective_policy_id)%.
+	AttributeIntentionHTTPDenyPolicy = "intention_http_deny_policy"
+
+	// AttributeIntentionNetworkDenyPolicy is set to
+	// %DYNAMIC_METADATA(envoy.filters.network.rbac:shadow_effective_policy_id)%.
+	AttributeIntentionNetworkDenyPolicy = "intention_network_deny_policy"
+)
+
+// resourceAttributeNodeName is set by Envoy to the ID of its node, which is
+// the ID of the proxy service.
+const resourceAttributeNodeName = "node_name"
+
+// rbacDeniedDetailsPrefix prefixes the response code details and connection
+// termination details Envoy's RBAC filters set when they deny a request or
+// connection.
+const rbacDeniedDetailsPrefix = "rbac_access_denied"
+
+type Config struct {
+	Logger hclog.Logger
+
+	// GetProxy returns the connect proxy registered with the agent with the
+	// given ID and its ACL token. It returns nil if there is no such proxy.
+	GetProxy func(proxyID string) (*structs.NodeService, string)
+
+	// RecordDenial buffers a denial to report to the servers with token.
+	RecordDenial func(token string, denial structs.IntentionDenial)
+}
+
+type Server struct {
+	Config
+
+	collogspb.UnimplementedLogsServiceServer
+}
+
+func NewServer(cfg Config) *Server {
+	return &Server{Config: cfg}
+}
+
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	collogspb.RegisterLogsServiceServer(registrar, s)
+}
+
+// Export is a gRPC endpoint that receives the access logs of local proxies.
+// Records that are not intention denials are discarded, as are the records of
+// proxies that are not registered with the agent.
+func (s *Server) Export(_ context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	var rejected int64
+	for _, rl := range req.GetResourceLogs() {
+		proxyID := stringAttributes(rl.GetResource().GetAttributes())[resourceAttributeNodeName]
+		proxy, token := s.GetProxy(proxyID)
+		if proxy == nil {
+			for _, sl := range rl.GetScopeLogs() {
+				rejected += int64(len(sl.GetLogRecords()))
+			}
+			s.Logger.Debug("discarding access logs of unknown proxy", "proxy", proxyID)
+			continue
+		}
+
+		for _, sl := range rl.GetScopeLogs() {
+			for _, record := range sl.GetLogRecords() {
+				denial, ok := denialFromAttributes(stringAttributes(record.GetAttributes()))
+				if !ok {
+					continue
+				}
+				denial.DestinationName = proxy.Proxy.DestinationServiceName
+				denial.DestinationNS = proxy.EnterpriseMeta.NamespaceOrEmpty()
+				denial.DestinationPartition = proxy.EnterpriseMeta.PartitionOrEmpty()
+				if ts := record.GetTimeUnixNano(); ts != 0 {
+					denial.Timestamp = time.Unix(0, int64(ts))
+				}
+				s.RecordDenial(token, denial)
+			}
+		}
+	}
+
+	resp := &collogspb.ExportLogsServiceResponse{}
+	if rejected > 0 {
+		resp.PartialSuccess = &collogspb.ExportLogsPartialSuccess{
+			RejectedLogRecords: rejected,
+			ErrorMessage:       "proxy is not registered with this agent",
+		}
+	}
+	return resp, nil
+}
+
+// denialFromAttributes returns the denial described by the attributes of a
+// log record, or false if the record is not of a request or connection denied
+// by an RBAC filter.
+func denialFromAttributes(attrs map[string]string) (structs.IntentionDenial, bool) {
+	var policyID string
+	switch {
+	case strings.HasPrefix(attrs[AttributeResponseCodeDetails], rbacDeniedDetailsPrefix):
+		policyID = attrs[AttributeIntentionHTTPDenyPolicy]
+	case strings.HasPrefix(attrs[AttributeConnectionTerminationDetails], rbacDeniedDetailsPrefix):
+		policyID = attrs[AttributeIntentionNetworkDenyPolicy]
+	default:
+		return structs.IntentionDenial{}, false
+	}
+
+	sourceURI := attrs[AttributeDownstreamPeerURISAN]
+	if sourceURI == "" {
+		return structs.IntentionDenial{}, false
+	}
+
+	denial := structs.IntentionDenial{SourceURI: sourceURI}
+	if _, _, ok := structs.ParseIntentionDenyPolicyID(policyID); ok {
+		denial.PolicyID = policyID
+	}
+	return denial, true
+}
+
+// stringAttributes returns the string attributes in kvs. Envoy formats unset
+// command operators as "-", which is treated as empty.
+func stringAttributes(kvs []*commonpb.KeyValue) map[string]string {
+	attrs := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		if v := kv.GetValue().GetStringValue(); v != "" && v != "-" {
+			attrs[kv.GetKey()] = v
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package accesslogs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestServer_Export(t *testing.T) {
+	proxy := &structs.NodeService{
+		Kind:    structs.ServiceKindConnectProxy,
+		ID:      "db-sidecar-proxy",
+		Service: "db-sidecar-proxy",
+		Proxy:   structs.ConnectProxyConfig{DestinationServiceName: "db"},
+	}
+
+	type recorded struct {
+		token  string
+		denial structs.IntentionDenial
+	}
+	var denials []recorded
+	srv := NewServer(Config{
+		Logger: hclog.NewNullLogger(),
+		GetProxy: func(proxyID string) (*structs.NodeService, string) {
+			if proxyID == proxy.ID {
+				return proxy, "proxy-token"
+			}
+			return nil, ""
+		},
+		RecordDenial: func(token string, denial structs.IntentionDenial) {
+			denials = append(denials, recorded{token: token, denial: denial})
+		},
+	})
+
+	ts := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	resourceLogs := func(proxyID string, records ...*logspb.LogRecord) *logspb.ResourceLogs {
+		return &logspb.ResourceLogs{
+			Resource: &resourcepb.Resource{
+				Attributes: attributes(map[string]string{resourceAttributeNodeName: proxyID}),
+			},
+			ScopeLogs: []*logspb.ScopeLogs{{LogRecords: records}},
+		}
+	}
+	record := func(attrs map[string]string) *logspb.LogRecord {
+		return &logspb.LogRecord{
+			TimeUnixNano: uint64(ts.UnixNano()),
+			Attributes:   attributes(attrs),
+		}
+	}
+
+	resp, err := srv.Export(context.Background(), &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{
+			resourceLogs(proxy.ID,
+				// Denied by a deny intention.
+				record(map[string]string{
+					AttributeResponseCodeDetails:     "rbac_access_denied_matched_policy[none]",
+					AttributeDownstreamPeerURISAN:    "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web",
+					AttributeIntentionHTTPDenyPolicy: "consul-intentions-deny:web",
+				}),
+				// Denied by the default intention policy.
+				record(map[string]string{
+					AttributeConnectionTerminationDetails: "rbac_access_denied_matched_policy[none]",
+					AttributeDownstreamPeerURISAN:         "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/api",
+					AttributeIntentionNetworkDenyPolicy:   "-",
+				}),
+				// Allowed request.
+				record(map[string]string{
+					AttributeResponseCodeDetails:  "via_upstream",
+					AttributeDownstreamPeerURISAN: "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web",
+				}),
+				// Denial without a client certificate.
+				record(map[string]string{
+					AttributeResponseCodeDetails: "rbac_access_denied_matched_policy[none]",
+				}),
+			),
+			resourceLogs("unknown-proxy",
+				record(map[string]string{
+					AttributeResponseCodeDetails:  "rbac_access_denied_matched_policy[none]",
+					AttributeDownstreamPeerURISAN: "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web",
+				}),
+			),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resp.GetPartialSuccess().GetRejectedLogRecords())
+
+	require.Equal(t, []recorded{
+		{
+			token: "proxy-token",
+			denial: structs.IntentionDenial{
+				SourceURI:       "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web",
+				DestinationName: "db",
+				PolicyID:        "consul-intentions-deny:web",
+				Timestamp:       ts.Local(),
+			},
+		},
+		{
+			token: "proxy-token",
+			denial: structs.IntentionDenial{
+				SourceURI:       "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/api",
+				DestinationName: "db",
+				Timestamp:       ts.Local(),
+			},
+		},
+	}, denials)
+}
+
+func attributes(attrs map[string]string) []*commonpb.KeyValue {
+	kvs := make([]*commonpb.KeyValue, 0, len(attrs))
+	for k, v := range attrs {
+		kvs = append(kvs, &commonpb.KeyValue{
+			Key:   k,
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}},
+		})
+	}
+	return kvs
+}
@@ -123,6 +123,22 @@ func makeServerAddrsResponse(
 	}, nil
 }
 
+func makeStreamSecretResponse(
+	update cache.UpdateEvent,
+) (*pbpeerstream.ReplicationMessage_Response, error) {
+	any, _, err := marshalToProtoAny[*pbpeering.PeeringSecrets_Stream](update.Result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal: %w", err)
+	}
+
+	return &pbpeerstream.ReplicationMessage_Response{
+		ResourceURL: pbpeerstream.TypeURLPeeringStreamSecret,
+		ResourceID:  "stream-secret",
+		Operation:   pbpeerstream.Operation_OPERATION_UPSERT,
+		Resource:    any,
+	}, nil
+}
+
 // marshalToProtoAny takes any input and returns:
 // the protobuf.Any type, the asserted T type, and any errors
 // during marshalling or type assertion.
@@ -260,6 +276,14 @@ func (s *Server) handleUpsert(
 		}
 
 		return s.handleUpsertServerAddrs(peerName, partition, addrs)
+
+	case pbpeerstream.TypeURLPeeringStreamSecret:
+		secret := &pbpeering.PeeringSecrets_Stream{}
+		if err := resource.UnmarshalTo(secret); err != nil {
+			return fmt.Errorf("failed to unmarshal resource: %w", err)
+		}
+
+		return s.handleUpsertStreamSecret(peerName, partition, secret)
 	default:
 		return fmt.Errorf("unexpected resourceURL: %s", resourceURL)
 	}
@@ -271,12 +295,23 @@ func (s *Server) handleUpsertExportedServiceList(
 	partition string,
 	export *pbpeerstream.ExportedServiceList,
 ) error {
+	imports, err := s.importedServicesEntry(peerName, partition)
+	if err != nil {
+		return err
+	}
+
 	exportedServices := make(map[structs.ServiceName]struct{})
 	var serviceNames []structs.ServiceName
 	for _, service := range export.Services {
 		sn := structs.ServiceNameFromString(service)
 		sn.OverridePartition(partition)
 
+		// Services that are not imported are left out, so that any of their
+		// previously imported instances get deleted below.
+		if !imports.ImportsService(sn) {
+			continue
+		}
+
 		// This ensures that we don't delete exported service's sidecars below.
 		snSidecarProxy := structs.ServiceNameFromString(service + syntheticProxyNameSuffix)
 		snSidecarProxy.OverridePartition(partition)
@@ -308,6 +343,48 @@ func (s *Server) handleUpsertExportedServiceList(
 	return nil
 }
 
+// importedServicesEntry returns the imported-services config entry for the peer,
+// or nil if there is none and all exported services are imported.
+func (s *Server) importedServicesEntry(peerName, partition string) (*structs.ImportedServicesConfigEntry, error) {
+	_, raw, err := s.GetStore().ConfigEntry(nil, structs.ImportedServices, peerName, structs.DefaultEnterpriseMetaInPartition(partition))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read imported-services config entry: %w", err)
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	entry, ok := raw.(*structs.ImportedServicesConfigEntry)
+	if !ok {
+		return nil, fmt.Errorf("invalid type %T for imported-services config entry", raw)
+	}
+	return entry, nil
+}
+
+// filterImportedInstances removes the instances that are not allowed by the
+// imported-services config entry. Synthetic sidecar proxies do not carry the tags
+// or metadata of their destination, so they are kept as long as the destination
+// service can be imported.
+func filterImportedInstances(imports *structs.ImportedServicesConfigEntry, nodes []structs.CheckServiceNode) []structs.CheckServiceNode {
+	if imports == nil {
+		return nodes
+	}
+
+	filtered := make([]structs.CheckServiceNode, 0, len(nodes))
+	for _, csn := range nodes {
+		if csn.Service.Kind == structs.ServiceKindConnectProxy {
+			dest := structs.NewServiceName(csn.Service.Proxy.DestinationServiceName, &csn.Service.EnterpriseMeta)
+			if !imports.ImportsService(dest) {
+				continue
+			}
+		} else if !imports.ImportsInstance(csn.Service) {
+			continue
+		}
+		filtered = append(filtered, csn)
+	}
+	return filtered
+}
+
 // handleUpdateService handles both deletion and upsert events for a service.
 //
 //	On an UPSERT event:
@@ -335,6 +412,12 @@ func (s *Server) handleUpdateService(
 		if err != nil {
 			return fmt.Errorf("failed to convert protobuf instances to structs: %w", err)
 		}
+
+		imports, err := s.importedServicesEntry(peerName, partition)
+		if err != nil {
+			return err
+		}
+		structsNodes = filterImportedInstances(imports, structsNodes)
 	}
 
 	// Normalize the data into a convenient form for operation.
@@ -586,6 +669,40 @@ func (s *Server) handleUpsertServerAddrs(
 	return s.Backend.PeeringWrite(req)
 }
 
+// handleUpsertStreamSecret persists the stream secret handed over by the
+// accepting peer after its secrets were rotated. The new secret is used the
+// next time the stream is opened.
+func (s *Server) handleUpsertStreamSecret(
+	peerName string,
+	partition string,
+	secret *pbpeering.PeeringSecrets_Stream,
+) error {
+	if secret.GetPendingSecretID() == "" {
+		return fmt.Errorf("missing stream secret")
+	}
+
+	q := state.Query{
+		Value:          peerName,
+		EnterpriseMeta: *structs.DefaultEnterpriseMetaInPartition(partition),
+	}
+	_, existing, err := s.GetStore().PeeringRead(nil, q)
+	if err != nil {
+		return fmt.Errorf("failed to read peering: %w", err)
+	}
+	if existing == nil || !existing.IsActive() {
+		return fmt.Errorf("peering does not exist or has been marked for deletion")
+	}
+
+	return s.Backend.PeeringSecretsWrite(&pbpeering.SecretsWriteRequest{
+		PeerID: existing.ID,
+		Request: &pbpeering.SecretsWriteRequest_Establish{
+			Establish: &pbpeering.SecretsWriteRequest_EstablishRequest{
+				ActiveStreamSecret: secret.GetPendingSecretID(),
+			},
+		},
+	})
+}
+
 func makeACKReply(resourceURL, nonce string) *pbpeerstream.ReplicationMessage {
 	return makeReplicationRequest(&pbpeerstream.ReplicationMessage_Request{
 		ResourceURL:   resourceURL,
@@ -14,6 +14,7 @@ import (
 
 	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-memdb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
@@ -26,6 +27,7 @@ import (
 	"github.com/hashicorp/consul/lib"
 	"github.com/hashicorp/consul/proto/private/pbpeering"
 	"github.com/hashicorp/consul/proto/private/pbpeerstream"
+	"github.com/hashicorp/consul/proto/private/pbservice"
 )
 
 type BidirectionalStream interface {
@@ -368,16 +370,16 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 
 		// We only track send successes and errors for response types because this is meant to track
 		// resources, not request/ack messages.
-		if msg.GetResponse() != nil {
+		if resp := msg.GetResponse(); resp != nil {
 			if err != nil {
-				if id := msg.GetResponse().GetResourceID(); id != "" {
+				if id := resp.GetResourceID(); id != "" {
 					logger.Error("failed to send resource", "resourceID", id, "error", err)
 					status.TrackSendError(err.Error())
 					return nil
 				}
 				status.TrackSendError(err.Error())
 			} else {
-				status.TrackSendSuccess()
+				status.TrackResourceSent(resp.ResourceURL, resp.ResourceID, resp.Nonce)
 			}
 		}
 		return err
@@ -389,8 +391,15 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 		pbpeerstream.TypeURLPeeringTrustBundle,
 	}
 	// Acceptors should not subscribe to server address updates, because they should always have an empty list.
+	// Stream secrets are generated by acceptors, so only dialers subscribe to them.
 	if !streamReq.IsAcceptor() {
 		resources = append(resources, pbpeerstream.TypeURLPeeringServerAddresses)
+
+		// Acceptors that predate secret rotation reject the subscription and
+		// end the stream, so it is only sent until a peer has rejected it.
+		if !status.StreamSecretUnsupported() {
+			resources = append(resources, pbpeerstream.TypeURLPeeringStreamSecret)
+		}
 	}
 
 	// Subscribe to all relevant resource types.
@@ -451,6 +460,12 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 		}
 	}()
 
+	// Services that were filtered out by a previous version of the imported-services
+	// config entry are only sent again by the peer on a new stream, so the stream is
+	// reset whenever the entry changes.
+	importsChangedCh := make(chan struct{})
+	go s.watchImportedServices(handleStreamCtx, logger, streamReq.PeerName, streamReq.Partition, importsChangedCh)
+
 	// incomingHeartbeatCtx will complete if incoming heartbeats time out.
 	incomingHeartbeatCtx, incomingHeartbeatCtxCancel :=
 		context.WithTimeout(context.Background(), s.incomingHeartbeatTimeout)
@@ -464,6 +479,11 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 	// The nonce is used to correlate response/(ack|nack) pairs.
 	var nonce uint64
 
+	// rotatedSecret is the pending stream secret last sent to the dialer, and
+	// rotatedSecretNonce the nonce of the response that carried it. The secret
+	// is promoted to active once the dialer acknowledges it.
+	var rotatedSecret, rotatedSecretNonce string
+
 	// The main loop that processes sends and receives.
 	for {
 		select {
@@ -498,6 +518,10 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 				// by DrainStream().
 				err = fmt.Errorf("stream ended unexpectedly")
 			} else {
+				if !streamReq.IsAcceptor() && isStreamSecretUnsupported(err) {
+					logger.Warn("peer does not support stream secret rotation, the stream will reconnect without subscribing to stream secrets")
+					status.TrackStreamSecretUnsupported()
+				}
 				err = fmt.Errorf("unexpected error receiving from the stream: %w", err)
 			}
 			status.TrackRecvError(err.Error())
@@ -507,6 +531,10 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 		case <-incomingHeartbeatCtx.Done():
 			return fmt.Errorf("heartbeat timeout")
 
+		case <-importsChangedCh:
+			logger.Info("imported-services config entry changed, resetting stream to re-import services")
+			return grpcstatus.Error(codes.FailedPrecondition, "imported-services config entry changed")
+
 		case msg := <-recvCh:
 			// NOTE: this code should have similar error handling to the
 			// initial handling code in StreamResources()
@@ -596,12 +624,17 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 				switch {
 				case req.Error == nil: // ACK
 					// TODO(peering): handle ACK fully
-					status.TrackAck()
+					status.TrackResourceAck(req.ResponseNonce)
+
+					if rotatedSecretNonce != "" && req.ResponseNonce == rotatedSecretNonce {
+						s.promoteRotatedSecret(logger, streamReq.LocalID, rotatedSecret)
+						rotatedSecret, rotatedSecretNonce = "", ""
+					}
 
 				case req.Error != nil: // NACK
 					// TODO(peering): handle NACK fully
 					logger.Warn("client peer was unable to apply resource", "code", req.Error.Code, "error", req.Error.Message)
-					status.TrackNack(fmt.Sprintf("client peer was unable to apply resource: %s", req.Error.Message))
+					status.TrackResourceNack(req.ResponseNonce, fmt.Sprintf("client peer was unable to apply resource: %s", req.Error.Message))
 
 				default:
 					// This branch might be dead code, but it could also happen
@@ -656,7 +689,12 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 			}
 
 		case update := <-subCh:
-			var resp *pbpeerstream.ReplicationMessage_Response
+			var (
+				resp *pbpeerstream.ReplicationMessage_Response
+				// deleted is set for responses that remove the resource
+				// from the peer.
+				deleted bool
+			)
 			switch {
 			case strings.HasPrefix(update.CorrelationID, subExportedServiceList):
 				resp, err = makeExportedServiceListResponse(status, update)
@@ -672,6 +710,7 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 					logger.Error("failed to create service response", "error", err)
 					continue
 				}
+				deleted = len(update.Result.(*pbservice.IndexedCheckServiceNodes).Nodes) == 0
 
 			case update.CorrelationID == subCARoot:
 				resp, err = makeCARootsResponse(update)
@@ -688,6 +727,13 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 					continue
 				}
 
+			case update.CorrelationID == subStreamSecret:
+				resp, err = makeStreamSecretResponse(update)
+				if err != nil {
+					logger.Error("failed to create stream secret response", "error", err)
+					continue
+				}
+
 			default:
 				logger.Warn("unrecognized update type from subscription manager: " + update.CorrelationID)
 				continue
@@ -705,6 +751,79 @@ func (s *Server) realHandleStream(streamReq HandleStreamRequest) error {
 				// note: govet warns of context leak but it is cleaned up in a defer
 				return fmt.Errorf("failed to push data for %q: %w", update.CorrelationID, err)
 			}
+			if deleted {
+				status.TrackResourceDeleted(resp.ResourceURL, resp.ResourceID)
+			}
+
+			if update.CorrelationID == subStreamSecret {
+				rotatedSecret = update.Result.(*pbpeering.PeeringSecrets_Stream).GetPendingSecretID()
+				rotatedSecretNonce = resp.Nonce
+			}
+		}
+	}
+}
+
+// promoteRotatedSecret promotes the pending stream secret acknowledged by the
+// dialer, which invalidates the previously active secret. Failures are only
+// logged: the dialer uses the pending secret the next time it opens a stream,
+// which promotes it as well.
+func (s *Server) promoteRotatedSecret(logger hclog.Logger, peerID, secret string) {
+	err := s.Backend.PeeringSecretsWrite(&pbpeering.SecretsWriteRequest{
+		PeerID: peerID,
+		Request: &pbpeering.SecretsWriteRequest_PromotePending{
+			PromotePending: &pbpeering.SecretsWriteRequest_PromotePendingRequest{
+				ActiveStreamSecret: secret,
+			},
+		},
+	})
+	if err != nil {
+		logger.Warn("failed to promote rotated stream secret", "error", err)
+		return
+	}
+	logger.Info("rotated peering stream secret")
+}
+
+// watchImportedServices closes changedCh when the imported-services config entry
+// of the peer is created, modified or deleted after the stream was opened.
+func (s *Server) watchImportedServices(
+	ctx context.Context,
+	logger hclog.Logger,
+	peerName string,
+	partition string,
+	changedCh chan<- struct{},
+) {
+	entMeta := structs.DefaultEnterpriseMetaInPartition(partition)
+
+	var (
+		lastIndex uint64
+		first     = true
+	)
+	for {
+		store := s.GetStore()
+
+		ws := memdb.NewWatchSet()
+		ws.Add(store.AbandonCh())
+
+		_, entry, err := store.ConfigEntry(ws, structs.ImportedServices, peerName, entMeta)
+		if err != nil {
+			logger.Error("failed to watch imported-services config entry", "error", err)
+			return
+		}
+
+		var index uint64
+		if entry != nil {
+			index = entry.GetRaftIndex().ModifyIndex
+		}
+		if !first && index != lastIndex {
+			close(changedCh)
+			return
+		}
+		first = false
+		lastIndex = index
+
+		if err := ws.WatchCtx(ctx); err != nil {
+			// The stream was closed.
+			return
 		}
 	}
 }
@@ -722,6 +841,16 @@ func getTrustDomain(store StateStore, logger hclog.Logger) (string, error) {
 	return connect.SpiffeIDSigningForCluster(cfg.ClusterID).Host(), nil
 }
 
+// isStreamSecretUnsupported returns true if err is the rejection of a
+// subscription to stream secrets by an acceptor that does not know the resource.
+func isStreamSecretUnsupported(err error) bool {
+	st, ok := grpcstatus.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return false
+	}
+	return strings.HasSuffix(st.Message(), "unknown resource URL: "+pbpeerstream.TypeURLPeeringStreamSecret)
+}
+
 func (s *Server) StreamStatus(peerID string) (resp Status, found bool) {
 	return s.Tracker.StreamStatus(peerID)
 }
@@ -760,6 +889,10 @@ func logTraceProto(logger hclog.Logger, pb proto.Message, received bool) {
 			clone.GetOpen().StreamSecretID = "hidden"
 			pbToLog = clone
 		}
+		if clone.GetResponse().GetResourceURL() == pbpeerstream.TypeURLPeeringStreamSecret {
+			clone.GetResponse().Resource = nil
+			pbToLog = clone
+		}
 	case *pbpeerstream.ReplicationMessage_Open:
 		clone := &pbpeerstream.ReplicationMessage_Open{}
 		proto.Merge(clone, msg)
@@ -550,7 +550,7 @@ func TestStreamResources_Server_StreamTracker(t *testing.T) {
 	it := incrementalTime{
 		base: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
 	}
-	waitUntil := it.FutureNow(7)
+	waitUntil := it.FutureNow(8)
 
 	srv, store := newTestServer(t, nil)
 	srv.Tracker.setClock(it.Now)
@@ -1173,6 +1173,218 @@ func TestStreamResources_Server_CARootUpdates(t *testing.T) {
 	})
 }
 
+func TestStreamResources_Server_StreamSecretRotation(t *testing.T) {
+	srv, store := newTestServer(t, nil)
+
+	p := writePeeringToBeDialed(t, store, 1, "my-peer")
+	require.Empty(t, p.PeerID, "should be empty if being dialed")
+
+	// Set the initial roots and CA configuration.
+	_, _ = writeInitialRootsAndCA(t, store)
+
+	client := makeClient(t, srv, testPeerID)
+	client.DrainStream(t)
+
+	// Subscribe to stream secrets the way a dialing peer does.
+	require.NoError(t, client.Send(&pbpeerstream.ReplicationMessage{
+		Payload: &pbpeerstream.ReplicationMessage_Request_{
+			Request: &pbpeerstream.ReplicationMessage_Request{
+				PeerID:      testPeerID,
+				ResourceURL: pbpeerstream.TypeURLPeeringStreamSecret,
+			},
+		},
+	}))
+
+	const rotatedSecretID = "0c6a1b0e-4b0a-4f33-9d3c-1f7b6c2a5e90"
+
+	var nonce string
+	testutil.RunStep(t, "rotated secret is sent to the dialer", func(t *testing.T) {
+		require.NoError(t, store.PeeringSecretsWrite(2, &pbpeering.SecretsWriteRequest{
+			PeerID: testPeerID,
+			Request: &pbpeering.SecretsWriteRequest_RotateSecret{
+				RotateSecret: &pbpeering.SecretsWriteRequest_RotateSecretRequest{
+					PendingStreamSecret: rotatedSecretID,
+				},
+			},
+		}))
+
+		expectReplEvents(t, client,
+			func(t *testing.T, msg *pbpeerstream.ReplicationMessage) {
+				require.Equal(t, pbpeerstream.TypeURLPeeringStreamSecret, msg.GetResponse().ResourceURL)
+				require.Equal(t, "stream-secret", msg.GetResponse().ResourceID)
+				require.Equal(t, pbpeerstream.Operation_OPERATION_UPSERT, msg.GetResponse().Operation)
+
+				var secret pbpeering.PeeringSecrets_Stream
+				require.NoError(t, msg.GetResponse().Resource.UnmarshalTo(&secret))
+				require.Equal(t, rotatedSecretID, secret.PendingSecretID)
+
+				nonce = msg.GetResponse().Nonce
+			},
+		)
+
+		// The previous secret stays active until the dialer acknowledges the new one.
+		secrets, err := store.PeeringSecretsRead(nil, testPeerID)
+		require.NoError(t, err)
+		require.Equal(t, testPendingStreamSecretID, secrets.GetStream().GetActiveSecretID())
+		require.Equal(t, rotatedSecretID, secrets.GetStream().GetPendingSecretID())
+	})
+
+	testutil.RunStep(t, "ack promotes the rotated secret", func(t *testing.T) {
+		require.NoError(t, client.Send(&pbpeerstream.ReplicationMessage{
+			Payload: &pbpeerstream.ReplicationMessage_Request_{
+				Request: &pbpeerstream.ReplicationMessage_Request{
+					ResourceURL:   pbpeerstream.TypeURLPeeringStreamSecret,
+					ResponseNonce: nonce,
+				},
+			},
+		}))
+
+		retry.Run(t, func(r *retry.R) {
+			secrets, err := store.PeeringSecretsRead(nil, testPeerID)
+			require.NoError(r, err)
+			require.Empty(r, secrets.GetStream().GetPendingSecretID())
+			require.Equal(r, rotatedSecretID, secrets.GetStream().GetActiveSecretID())
+		})
+	})
+}
+
+func TestStreamResources_Dialer_AcceptorWithoutStreamSecrets(t *testing.T) {
+	srv, store := newTestServer(t, nil)
+
+	p := writePeeringToDialFrom(t, store, 1, "my-peer")
+	require.NotEmpty(t, p.PeerID, "should be set when dialing")
+
+	// Set the initial roots and CA configuration.
+	_, _ = writeInitialRootsAndCA(t, store)
+
+	// dial opens a stream to an acceptor that predates stream secret rotation,
+	// which rejects subscriptions to stream secrets as unknown resources. It
+	// returns the resources the dialer subscribed to and the error the dialer
+	// ended the stream with.
+	dial := func(t *testing.T) ([]string, error) {
+		stream := &oldAcceptorStream{
+			MockStream: newTestReplicationStream(context.Background()),
+			errCh:      make(chan error, 1),
+		}
+		client := &MockClient{ReplicationStream: stream.MockStream}
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- srv.HandleStream(HandleStreamRequest{
+				LocalID:   p.ID,
+				RemoteID:  p.PeerID,
+				PeerName:  p.Name,
+				Partition: p.Partition,
+				Stream:    stream,
+			})
+		}()
+
+		var subscribed []string
+		for {
+			msg, err := client.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+
+			req := msg.GetRequest()
+			if req == nil {
+				continue
+			}
+			subscribed = append(subscribed, req.ResourceURL)
+			if req.ResourceURL == pbpeerstream.TypeURLPeeringStreamSecret {
+				stream.errCh <- status.Errorf(codes.InvalidArgument, "subscription request to unknown resource URL: %s", req.ResourceURL)
+			}
+		}
+		client.Close()
+
+		select {
+		case err := <-errCh:
+			return subscribed, err
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for the stream to end")
+			return nil, nil
+		}
+	}
+
+	testutil.RunStep(t, "rejected subscription ends the stream", func(t *testing.T) {
+		subscribed, err := dial(t)
+		require.Contains(t, subscribed, pbpeerstream.TypeURLPeeringStreamSecret)
+		require.ErrorContains(t, err, "unknown resource URL: "+pbpeerstream.TypeURLPeeringStreamSecret)
+	})
+
+	testutil.RunStep(t, "reconnect does not subscribe to stream secrets", func(t *testing.T) {
+		subscribed, err := dial(t)
+		require.ElementsMatch(t, []string{
+			pbpeerstream.TypeURLExportedService,
+			pbpeerstream.TypeURLExportedServiceList,
+			pbpeerstream.TypeURLPeeringTrustBundle,
+			pbpeerstream.TypeURLPeeringServerAddresses,
+		}, subscribed)
+		require.EqualError(t, err, "stream ended unexpectedly")
+	})
+}
+
+// oldAcceptorStream is a MockStream whose Recv fails with the errors sent to
+// errCh, the way a dialer's stream fails when the acceptor ends it.
+type oldAcceptorStream struct {
+	*MockStream
+
+	errCh chan error
+}
+
+func (s *oldAcceptorStream) Recv() (*pbpeerstream.ReplicationMessage, error) {
+	select {
+	case err := <-s.errCh:
+		return nil, err
+	case r := <-s.recvCh:
+		if r == nil {
+			return nil, io.EOF
+		}
+		return r, nil
+	}
+}
+
+func TestStreamResources_Server_ImportedServicesChangeResetsStream(t *testing.T) {
+	srv, store := newTestServer(t, nil)
+
+	p := writePeeringToBeDialed(t, store, 1, "my-peer")
+	require.Empty(t, p.PeerID, "should be empty if being dialed")
+
+	// Set the initial roots and CA configuration.
+	_, _ = writeInitialRootsAndCA(t, store)
+
+	client := makeClient(t, srv, testPeerID)
+	client.DrainStream(t)
+
+	testutil.RunStep(t, "entries for other peers are ignored", func(t *testing.T) {
+		require.NoError(t, store.EnsureConfigEntry(2, &structs.ImportedServicesConfigEntry{
+			Name: "other-peer",
+			Deny: []structs.ImportedServiceFilter{{Name: "web"}},
+		}))
+
+		expectReplEvents(t, client)
+	})
+
+	testutil.RunStep(t, "entry for the peer resets the stream", func(t *testing.T) {
+		require.NoError(t, store.EnsureConfigEntry(3, &structs.ImportedServicesConfigEntry{
+			Name: "my-peer",
+			Deny: []structs.ImportedServiceFilter{{Name: "web"}},
+		}))
+
+		retry.Run(t, func(r *retry.R) {
+			_, err := client.Recv()
+			require.Error(r, err)
+			require.NotEqual(r, io.EOF, err)
+
+			st, ok := status.FromError(err)
+			require.True(r, ok)
+			require.Equal(r, codes.FailedPrecondition, st.Code())
+			require.Equal(r, "imported-services config entry changed", st.Message())
+		})
+	})
+}
+
 func TestStreamResources_Server_AckNackNonce(t *testing.T) {
 	srv, store := newTestServer(t, func(c *Config) {
 		c.incomingHeartbeatTimeout = 5 * time.Second
@@ -1779,6 +1991,56 @@ func Test_processResponse_Validation(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "missing stream secret",
+			in: &pbpeerstream.ReplicationMessage_Response{
+				ResourceURL: pbpeerstream.TypeURLPeeringStreamSecret,
+				ResourceID:  "stream-secret",
+				Nonce:       "1",
+				Operation:   pbpeerstream.Operation_OPERATION_UPSERT,
+				Resource:    makeAnyPB(t, &pbpeering.PeeringSecrets_Stream{}),
+			},
+			expect: &pbpeerstream.ReplicationMessage{
+				Payload: &pbpeerstream.ReplicationMessage_Request_{
+					Request: &pbpeerstream.ReplicationMessage_Request{
+						ResourceURL:   pbpeerstream.TypeURLPeeringStreamSecret,
+						ResponseNonce: "1",
+						Error: &pbstatus.Status{
+							Code:    int32(code.Code_INTERNAL),
+							Message: `upsert error, ResourceURL: "` + pbpeerstream.TypeURLPeeringStreamSecret + `", ResourceID: "stream-secret": missing stream secret`,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rotated stream secret is stored as active",
+			in: &pbpeerstream.ReplicationMessage_Response{
+				ResourceURL: pbpeerstream.TypeURLPeeringStreamSecret,
+				ResourceID:  "stream-secret",
+				Nonce:       "1",
+				Operation:   pbpeerstream.Operation_OPERATION_UPSERT,
+				Resource: makeAnyPB(t, &pbpeering.PeeringSecrets_Stream{
+					PendingSecretID: "e9c1c5a6-3d68-4f1c-8bb4-4e8f3d5c8a11",
+				}),
+			},
+			expect: &pbpeerstream.ReplicationMessage{
+				Payload: &pbpeerstream.ReplicationMessage_Request_{
+					Request: &pbpeerstream.ReplicationMessage_Request{
+						ResourceURL:   pbpeerstream.TypeURLPeeringStreamSecret,
+						ResponseNonce: "1",
+					},
+				},
+			},
+			extraTests: func(t *testing.T, s *state.Store) {
+				secrets, err := s.PeeringSecretsRead(nil, peerID)
+				require.NoError(t, err)
+				require.Equal(t, "e9c1c5a6-3d68-4f1c-8bb4-4e8f3d5c8a11", secrets.GetStream().GetActiveSecretID())
+				require.Empty(t, secrets.GetStream().GetPendingSecretID())
+			},
+			wantErr: false,
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1809,6 +2071,24 @@ func writeTestPeering(t *testing.T, store *state.Store, idx uint64, peerName, re
 	}
 	if remotePeerID != "" {
 		peering.PeerServerAddresses = []string{"127.0.0.1:5300"}
+
+		// Dialers store the stream secret generated by the acceptor.
+		require.NoError(t, store.PeeringWrite(idx, &pbpeering.PeeringWriteRequest{
+			Peering: &peering,
+			SecretsRequest: &pbpeering.SecretsWriteRequest{
+				PeerID: testPeerID,
+				Request: &pbpeering.SecretsWriteRequest_Establish{
+					Establish: &pbpeering.SecretsWriteRequest_EstablishRequest{
+						ActiveStreamSecret: testPendingStreamSecretID,
+					},
+				},
+			},
+		}))
+
+		_, p, err := store.PeeringRead(nil, state.Query{Value: peerName})
+		require.NoError(t, err)
+
+		return p
 	}
 
 	require.NoError(t, store.PeeringWrite(idx, &pbpeering.PeeringWriteRequest{
@@ -2986,6 +3266,123 @@ func Test_processResponse_ExportedServiceUpdates(t *testing.T) {
 // We ensure it gets redacted when logging a ReplicationMessage_Open or a ReplicationMessage.
 // In the stream handler we only log the ReplicationMessage_Open, but testing both guards against
 // a change in that behavior.
+func Test_processResponse_ImportedServicesFilter(t *testing.T) {
+	peerName := "billing"
+	peerID := "1fabcd52-1d46-49b0-b1d8-71559aee47f5"
+
+	srv, store := newTestServer(t, nil)
+	require.NoError(t, store.PeeringWrite(31, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			Name: peerName,
+			ID:   peerID,
+		},
+	}))
+
+	// connect the stream
+	mst, err := srv.Tracker.Connected(peerID)
+	require.NoError(t, err)
+
+	require.NoError(t, store.EnsureConfigEntry(32, &structs.ImportedServicesConfigEntry{
+		Name: peerName,
+		Allow: []structs.ImportedServiceFilter{
+			{Name: "web", Tags: []string{"v2"}},
+		},
+		Deny: []structs.ImportedServiceFilter{
+			{Name: "*", Tags: []string{"canary"}},
+		},
+	}))
+
+	instance := func(id, name string, tags ...string) *pbservice.CheckServiceNode {
+		return &pbservice.CheckServiceNode{
+			Node: &pbservice.Node{
+				ID:       "af913374-68ea-41e5-82e8-6ffd3dffc461",
+				Node:     "node-foo",
+				PeerName: peerName,
+			},
+			Service: &pbservice.NodeService{
+				ID:       id,
+				Service:  name,
+				Tags:     tags,
+				PeerName: peerName,
+			},
+		}
+	}
+
+	upsert := func(t *testing.T, resourceURL, resourceID string, resource newproto.Message) {
+		t.Helper()
+
+		_, err := srv.processResponse(peerName, "", mst, &pbpeerstream.ReplicationMessage_Response{
+			ResourceURL: resourceURL,
+			ResourceID:  resourceID,
+			Nonce:       "1",
+			Operation:   pbpeerstream.Operation_OPERATION_UPSERT,
+			Resource:    makeAnyPB(t, resource),
+		})
+		require.NoError(t, err)
+	}
+
+	importedIDs := func(t *testing.T, service string) []string {
+		t.Helper()
+
+		_, nodes, err := store.CheckServiceNodes(nil, service, nil, peerName)
+		require.NoError(t, err)
+
+		var ids []string
+		for _, csn := range nodes {
+			ids = append(ids, csn.Service.ID)
+		}
+		return ids
+	}
+
+	testutil.RunStep(t, "only allowed instances are imported", func(t *testing.T) {
+		upsert(t, pbpeerstream.TypeURLExportedServiceList, subExportedServiceList, &pbpeerstream.ExportedServiceList{
+			Services: []string{"web", "api"},
+		})
+		upsert(t, pbpeerstream.TypeURLExportedService, "web", &pbpeerstream.ExportedService{
+			Nodes: []*pbservice.CheckServiceNode{
+				instance("web-1", "web", "v1"),
+				instance("web-2", "web", "v2"),
+				instance("web-3", "web", "v2", "canary"),
+			},
+		})
+		upsert(t, pbpeerstream.TypeURLExportedService, "api", &pbpeerstream.ExportedService{
+			Nodes: []*pbservice.CheckServiceNode{
+				instance("api-1", "api", "v2"),
+			},
+		})
+
+		proxy := instance("web-sidecar-proxy", "web-sidecar-proxy")
+		proxy.Service.Kind = string(structs.ServiceKindConnectProxy)
+		proxy.Service.Proxy = &pbservice.ConnectProxyConfig{DestinationServiceName: "web"}
+		upsert(t, pbpeerstream.TypeURLExportedService, "web-sidecar-proxy", &pbpeerstream.ExportedService{
+			Nodes: []*pbservice.CheckServiceNode{proxy},
+		})
+
+		require.Equal(t, []string{"web-2"}, importedIDs(t, "web"))
+		require.Empty(t, importedIDs(t, "api"))
+		require.Equal(t, []string{"web-sidecar-proxy"}, importedIDs(t, "web-sidecar-proxy"))
+
+		require.ElementsMatch(t, []string{"web"}, mst.GetStatus().ImportedServices)
+	})
+
+	testutil.RunStep(t, "denied services are removed on the next exported service list", func(t *testing.T) {
+		require.NoError(t, store.EnsureConfigEntry(33, &structs.ImportedServicesConfigEntry{
+			Name: peerName,
+			Deny: []structs.ImportedServiceFilter{
+				{Name: "web"},
+			},
+		}))
+
+		upsert(t, pbpeerstream.TypeURLExportedServiceList, subExportedServiceList, &pbpeerstream.ExportedServiceList{
+			Services: []string{"web", "api"},
+		})
+
+		require.Empty(t, importedIDs(t, "web"))
+		require.Empty(t, importedIDs(t, "web-sidecar-proxy"))
+		require.ElementsMatch(t, []string{"api"}, mst.GetStatus().ImportedServices)
+	})
+}
+
 func TestLogTraceProto(t *testing.T) {
 	type testCase struct {
 		input proto.Message
@@ -5,6 +5,7 @@ package peerstream
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -120,6 +121,21 @@ func (t *Tracker) StreamStatus(id string) (resp Status, found bool) {
 	return s.GetStatus(), true
 }
 
+// StreamDiagnostics returns the detailed replication state of the stream for
+// the given peer.
+func (t *Tracker) StreamDiagnostics(id string) (resp Diagnostics, found bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	s, ok := t.streams[id]
+	if !ok {
+		return Diagnostics{
+			Status: Status{NeverConnected: true},
+		}, false
+	}
+	return s.GetDiagnostics(), true
+}
+
 func (t *Tracker) ConnectedStreams() map[string]chan struct{} {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
@@ -193,7 +209,92 @@ type MutableStatus struct {
 	// to the peer before the stream's context is cancelled.
 	doneCh chan struct{}
 
+	// resources tracks the replication state of each resource sent to the peer.
+	resources map[resourceKey]*ResourceStatus
+
+	// pendingNonces maps the nonce of each response that was not yet ACKed or
+	// NACKed to the resource it carried. Nonces are only unique within a stream,
+	// so this is reset when a new stream connects.
+	pendingNonces map[string]resourceKey
+
+	// streamEvents is the history of the most recent connections and
+	// disconnections, oldest first.
+	streamEvents []StreamEvent
+
+	// lastDialAddress and lastDialTime track the last address dialed to reach
+	// the peer. They are only set for dialers.
+	lastDialAddress string
+	lastDialTime    *time.Time
+
+	// streamSecretUnsupported is set for dialers once the peer rejects the
+	// subscription to stream secrets, which peers that predate secret rotation
+	// do not recognize. It outlives the stream so that reconnects stop
+	// subscribing to them.
+	streamSecretUnsupported bool
+
+	Status
+}
+
+// maxStreamEvents is the number of stream connections and disconnections
+// kept for diagnostics.
+const maxStreamEvents = 10
+
+type resourceKey struct {
+	resourceURL string
+	resourceID  string
+}
+
+// ResourceStatus contains information about the replication of a single
+// resource to a peer cluster.
+type ResourceStatus struct {
+	ResourceURL string
+	ResourceID  string
+
+	// LastSent tracks when the resource was last sent to the peer.
+	LastSent *time.Time
+
+	// LastSentNonce is the nonce of the last response carrying the resource.
+	LastSentNonce string
+
+	// LastAck tracks when the peer last acknowledged the resource.
+	LastAck *time.Time
+
+	// LastNack tracks when the peer last failed to apply the resource.
+	LastNack *time.Time
+
+	// LastNackMessage is the error reported by the peer with the last NACK.
+	LastNackMessage string
+}
+
+// StreamEvent records a stream being connected or disconnected.
+type StreamEvent struct {
+	Time time.Time
+
+	// Connected is true when the stream was connected, false when it was disconnected.
+	Connected bool
+
+	// ErrorMessage is the error that caused the stream to disconnect, if any.
+	ErrorMessage string
+}
+
+// Diagnostics contains the Status of a stream along with the detailed
+// replication information used to troubleshoot it.
+type Diagnostics struct {
 	Status
+
+	// Resources is the replication state of each resource sent to the peer,
+	// sorted by resource URL and ID.
+	Resources []ResourceStatus
+
+	// StreamEvents is the history of the most recent connections and
+	// disconnections, oldest first.
+	StreamEvents []StreamEvent
+
+	// LastDialAddress is the last address dialed to reach the peer.
+	LastDialAddress string
+
+	// LastDialTime is when LastDialAddress was dialed.
+	LastDialTime *time.Time
 }
 
 // Status contains information about the replication stream to a peer cluster.
@@ -260,7 +361,7 @@ func (s *Status) GetExportedServicesCount() uint64 {
 }
 
 func newMutableStatus(now func() time.Time, connected bool) *MutableStatus {
-	return &MutableStatus{
+	s := &MutableStatus{
 		Status: Status{
 			Connected:      connected,
 			NeverConnected: !connected,
@@ -268,6 +369,10 @@ func newMutableStatus(now func() time.Time, connected bool) *MutableStatus {
 		timeNow: now,
 		doneCh:  make(chan struct{}),
 	}
+	if connected {
+		s.trackStreamEventLocked(now().UTC(), true, "")
+	}
+	return s
 }
 
 func (s *MutableStatus) Done() <-chan struct{} {
@@ -321,11 +426,111 @@ func (s *MutableStatus) TrackNack(msg string) {
 	s.mu.Unlock()
 }
 
+// TrackResourceSent tracks successfully sending a resource to the peer in the
+// response with the given nonce. It also tracks the send success.
+func (s *MutableStatus) TrackResourceSent(resourceURL, resourceID, nonce string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := ptr(s.timeNow().UTC())
+	s.LastSendSuccess = now
+
+	if s.resources == nil {
+		s.resources = make(map[resourceKey]*ResourceStatus)
+	}
+	if s.pendingNonces == nil {
+		s.pendingNonces = make(map[string]resourceKey)
+	}
+
+	key := resourceKey{resourceURL: resourceURL, resourceID: resourceID}
+	res, ok := s.resources[key]
+	if !ok {
+		res = &ResourceStatus{ResourceURL: resourceURL, ResourceID: resourceID}
+		s.resources[key] = res
+	}
+	res.LastSent = now
+	res.LastSentNonce = nonce
+	s.pendingNonces[nonce] = key
+}
+
+// TrackResourceAck tracks the peer acknowledging the response with the given
+// nonce. It also tracks the ACK for the stream.
+func (s *MutableStatus) TrackResourceAck(nonce string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.LastAck = ptr(s.timeNow().UTC())
+	if res := s.popPendingLocked(nonce); res != nil {
+		res.LastAck = s.LastAck
+	}
+}
+
+// TrackResourceNack tracks the peer failing to apply the response with the
+// given nonce. It also tracks the NACK for the stream.
+func (s *MutableStatus) TrackResourceNack(nonce, msg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.LastNack = ptr(s.timeNow().UTC())
+	s.LastNackMessage = msg
+	if res := s.popPendingLocked(nonce); res != nil {
+		res.LastNack = s.LastNack
+		res.LastNackMessage = msg
+	}
+}
+
+// TrackResourceDeleted stops tracking a resource once the peer was sent its
+// deletion, so that the resources of services that are no longer exported
+// don't accumulate for the lifetime of the peering.
+func (s *MutableStatus) TrackResourceDeleted(resourceURL, resourceID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := resourceKey{resourceURL: resourceURL, resourceID: resourceID}
+	delete(s.resources, key)
+	for nonce, pending := range s.pendingNonces {
+		if pending == key {
+			delete(s.pendingNonces, nonce)
+		}
+	}
+}
+
+func (s *MutableStatus) popPendingLocked(nonce string) *ResourceStatus {
+	key, ok := s.pendingNonces[nonce]
+	if !ok {
+		return nil
+	}
+	delete(s.pendingNonces, nonce)
+	return s.resources[key]
+}
+
+// TrackDial tracks dialing the given address to reach the peer.
+func (s *MutableStatus) TrackDial(addr string) {
+	s.mu.Lock()
+	s.lastDialAddress = addr
+	s.lastDialTime = ptr(s.timeNow().UTC())
+	s.mu.Unlock()
+}
+
+func (s *MutableStatus) trackStreamEventLocked(at time.Time, connected bool, errMsg string) {
+	s.streamEvents = append(s.streamEvents, StreamEvent{
+		Time:         at,
+		Connected:    connected,
+		ErrorMessage: errMsg,
+	})
+	if len(s.streamEvents) > maxStreamEvents {
+		s.streamEvents = s.streamEvents[len(s.streamEvents)-maxStreamEvents:]
+	}
+}
+
 func (s *MutableStatus) TrackConnected() {
 	s.mu.Lock()
 	s.Connected = true
 	s.DisconnectTime = &time.Time{}
 	s.DisconnectErrorMessage = ""
+	// Nonces restart with every stream.
+	s.pendingNonces = nil
+	s.trackStreamEventLocked(s.timeNow().UTC(), true, "")
 	s.mu.Unlock()
 }
 
@@ -336,6 +541,7 @@ func (s *MutableStatus) TrackDisconnectedGracefully() {
 	s.Connected = false
 	s.DisconnectTime = ptr(s.timeNow().UTC())
 	s.DisconnectErrorMessage = ""
+	s.trackStreamEventLocked(*s.DisconnectTime, false, "")
 	s.mu.Unlock()
 }
 
@@ -346,9 +552,24 @@ func (s *MutableStatus) TrackDisconnectedDueToError(error string) {
 	s.Connected = false
 	s.DisconnectTime = ptr(s.timeNow().UTC())
 	s.DisconnectErrorMessage = error
+	s.trackStreamEventLocked(*s.DisconnectTime, false, error)
+	s.mu.Unlock()
+}
+
+// TrackStreamSecretUnsupported records that the peer does not replicate stream secrets.
+func (s *MutableStatus) TrackStreamSecretUnsupported() {
+	s.mu.Lock()
+	s.streamSecretUnsupported = true
 	s.mu.Unlock()
 }
 
+// StreamSecretUnsupported returns true if the peer rejected a subscription to stream secrets.
+func (s *MutableStatus) StreamSecretUnsupported() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.streamSecretUnsupported
+}
+
 func (s *MutableStatus) IsConnected() bool {
 	var resp bool
 
@@ -367,6 +588,31 @@ func (s *MutableStatus) GetStatus() Status {
 	return copy
 }
 
+// GetDiagnostics returns a copy of the stream's status and replication details.
+func (s *MutableStatus) GetDiagnostics() Diagnostics {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	d := Diagnostics{
+		Status:          s.Status,
+		Resources:       make([]ResourceStatus, 0, len(s.resources)),
+		StreamEvents:    make([]StreamEvent, len(s.streamEvents)),
+		LastDialAddress: s.lastDialAddress,
+		LastDialTime:    s.lastDialTime,
+	}
+	for _, res := range s.resources {
+		d.Resources = append(d.Resources, *res)
+	}
+	sort.Slice(d.Resources, func(i, j int) bool {
+		if d.Resources[i].ResourceURL != d.Resources[j].ResourceURL {
+			return d.Resources[i].ResourceURL < d.Resources[j].ResourceURL
+		}
+		return d.Resources[i].ResourceID < d.Resources[j].ResourceID
+	})
+	copy(d.StreamEvents, s.streamEvents)
+	return d
+}
+
 func (s *MutableStatus) SetImportedServices(serviceNames []structs.ServiceName) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -4,6 +4,7 @@
 package peerstream
 
 import (
+	"fmt"
 	"sort"
 	"testing"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hashicorp/consul/proto/private/pbpeerstream"
 	"github.com/hashicorp/consul/sdk/testutil"
 )
 
@@ -129,11 +131,16 @@ func TestTracker_EnsureConnectedDisconnected(t *testing.T) {
 	var (
 		statusPtr *MutableStatus
 		err       error
+
+		sequence    uint64
+		lastSuccess *time.Time
 	)
 
 	testutil.RunStep(t, "new stream", func(t *testing.T) {
 		statusPtr, err = tracker.Connected(peerID)
 		require.NoError(t, err)
+		// The connection is recorded in the stream events.
+		sequence++
 
 		expect := Status{
 			Connected: true,
@@ -150,9 +157,6 @@ func TestTracker_EnsureConnectedDisconnected(t *testing.T) {
 		require.Contains(t, err.Error(), `there is an active stream for the given PeerID "63b60245-c475-426b-b314-4588d210859d"`)
 	})
 
-	var sequence uint64
-	var lastSuccess *time.Time
-
 	testutil.RunStep(t, "stream updated", func(t *testing.T) {
 		statusPtr.TrackAck()
 		sequence++
@@ -272,6 +276,7 @@ func TestTracker_connectedStreams(t *testing.T) {
 
 func TestMutableStatus_TrackConnected(t *testing.T) {
 	s := MutableStatus{
+		timeNow: time.Now,
 		Status: Status{
 			Connected:              false,
 			DisconnectTime:         ptr(time.Now()),
@@ -327,3 +332,109 @@ func TestMutableStatus_TrackDisconnectedDueToError(t *testing.T) {
 	require.Equal(t, disconnectTime, s.DisconnectTime)
 	require.Equal(t, "disconnect err", s.DisconnectErrorMessage)
 }
+
+func TestMutableStatus_TrackResources(t *testing.T) {
+	it := incrementalTime{
+		base: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	s := newMutableStatus(it.Now, true)
+
+	s.TrackResourceSent(pbpeerstream.TypeURLExportedService, "web", "1")
+	s.TrackResourceSent(pbpeerstream.TypeURLExportedService, "api", "2")
+	s.TrackResourceSent(pbpeerstream.TypeURLExportedService, "web", "3")
+
+	// The ACK for the first nonce is ignored since the resource was resent.
+	s.TrackResourceAck("3")
+	s.TrackResourceNack("2", "bad service")
+	s.TrackResourceAck("unknown")
+
+	d := s.GetDiagnostics()
+	require.Equal(t, ptr(it.StaticNow()), d.LastAck)
+	require.Equal(t, []ResourceStatus{
+		{
+			ResourceURL:     pbpeerstream.TypeURLExportedService,
+			ResourceID:      "api",
+			LastSent:        ptr(it.base.Add(3 * time.Second)),
+			LastSentNonce:   "2",
+			LastNack:        ptr(it.base.Add(6 * time.Second)),
+			LastNackMessage: "bad service",
+		},
+		{
+			ResourceURL:   pbpeerstream.TypeURLExportedService,
+			ResourceID:    "web",
+			LastSent:      ptr(it.base.Add(4 * time.Second)),
+			LastSentNonce: "3",
+			LastAck:       ptr(it.base.Add(5 * time.Second)),
+		},
+	}, d.Resources)
+	require.Equal(t, "bad service", d.LastNackMessage)
+	require.Equal(t, ptr(it.base.Add(4*time.Second)), d.LastSendSuccess)
+
+	testutil.RunStep(t, "nonces are reset on reconnect", func(t *testing.T) {
+		s.TrackResourceSent(pbpeerstream.TypeURLExportedService, "web", "4")
+		s.TrackDisconnectedGracefully()
+		s.TrackConnected()
+		s.TrackResourceAck("4")
+
+		d := s.GetDiagnostics()
+		require.Len(t, d.Resources, 2)
+		require.Equal(t, "4", d.Resources[1].LastSentNonce)
+		require.Equal(t, ptr(it.base.Add(5*time.Second)), d.Resources[1].LastAck)
+	})
+
+	testutil.RunStep(t, "deleted resources are no longer tracked", func(t *testing.T) {
+		s.TrackResourceSent(pbpeerstream.TypeURLExportedService, "api", "5")
+		s.TrackResourceDeleted(pbpeerstream.TypeURLExportedService, "api")
+		s.TrackResourceAck("5")
+
+		d := s.GetDiagnostics()
+		require.Len(t, d.Resources, 1)
+		require.Equal(t, "web", d.Resources[0].ResourceID)
+		require.Empty(t, s.pendingNonces)
+	})
+}
+
+func TestMutableStatus_StreamEvents(t *testing.T) {
+	it := incrementalTime{
+		base: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	s := newMutableStatus(it.Now, true)
+	s.TrackDisconnectedDueToError("heartbeat timeout")
+	s.TrackConnected()
+	s.TrackDisconnectedGracefully()
+
+	d := s.GetDiagnostics()
+	require.Equal(t, []StreamEvent{
+		{Time: it.base.Add(time.Second), Connected: true},
+		{Time: it.base.Add(2 * time.Second), ErrorMessage: "heartbeat timeout"},
+		{Time: it.base.Add(3 * time.Second), Connected: true},
+		{Time: it.base.Add(4 * time.Second)},
+	}, d.StreamEvents)
+
+	testutil.RunStep(t, "history is bounded", func(t *testing.T) {
+		for i := 0; i < maxStreamEvents; i++ {
+			s.TrackConnected()
+			s.TrackDisconnectedDueToError(fmt.Sprintf("error %d", i))
+		}
+
+		d := s.GetDiagnostics()
+		require.Len(t, d.StreamEvents, maxStreamEvents)
+		require.Equal(t, fmt.Sprintf("error %d", maxStreamEvents-1), d.StreamEvents[maxStreamEvents-1].ErrorMessage)
+	})
+}
+
+func TestMutableStatus_TrackDial(t *testing.T) {
+	it := incrementalTime{
+		base: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	s := newMutableStatus(it.Now, false)
+	s.TrackDial("10.0.0.1:8502")
+
+	d := s.GetDiagnostics()
+	require.Equal(t, "10.0.0.1:8502", d.LastDialAddress)
+	require.Equal(t, ptr(it.base.Add(time.Second)), d.LastDialTime)
+	require.Empty(t, d.StreamEvents)
+}
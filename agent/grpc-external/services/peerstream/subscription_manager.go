@@ -53,6 +53,7 @@ type subscriptionManager struct {
 	serviceSubReady      <-chan struct{}
 	trustBundlesSubReady <-chan struct{}
 	serverAddrsSubReady  <-chan struct{}
+	streamSecretSubReady <-chan struct{}
 }
 
 // TODO(peering): Maybe centralize so that there is a single manager per datacenter, rather than per peering.
@@ -79,6 +80,7 @@ func newSubscriptionManager(
 		serviceSubReady:      remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLExportedService),
 		trustBundlesSubReady: remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLPeeringTrustBundle),
 		serverAddrsSubReady:  remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLPeeringServerAddresses),
+		streamSecretSubReady: remoteSubTracker.SubscribedChan(pbpeerstream.TypeURLPeeringStreamSecret),
 	}
 }
 
@@ -96,6 +98,7 @@ func (m *subscriptionManager) subscribe(ctx context.Context, peerID, peerName, p
 	// Wrap our bare state store queries in goroutines that emit events.
 	go m.notifyExportedServicesForPeerID(ctx, state, peerID)
 	go m.notifyServerAddrUpdates(ctx, state.updateCh)
+	go m.notifyStreamSecretUpdates(ctx, state.updateCh, peerID)
 	if m.config.ConnectEnabled {
 		go m.notifyMeshGatewaysForPartition(ctx, state, state.partition)
 		// If connect is enabled, watch for updates to CA roots.
@@ -287,6 +290,22 @@ func (m *subscriptionManager) handleEvent(ctx context.Context, state *subscripti
 			return err
 		}
 
+		state.sendPendingEvents(ctx, m.logger, pending)
+
+	case u.CorrelationID == subStreamSecret:
+		secret, ok := u.Result.(*pbpeering.PeeringSecrets_Stream)
+		if !ok {
+			return fmt.Errorf("invalid type for response: %T", u.Result)
+		}
+		if secret.GetPendingSecretID() == "" {
+			// Nothing to hand over until the secrets are rotated.
+			return nil
+		}
+		pending := &pendingPayload{}
+		if err := pending.Add(streamSecretPayloadID, u.CorrelationID, secret); err != nil {
+			return err
+		}
+
 		state.sendPendingEvents(ctx, m.logger, pending)
 	default:
 		return fmt.Errorf("unknown correlation ID: %s", u.CorrelationID)
@@ -805,6 +824,37 @@ func (m *subscriptionManager) notifyServerAddrUpdates(
 	}
 }
 
+const subStreamSecret = "stream-secret"
+
+// notifyStreamSecretUpdates watches the stream secrets of the peering and
+// emits the pending stream secret so that it can be handed over to the dialing
+// peer. A pending secret is introduced when the secrets of an established
+// peering are rotated.
+func (m *subscriptionManager) notifyStreamSecretUpdates(
+	ctx context.Context,
+	updateCh chan<- cache.UpdateEvent,
+	peerID string,
+) {
+	// Wait until stream secret updates are subscribed-to.
+	select {
+	case <-m.streamSecretSubReady:
+	case <-ctx.Done():
+		return
+	}
+
+	m.syncViaBlockingQuery(ctx, "stream-secret", func(_ context.Context, store StateStore, ws memdb.WatchSet) (interface{}, error) {
+		secrets, err := store.PeeringSecretsRead(ws, peerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to watch secrets for peer %q: %w", peerID, err)
+		}
+
+		// Only the pending secret is sent, the dialer already knows the active one.
+		return &pbpeering.PeeringSecrets_Stream{
+			PendingSecretID: secrets.GetStream().GetPendingSecretID(),
+		}, nil
+	}, subStreamSecret, updateCh)
+}
+
 func (m *subscriptionManager) notifyMeshConfigUpdates(ctx context.Context) <-chan cache.UpdateEvent {
 	const meshConfigWatch = "mesh-config-entry"
 
@@ -865,6 +865,114 @@ func TestSubscriptionManager_ServerAddrs(t *testing.T) {
 	})
 }
 
+func TestSubscriptionManager_StreamSecret(t *testing.T) {
+	backend := newTestSubscriptionBackend(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// Create a peering
+	_, id := backend.ensurePeering(t, "my-peering")
+	partition := acl.DefaultEnterpriseMeta().PartitionOrEmpty()
+
+	_, peering, err := backend.store.PeeringRead(nil, state.Query{Value: "my-peering"})
+	require.NoError(t, err)
+
+	writeSecrets := func(t *testing.T, req *pbpeering.SecretsWriteRequest) {
+		t.Helper()
+
+		backend.lastIdx++
+		req.PeerID = id
+		require.NoError(t, backend.store.PeeringWrite(backend.lastIdx, &pbpeering.PeeringWriteRequest{
+			Peering:        peering,
+			SecretsRequest: req,
+		}))
+	}
+
+	// Establish the peering with this cluster as the acceptor.
+	var (
+		establishmentSecret = testUUID(t)
+		activeSecret        = testUUID(t)
+	)
+	writeSecrets(t, &pbpeering.SecretsWriteRequest{
+		Request: &pbpeering.SecretsWriteRequest_GenerateToken{
+			GenerateToken: &pbpeering.SecretsWriteRequest_GenerateTokenRequest{
+				EstablishmentSecret: establishmentSecret,
+			},
+		},
+	})
+	writeSecrets(t, &pbpeering.SecretsWriteRequest{
+		Request: &pbpeering.SecretsWriteRequest_ExchangeSecret{
+			ExchangeSecret: &pbpeering.SecretsWriteRequest_ExchangeSecretRequest{
+				EstablishmentSecret: establishmentSecret,
+				PendingStreamSecret: activeSecret,
+			},
+		},
+	})
+	writeSecrets(t, &pbpeering.SecretsWriteRequest{
+		Request: &pbpeering.SecretsWriteRequest_PromotePending{
+			PromotePending: &pbpeering.SecretsWriteRequest_PromotePendingRequest{
+				ActiveStreamSecret: activeSecret,
+			},
+		},
+	})
+
+	// Only configure a tracker for stream secret events.
+	tracker := newResourceSubscriptionTracker()
+	tracker.Subscribe(pbpeerstream.TypeURLPeeringStreamSecret)
+
+	mgr := newSubscriptionManager(ctx,
+		testutil.Logger(t),
+		Config{
+			Datacenter:     "dc1",
+			ConnectEnabled: true,
+		},
+		connect.TestTrustDomain,
+		backend,
+		func() StateStore {
+			return backend.store
+		},
+		tracker)
+	subCh := mgr.subscribe(ctx, id, "my-peering", partition)
+
+	testutil.RunStep(t, "no events without a pending secret", func(t *testing.T) {
+		expectEvents(t, subCh)
+	})
+
+	rotatedSecret := testUUID(t)
+	testutil.RunStep(t, "rotating the secret emits the pending secret", func(t *testing.T) {
+		writeSecrets(t, &pbpeering.SecretsWriteRequest{
+			Request: &pbpeering.SecretsWriteRequest_RotateSecret{
+				RotateSecret: &pbpeering.SecretsWriteRequest_RotateSecretRequest{
+					PendingStreamSecret: rotatedSecret,
+				},
+			},
+		})
+
+		expectEvents(t, subCh,
+			func(t *testing.T, got cache.UpdateEvent) {
+				require.Equal(t, subStreamSecret, got.CorrelationID)
+				secret, ok := got.Result.(*pbpeering.PeeringSecrets_Stream)
+				require.True(t, ok)
+
+				require.Equal(t, rotatedSecret, secret.GetPendingSecretID())
+			},
+		)
+	})
+
+	testutil.RunStep(t, "promoting the pending secret emits no events", func(t *testing.T) {
+		writeSecrets(t, &pbpeering.SecretsWriteRequest{
+			Request: &pbpeering.SecretsWriteRequest_PromotePending{
+				PromotePending: &pbpeering.SecretsWriteRequest_PromotePendingRequest{
+					ActiveStreamSecret: rotatedSecret,
+				},
+			},
+		})
+
+		expectEvents(t, subCh)
+	})
+}
+
 func TestFlattenChecks(t *testing.T) {
 	type testcase struct {
 		checks         []*pbservice.HealthCheck
@@ -99,6 +99,9 @@ func (s *subscriptionState) cleanupEventVersions(logger hclog.Logger) {
 		case id == serverAddrsPayloadID:
 			keep = true
 
+		case id == streamSecretPayloadID:
+			keep = true
+
 		case id == exportedServiceListID:
 			keep = true
 
@@ -139,6 +142,7 @@ type pendingEvent struct {
 
 const (
 	serverAddrsPayloadID          = "server-addrs"
+	streamSecretPayloadID         = "stream-secret"
 	caRootsPayloadID              = "roots"
 	meshGatewayPayloadID          = "mesh-gateway"
 	exportedServiceListID         = "exported-service-list"
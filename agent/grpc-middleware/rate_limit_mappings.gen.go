@@ -16,9 +16,11 @@ var rpcRateLimitSpecs = map[string]rate.OperationSpec{
 	"/hashicorp.consul.internal.peering.PeeringService/Establish":                           {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peering.PeeringService/GenerateToken":                       {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peering.PeeringService/PeeringDelete":                       {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryPeering},
+	"/hashicorp.consul.internal.peering.PeeringService/PeeringDiagnostics":                  {Type: rate.OperationTypeRead, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peering.PeeringService/PeeringList":                         {Type: rate.OperationTypeRead, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peering.PeeringService/PeeringRead":                         {Type: rate.OperationTypeRead, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peering.PeeringService/PeeringWrite":                        {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryPeering},
+	"/hashicorp.consul.internal.peering.PeeringService/RotateSecrets":                       {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peering.PeeringService/TrustBundleListByService":            {Type: rate.OperationTypeRead, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peering.PeeringService/TrustBundleRead":                     {Type: rate.OperationTypeRead, Category: rate.OperationCategoryPeering},
 	"/hashicorp.consul.internal.peerstream.PeerStreamService/ExchangeSecret":                {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryPeerStream},
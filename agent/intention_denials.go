@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const (
+	// intentionDenialReportInterval is how often the denials recorded by the
+	// agent are reported to the servers.
+	intentionDenialReportInterval = 10 * time.Second
+
+	// maxPendingIntentionDenials bounds the number of denials buffered
+	// between two reports. Denials recorded while the buffer is full are
+	// dropped.
+	maxPendingIntentionDenials = 1024
+)
+
+type pendingIntentionDenial struct {
+	token  string
+	denial structs.IntentionDenial
+}
+
+// intentionDenialReporter buffers the intention denials observed by the
+// proxies registered with the agent until they are reported to the servers.
+type intentionDenialReporter struct {
+	limit int
+
+	lock    sync.Mutex
+	pending []pendingIntentionDenial
+	dropped int
+}
+
+func newIntentionDenialReporter(limit int) *intentionDenialReporter {
+	return &intentionDenialReporter{limit: limit}
+}
+
+// Record buffers a denial. It is reported with token, which must be allowed
+// to write the destination service.
+func (r *intentionDenialReporter) Record(token string, denial structs.IntentionDenial) {
+	if denial.Timestamp.IsZero() {
+		denial.Timestamp = time.Now()
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if len(r.pending) >= r.limit {
+		r.dropped++
+		return
+	}
+	r.pending = append(r.pending, pendingIntentionDenial{token: token, denial: denial})
+}
+
+// drain returns the buffered denials grouped by token, and the number of
+// denials dropped since the last call.
+func (r *intentionDenialReporter) drain() (map[string][]structs.IntentionDenial, int) {
+	r.lock.Lock()
+	pending, dropped := r.pending, r.dropped
+	r.pending, r.dropped = nil, 0
+	r.lock.Unlock()
+
+	byToken := make(map[string][]structs.IntentionDenial)
+	for _, p := range pending {
+		byToken[p.token] = append(byToken[p.token], p.denial)
+	}
+	return byToken, dropped
+}
+
+// reportIntentionDenials periodically sends the denials buffered by the
+// agent to the servers, which aggregate them for /v1/connect/intentions/denials.
+func (a *Agent) reportIntentionDenials() {
+	ticker := time.NewTicker(intentionDenialReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			a.flushIntentionDenials()
+		case <-a.shutdownCh:
+			return
+		}
+	}
+}
+
+func (a *Agent) flushIntentionDenials() {
+	byToken, dropped := a.intentionDenials.drain()
+	if dropped > 0 {
+		a.logger.Warn("Dropped intention denials because too many were recorded between reports", "dropped", dropped)
+	}
+
+	for token, denials := range byToken {
+		req := structs.IntentionDenialsReportRequest{
+			Datacenter:   a.config.Datacenter,
+			Denials:      denials,
+			WriteRequest: structs.WriteRequest{Token: token},
+		}
+		var reply struct{}
+		if err := a.RPC(context.Background(), "Intention.ReportDenials", &req, &reply); err != nil {
+			if acl.IsErrPermissionDenied(err) {
+				accessorID := a.aclAccessorID(token)
+				a.logger.Warn("Intention denials report blocked by ACLs", "accessorID", acl.AliasIfAnonymousToken(accessorID))
+			} else {
+				a.logger.Error("Intention denials report error", "error", err)
+			}
+		}
+	}
+}
+
+// localConnectProxy returns the connect proxy registered with the agent with
+// the given ID and its ACL token, or nil if there is no such proxy.
+func (a *Agent) localConnectProxy(proxyID string) (*structs.NodeService, string) {
+	for sid, svc := range a.State.AllServices() {
+		if sid.ID == proxyID && svc.Kind == structs.ServiceKindConnectProxy {
+			return svc, a.State.ServiceToken(sid)
+		}
+	}
+	return nil, ""
+}
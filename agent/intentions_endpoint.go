@@ -264,6 +264,55 @@ func (s *HTTPHandlers) IntentionCheck(resp http.ResponseWriter, req *http.Reques
 	return &reply, nil
 }
 
+// IntentionDenials handles the endpoint for /v1/connect/intentions/denials
+func (s *HTTPHandlers) IntentionDenials(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	switch req.Method {
+	case "GET":
+		return s.IntentionDenialsList(resp, req)
+	case "PUT":
+		return s.IntentionDenialsReport(resp, req)
+	default:
+		return nil, MethodNotAllowedError{req.Method, []string{"GET", "PUT"}}
+	}
+}
+
+// GET /v1/connect/intentions/denials
+func (s *HTTPHandlers) IntentionDenialsList(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.IntentionDenialsRequest
+	if done := s.parse(resp, req, &args.Datacenter, &args.QueryOptions); done {
+		return nil, nil
+	}
+
+	if err := s.parseEntMeta(req, &args.EnterpriseMeta); err != nil {
+		return nil, err
+	}
+
+	var reply structs.IndexedIntentionDenials
+	defer setMeta(resp, &reply.QueryMeta)
+	if err := s.agent.RPC(req.Context(), "Intention.Denials", &args, &reply); err != nil {
+		return nil, err
+	}
+
+	return reply.Denials, nil
+}
+
+// PUT /v1/connect/intentions/denials
+func (s *HTTPHandlers) IntentionDenialsReport(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var args structs.IntentionDenialsReportRequest
+	s.parseDC(req, &args.Datacenter)
+	s.parseToken(req, &args.Token)
+	if err := decodeBody(req.Body, &args.Denials); err != nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Request decode failed: %v", err)}
+	}
+
+	var ignored struct{}
+	if err := s.agent.RPC(req.Context(), "Intention.ReportDenials", &args, &ignored); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
 // IntentionExact handles the endpoint for /v1/connect/intentions/exact
 func (s *HTTPHandlers) IntentionExact(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	switch req.Method {
@@ -4,6 +4,7 @@
 package agent
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net/http"
@@ -353,6 +354,52 @@ func TestIntentionCheck(t *testing.T) {
 	})
 }
 
+func TestIntentionDenials(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	t.Run("report", func(t *testing.T) {
+		body := bytes.NewBuffer([]byte(`[
+			{
+				"SourceURI": "spiffe://11111111-2222-3333-4444-555555555555.consul/ns/default/dc/dc1/svc/web",
+				"DestinationName": "db",
+				"PolicyID": "consul-intentions-deny:web"
+			}
+		]`))
+		req, err := http.NewRequest("PUT", "/v1/connect/intentions/denials", body)
+		require.NoError(t, err)
+
+		resp := httptest.NewRecorder()
+		obj, err := a.srv.IntentionDenials(resp, req)
+		require.NoError(t, err)
+		require.Equal(t, true, obj)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/v1/connect/intentions/denials", nil)
+		require.NoError(t, err)
+
+		resp := httptest.NewRecorder()
+		obj, err := a.srv.IntentionDenials(resp, req)
+		require.NoError(t, err)
+
+		denials, ok := obj.([]structs.IntentionDenialSummary)
+		require.True(t, ok)
+		require.Len(t, denials, 1)
+		require.Equal(t, "web", denials[0].SourceName)
+		require.Equal(t, "db", denials[0].DestinationName)
+		require.Equal(t, "web", denials[0].Intention)
+		require.Equal(t, uint64(1), denials[0].Count)
+	})
+}
+
 func TestIntentionGetExact_PeerIntentions(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
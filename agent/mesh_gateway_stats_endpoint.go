@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+
+	"github.com/hashicorp/consul/acl"
+	external "github.com/hashicorp/consul/agent/grpc-external"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/proto/private/pbpeering"
+	troubleshoot "github.com/hashicorp/consul/troubleshoot/proxy"
+)
+
+const defaultMeshGatewayEnvoyAdminEndpoint = "127.0.0.1:19000"
+
+// AgentMeshGatewayStats returns the traffic handled by a mesh gateway
+// registered with this agent, aggregated per remote datacenter and per peer.
+// The stats are read from the gateway's Envoy admin API, which must listen on
+// a loopback address.
+func (s *HTTPHandlers) AgentMeshGatewayStats(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
+	var token string
+	s.parseToken(req, &token)
+
+	var entMeta acl.EnterpriseMeta
+	if err := s.parseEntMetaNoWildcard(req, &entMeta); err != nil {
+		return nil, err
+	}
+
+	// need to resolve to default the meta
+	s.defaultMetaPartitionToAgent(&entMeta)
+	authz, err := s.agent.delegate.ResolveTokenAndDefaultMeta(token, &entMeta, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.validateRequestPartition(resp, &entMeta) {
+		return nil, nil
+	}
+
+	svc, err := s.meshGatewayForStats(req.URL.Query().Get("service-id"), &entMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	var authzContext acl.AuthorizerContext
+	svc.FillAuthzContext(&authzContext)
+	if err := authz.ToAllowAuthorizer().ServiceReadAllowed(svc.Service, &authzContext); err != nil {
+		return nil, err
+	}
+
+	adminAddr := req.URL.Query().Get("envoy-admin-endpoint")
+	if adminAddr == "" {
+		adminAddr = defaultMeshGatewayEnvoyAdminEndpoint
+	}
+	adminIP, adminPort, err := parseLoopbackAddr(adminAddr)
+	if err != nil {
+		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("Invalid envoy-admin-endpoint: %v", err)}
+	}
+
+	t, err := troubleshoot.NewTroubleshoot(adminIP, adminPort)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := t.GetEnvoyStats(`^cluster\.`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stats of mesh gateway %q: %w", svc.ID, err)
+	}
+
+	names := meshGatewayClusterNames{
+		datacenter:  s.agent.config.Datacenter,
+		domain:      strings.TrimSuffix(s.agent.config.DNSDomain, "."),
+		peerServers: s.peerServerNames(req, token, &entMeta),
+	}
+	out := aggregateMeshGatewayStats(names, stats)
+	out.Service = svc.ID
+	return out, nil
+}
+
+// meshGatewayForStats returns the local mesh gateway with the given ID. When
+// no ID is given, exactly one mesh gateway must be registered with the agent.
+func (s *HTTPHandlers) meshGatewayForStats(id string, entMeta *acl.EnterpriseMeta) (*structs.NodeService, error) {
+	if id != "" {
+		sid := structs.NewServiceID(id, entMeta)
+		svc := s.agent.State.Service(sid)
+		if svc == nil {
+			return nil, HTTPError{StatusCode: http.StatusNotFound, Reason: fmt.Sprintf("unknown service ID: %s", sid.String())}
+		}
+		if svc.Kind != structs.ServiceKindMeshGateway {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: fmt.Sprintf("service %q is not a mesh gateway", id)}
+		}
+		return svc, nil
+	}
+
+	var found *structs.NodeService
+	for _, svc := range s.agent.State.Services(entMeta) {
+		if svc.Kind != structs.ServiceKindMeshGateway {
+			continue
+		}
+		if found != nil {
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Multiple mesh gateways are registered with this agent, the service-id query parameter is required"}
+		}
+		found = svc
+	}
+	if found == nil {
+		return nil, HTTPError{StatusCode: http.StatusNotFound, Reason: "No mesh gateway is registered with this agent"}
+	}
+	return found, nil
+}
+
+// peerServerNames maps the SNI of each peer's servers to the peer name. It is
+// best effort: traffic to peer servers is not attributed to a peer when the
+// peerings cannot be listed.
+func (s *HTTPHandlers) peerServerNames(req *http.Request, token string, entMeta *acl.EnterpriseMeta) map[string]string {
+	ctx, err := external.ContextWithQueryOptions(req.Context(), structs.QueryOptions{Token: token})
+	if err != nil {
+		return nil
+	}
+	list, err := s.agent.rpcClientPeering.PeeringList(ctx, &pbpeering.PeeringListRequest{
+		Partition: entMeta.PartitionOrEmpty(),
+	})
+	if err != nil {
+		s.agent.logger.Debug("failed to list peerings for mesh gateway stats", "error", err)
+		return nil
+	}
+
+	names := make(map[string]string, len(list.Peerings))
+	for _, p := range list.Peerings {
+		if p.PeerServerName != "" {
+			names[p.PeerServerName] = p.Name
+		}
+	}
+	return names
+}
+
+// parseLoopbackAddr splits an "ip:port" address, rejecting IPs that are not
+// loopback addresses so the endpoint cannot be used to reach other hosts.
+func parseLoopbackAddr(addr string) (*net.IPAddr, string, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, "", err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsLoopback() {
+		return nil, "", fmt.Errorf("%q is not a loopback IP address", host)
+	}
+	return &net.IPAddr{IP: ip}, port, nil
+}
+
+// meshGatewayClusterNames attributes the upstream clusters of a mesh gateway
+// to the remote datacenter or peer they route to.
+type meshGatewayClusterNames struct {
+	// datacenter is the local datacenter, whose clusters are ignored.
+	datacenter string
+
+	// domain is the Consul DNS domain, used in the SNI of WAN federated
+	// servers.
+	domain string
+
+	// peerServers maps the SNI of peer servers to the peer name.
+	peerServers map[string]string
+}
+
+// classify returns the remote datacenter or the peer that the named cluster
+// routes to. Both are empty for clusters of the local datacenter.
+func (n meshGatewayClusterNames) classify(cluster string) (dc, peer string) {
+	if peer, ok := n.peerServers[cluster]; ok {
+		return "", peer
+	}
+
+	if n.domain != "" && strings.HasSuffix(cluster, "."+n.domain) {
+		// server.<datacenter>.<domain> or <node>.server.<datacenter>.<domain>
+		labels := strings.Split(strings.TrimSuffix(cluster, "."+n.domain), ".")
+		switch {
+		case len(labels) == 2 && labels[0] == "server":
+			return n.remote(labels[1]), ""
+		case len(labels) == 3 && labels[1] == "server":
+			return n.remote(labels[2]), ""
+		}
+	}
+
+	labels := strings.Split(cluster, ".")
+	switch {
+	case len(labels) > 5 && labels[4] == "external":
+		// <service>.<namespace>.<partition>.<peer>.external.<trust-domain>
+		return "", labels[3]
+	case len(labels) > 2 && labels[1] == "internal":
+		// <datacenter>.internal.<trust-domain>
+		return n.remote(labels[0]), ""
+	case len(labels) > 3 && labels[2] == "internal-v1":
+		// <partition>.<datacenter>.internal-v1.<trust-domain>
+		return n.remote(labels[1]), ""
+	}
+	return "", ""
+}
+
+func (n meshGatewayClusterNames) remote(dc string) string {
+	if dc == n.datacenter {
+		return ""
+	}
+	return dc
+}
+
+// aggregateMeshGatewayStats sums the upstream cluster stats of a mesh gateway
+// per remote datacenter and per peer.
+func aggregateMeshGatewayStats(names meshGatewayClusterNames, stats []*envoy_admin_v3.SimpleMetric) *api.MeshGatewayStats {
+	out := &api.MeshGatewayStats{
+		Datacenters: make(map[string]api.MeshGatewayTrafficStats),
+		Peers:       make(map[string]api.MeshGatewayTrafficStats),
+	}
+
+	for _, stat := range stats {
+		name, ok := strings.CutPrefix(stat.Name, "cluster.")
+		if !ok {
+			continue
+		}
+		idx := strings.LastIndex(name, ".")
+		if idx == -1 {
+			continue
+		}
+		cluster, metric := name[:idx], name[idx+1:]
+
+		var target map[string]api.MeshGatewayTrafficStats
+		var key string
+		switch dc, peer := names.classify(cluster); {
+		case dc != "":
+			target, key = out.Datacenters, dc
+		case peer != "":
+			target, key = out.Peers, peer
+		default:
+			continue
+		}
+
+		traffic := target[key]
+		switch metric {
+		case "upstream_cx_tx_bytes_total":
+			traffic.BytesSent += stat.Value
+		case "upstream_cx_rx_bytes_total":
+			traffic.BytesReceived += stat.Value
+		case "upstream_cx_active":
+			traffic.ActiveConnections += stat.Value
+		case "upstream_cx_total":
+			traffic.TotalConnections += stat.Value
+		case "upstream_cx_connect_fail", "upstream_cx_connect_timeout":
+			traffic.ConnectionErrors += stat.Value
+		default:
+			continue
+		}
+		target[key] = traffic
+	}
+	return out
+}
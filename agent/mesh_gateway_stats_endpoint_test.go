@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package agent
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+const testMeshGatewayTrustDomain = "11111111-2222-3333-4444-555555555555.consul"
+
+func TestMeshGatewayClusterNames_classify(t *testing.T) {
+	names := meshGatewayClusterNames{
+		datacenter: "dc1",
+		domain:     "consul",
+		peerServers: map[string]string{
+			"server.dc3.peering.aaaaaaaa-2222-3333-4444-555555555555.consul": "cluster-03",
+		},
+	}
+
+	cases := map[string]struct {
+		cluster string
+		dc      string
+		peer    string
+	}{
+		"remote datacenter": {
+			cluster: "dc2.internal." + testMeshGatewayTrustDomain,
+			dc:      "dc2",
+		},
+		"remote datacenter in partition": {
+			cluster: "part1.dc2.internal-v1." + testMeshGatewayTrustDomain,
+			dc:      "dc2",
+		},
+		"local datacenter in partition": {
+			cluster: "part1.dc1.internal-v1." + testMeshGatewayTrustDomain,
+		},
+		"local service": {
+			cluster: "web.default.dc1.internal." + testMeshGatewayTrustDomain,
+		},
+		"exported service": {
+			cluster: "exported~web.default.dc1.internal." + testMeshGatewayTrustDomain,
+		},
+		"wan federated servers": {
+			cluster: "server.dc2.consul",
+			dc:      "dc2",
+		},
+		"wan federated server node": {
+			cluster: "node1.server.dc2.consul",
+			dc:      "dc2",
+		},
+		"local servers": {
+			cluster: "server.dc1.consul",
+		},
+		"peered service": {
+			cluster: "web.default.default.cluster-02.external." + testMeshGatewayTrustDomain,
+			peer:    "cluster-02",
+		},
+		"peer servers": {
+			cluster: "server.dc3.peering.aaaaaaaa-2222-3333-4444-555555555555.consul",
+			peer:    "cluster-03",
+		},
+		"local peering servers": {
+			cluster: "server.dc1.peering." + testMeshGatewayTrustDomain,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dc, peer := names.classify(tc.cluster)
+			require.Equal(t, tc.dc, dc)
+			require.Equal(t, tc.peer, peer)
+		})
+	}
+}
+
+func TestAggregateMeshGatewayStats(t *testing.T) {
+	names := meshGatewayClusterNames{datacenter: "dc1", domain: "consul"}
+	stats := []*envoy_admin_v3.SimpleMetric{
+		{Name: "cluster.dc2.internal." + testMeshGatewayTrustDomain + ".upstream_cx_tx_bytes_total", Value: 100},
+		{Name: "cluster.dc2.internal." + testMeshGatewayTrustDomain + ".upstream_cx_rx_bytes_total", Value: 200},
+		{Name: "cluster.dc2.internal." + testMeshGatewayTrustDomain + ".upstream_cx_active", Value: 1},
+		{Name: "cluster.dc2.internal." + testMeshGatewayTrustDomain + ".upstream_cx_total", Value: 3},
+		{Name: "cluster.dc2.internal." + testMeshGatewayTrustDomain + ".upstream_cx_connect_fail", Value: 1},
+		{Name: "cluster.dc2.internal." + testMeshGatewayTrustDomain + ".upstream_cx_connect_timeout", Value: 1},
+		{Name: "cluster.dc2.internal." + testMeshGatewayTrustDomain + ".upstream_rq_total", Value: 50},
+		{Name: "cluster.server.dc2.consul.upstream_cx_tx_bytes_total", Value: 10},
+		{Name: "cluster.server.dc2.consul.upstream_cx_total", Value: 1},
+		{Name: "cluster.web.default.default.cluster-02.external." + testMeshGatewayTrustDomain + ".upstream_cx_rx_bytes_total", Value: 7},
+		{Name: "cluster.api.default.default.cluster-02.external." + testMeshGatewayTrustDomain + ".upstream_cx_rx_bytes_total", Value: 8},
+		{Name: "cluster.web.default.dc1.internal." + testMeshGatewayTrustDomain + ".upstream_cx_total", Value: 99},
+		{Name: "cluster_manager.active_clusters", Value: 4},
+	}
+
+	out := aggregateMeshGatewayStats(names, stats)
+	require.Equal(t, map[string]api.MeshGatewayTrafficStats{
+		"dc2": {
+			BytesSent:         110,
+			BytesReceived:     200,
+			ActiveConnections: 1,
+			TotalConnections:  4,
+			ConnectionErrors:  2,
+		},
+	}, out.Datacenters)
+	require.Equal(t, map[string]api.MeshGatewayTrafficStats{
+		"cluster-02": {BytesReceived: 15},
+	}, out.Peers)
+}
+
+func TestAgent_MeshGatewayStats(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	admin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/stats" || r.URL.Query().Get("filter") != `^cluster\.` {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"stats": [
+			{"name": "cluster.dc2.internal.%[1]s.upstream_cx_tx_bytes_total", "value": 100},
+			{"name": "cluster.dc2.internal.%[1]s.upstream_cx_total", "value": 2},
+			{"name": "cluster.web.default.default.cluster-02.external.%[1]s.upstream_cx_active", "value": 1}
+		]}`, testMeshGatewayTrustDomain)
+	}))
+	defer admin.Close()
+	adminAddr := strings.TrimPrefix(admin.URL, "http://")
+
+	a := NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	get := func(t *testing.T, query url.Values) *httptest.ResponseRecorder {
+		req, err := http.NewRequest("GET", "/v1/agent/mesh-gateway/stats?"+query.Encode(), nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		return resp
+	}
+
+	t.Run("no mesh gateway", func(t *testing.T) {
+		resp := get(t, url.Values{"envoy-admin-endpoint": {adminAddr}})
+		require.Equal(t, http.StatusNotFound, resp.Code)
+	})
+
+	for _, id := range []string{"mesh-gateway-1", "mesh-gateway-2"} {
+		require.NoError(t, a.State.AddServiceWithChecks(&structs.NodeService{
+			Kind:    structs.ServiceKindMeshGateway,
+			ID:      id,
+			Service: "mesh-gateway",
+			Port:    8443,
+		}, nil, "", false))
+	}
+	require.NoError(t, a.State.AddServiceWithChecks(&structs.NodeService{
+		ID:      "web",
+		Service: "web",
+		Port:    8080,
+	}, nil, "", false))
+
+	t.Run("ambiguous mesh gateway", func(t *testing.T) {
+		resp := get(t, url.Values{"envoy-admin-endpoint": {adminAddr}})
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, resp.Body.String(), "service-id query parameter is required")
+	})
+
+	t.Run("not a mesh gateway", func(t *testing.T) {
+		resp := get(t, url.Values{"service-id": {"web"}, "envoy-admin-endpoint": {adminAddr}})
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, resp.Body.String(), `service "web" is not a mesh gateway`)
+	})
+
+	t.Run("admin endpoint must be loopback", func(t *testing.T) {
+		resp := get(t, url.Values{"service-id": {"mesh-gateway-1"}, "envoy-admin-endpoint": {"10.0.0.1:19000"}})
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, resp.Body.String(), "is not a loopback IP address")
+	})
+
+	t.Run("stats", func(t *testing.T) {
+		resp := get(t, url.Values{"service-id": {"mesh-gateway-1"}, "envoy-admin-endpoint": {adminAddr}})
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+
+		var out api.MeshGatewayStats
+		require.NoError(t, decodeBody(resp.Body, &out))
+		require.Equal(t, api.MeshGatewayStats{
+			Service: "mesh-gateway-1",
+			Datacenters: map[string]api.MeshGatewayTrafficStats{
+				"dc2": {BytesSent: 100, TotalConnections: 2},
+			},
+			Peers: map[string]api.MeshGatewayTrafficStats{
+				"cluster-02": {ActiveConnections: 1},
+			},
+		}, out)
+	})
+}
+
+func TestAgent_MeshGatewayStats_ACLDeny(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := NewTestAgent(t, TestACLConfig())
+	defer a.Shutdown()
+	testrpc.WaitForLeader(t, a.RPC, "dc1")
+
+	require.NoError(t, a.State.AddServiceWithChecks(&structs.NodeService{
+		Kind:    structs.ServiceKindMeshGateway,
+		ID:      "mesh-gateway",
+		Service: "mesh-gateway",
+		Port:    8443,
+	}, nil, "", false))
+
+	req, err := http.NewRequest("GET", "/v1/agent/mesh-gateway/stats", nil)
+	require.NoError(t, err)
+	resp := httptest.NewRecorder()
+	a.srv.h.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusForbidden, resp.Code)
+}
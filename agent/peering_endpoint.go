@@ -9,7 +9,9 @@ import (
 	"strings"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/hashicorp/consul/acl"
 	external "github.com/hashicorp/consul/agent/grpc-external"
@@ -19,13 +21,28 @@ import (
 	"github.com/hashicorp/consul/proto/private/pbpeering"
 )
 
-// PeeringEndpoint handles GET, DELETE on v1/peering/name
+// PeeringEndpoint handles GET, DELETE on v1/peering/name, GET on
+// v1/peering/name/diagnostics and POST on v1/peering/name/rotate
 func (s *HTTPHandlers) PeeringEndpoint(resp http.ResponseWriter, req *http.Request) (interface{}, error) {
 	name := strings.TrimPrefix(req.URL.Path, "/v1/peering/")
 	if name == "" {
 		return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: "Must specify a name to fetch."}
 	}
 
+	if name, ok := strings.CutSuffix(name, "/diagnostics"); ok {
+		if req.Method != "GET" {
+			return nil, MethodNotAllowedError{req.Method, []string{"GET"}}
+		}
+		return s.peeringDiagnostics(resp, req, name)
+	}
+
+	if name, ok := strings.CutSuffix(name, "/rotate"); ok {
+		if req.Method != "POST" {
+			return nil, MethodNotAllowedError{req.Method, []string{"POST"}}
+		}
+		return s.peeringRotateSecrets(resp, req, name)
+	}
+
 	// Switch on the method
 	switch req.Method {
 	case "GET":
@@ -37,6 +54,38 @@ func (s *HTTPHandlers) PeeringEndpoint(resp http.ResponseWriter, req *http.Reque
 	}
 }
 
+// peeringDiagnostics fetches the replication details of the stream of a peering
+// that matches the name and partition.
+func (s *HTTPHandlers) peeringDiagnostics(resp http.ResponseWriter, req *http.Request, name string) (interface{}, error) {
+	var entMeta acl.EnterpriseMeta
+	if err := s.parseEntMetaPartition(req, &entMeta); err != nil {
+		return nil, err
+	}
+	args := pbpeering.PeeringDiagnosticsRequest{
+		Name:      name,
+		Partition: entMeta.PartitionOrEmpty(),
+	}
+
+	var dc string
+	options := structs.QueryOptions{}
+	s.parse(resp, req, &dc, &options)
+	options.AllowStale = false // Stream state is only tracked by the leader
+	ctx, err := external.ContextWithQueryOptions(req.Context(), options)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.agent.rpcClientPeering.PeeringDiagnostics(ctx, &args)
+	if err != nil {
+		return nil, err
+	}
+	if result.Diagnostics == nil {
+		return nil, HTTPError{StatusCode: http.StatusNotFound, Reason: fmt.Sprintf("Peering not found for %q", name)}
+	}
+
+	return result.Diagnostics.ToAPI(), nil
+}
+
 // peeringRead fetches a peering that matches the name and partition.
 // This assumes that the name and partition parameters are valid
 func (s *HTTPHandlers) peeringRead(resp http.ResponseWriter, req *http.Request, name string) (interface{}, error) {
@@ -226,3 +275,39 @@ func (s *HTTPHandlers) peeringDelete(resp http.ResponseWriter, req *http.Request
 
 	return nil, nil
 }
+
+// peeringRotateSecrets replaces the stream secret of a peering that matches the
+// name and partition.
+func (s *HTTPHandlers) peeringRotateSecrets(resp http.ResponseWriter, req *http.Request, name string) (interface{}, error) {
+	var entMeta acl.EnterpriseMeta
+	if err := s.parseEntMetaPartition(req, &entMeta); err != nil {
+		return nil, err
+	}
+	args := pbpeering.RotateSecretsRequest{
+		Name:      name,
+		Partition: entMeta.PartitionOrEmpty(),
+	}
+
+	var token string
+	s.parseToken(req, &token)
+	options := structs.QueryOptions{Token: token}
+	ctx, err := external.ContextWithQueryOptions(req.Context(), options)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.agent.rpcClientPeering.RotateSecrets(ctx, &args)
+	if st, ok := grpcstatus.FromError(err); ok {
+		switch st.Code() {
+		case codes.NotFound:
+			return nil, HTTPError{StatusCode: http.StatusNotFound, Reason: st.Message()}
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			return nil, HTTPError{StatusCode: http.StatusBadRequest, Reason: st.Message()}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
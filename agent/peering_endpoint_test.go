@@ -631,6 +631,73 @@ func TestHTTP_Peering_Read(t *testing.T) {
 	})
 }
 
+func TestHTTP_Peering_Diagnostics(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Insert peerings directly to state store.
+	// Note that the state store holds reference to the underlying
+	// variables; do not modify them after writing.
+	foo := &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			Name:                "foo",
+			State:               pbpeering.PeeringState_ESTABLISHING,
+			PeerCAPems:          nil,
+			PeerServerName:      "fooservername",
+			PeerServerAddresses: []string{"127.0.0.1:1"},
+		},
+	}
+	_, err := a.rpcClientPeering.PeeringWrite(ctx, foo)
+	require.NoError(t, err)
+
+	t.Run("return foo", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/v1/peering/foo/diagnostics", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		var apiResp api.PeeringDiagnostics
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&apiResp))
+
+		require.Equal(t, "foo", apiResp.Name)
+		require.True(t, apiResp.Dialer)
+		require.False(t, apiResp.Connected)
+		require.Empty(t, apiResp.Resources)
+		require.NotNil(t, apiResp.Dial)
+		require.Equal(t, "fooservername", apiResp.Dial.ServerName)
+		require.Equal(t, []string{"127.0.0.1:1"}, apiResp.Dial.ServerAddresses)
+		require.False(t, apiResp.Dial.PeerThroughMeshGateways)
+		require.Empty(t, apiResp.Dial.GatewayAddresses)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/v1/peering/baz/diagnostics", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusNotFound, resp.Code)
+		require.Equal(t, "Peering not found for \"baz\"", resp.Body.String())
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		req, err := http.NewRequest("DELETE", "/v1/peering/foo/diagnostics", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+	})
+}
+
 func TestHTTP_Peering_Delete(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -694,6 +761,79 @@ func TestHTTP_Peering_Delete(t *testing.T) {
 	})
 }
 
+func TestHTTP_Peering_RotateSecrets(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+	a := NewTestAgent(t, "")
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	testrpc.WaitForActiveCARoot(t, a.RPC, "dc1", nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Insert a peering for which this agent is the dialer.
+	dialer := &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			Name:                "dialer",
+			State:               pbpeering.PeeringState_ESTABLISHING,
+			PeerServerName:      "dialerservername",
+			PeerServerAddresses: []string{"addr1"},
+		},
+	}
+	_, err := a.rpcClientPeering.PeeringWrite(ctx, dialer)
+	require.NoError(t, err)
+
+	// Generate a token so that there is a peering that was never established.
+	bodyBytes, err := json.Marshal(&pbpeering.GenerateTokenRequest{
+		PeerName: "foo",
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("POST", "/v1/peering/token", bytes.NewReader(bodyBytes))
+	require.NoError(t, err)
+	resp := httptest.NewRecorder()
+	a.srv.h.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code, "expected 200, got %d: %v", resp.Code, resp.Body.String())
+
+	t.Run("method not allowed", func(t *testing.T) {
+		req, err := http.NewRequest("GET", "/v1/peering/foo/rotate", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+	})
+
+	t.Run("peering does not exist", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/v1/peering/baz/rotate", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusNotFound, resp.Code)
+	})
+
+	t.Run("peering was not established", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/v1/peering/foo/rotate", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, resp.Body.String(), "peering was not established")
+	})
+
+	t.Run("cannot rotate from the dialing cluster", func(t *testing.T) {
+		req, err := http.NewRequest("POST", "/v1/peering/dialer/rotate", nil)
+		require.NoError(t, err)
+		resp := httptest.NewRecorder()
+		a.srv.h.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		require.Contains(t, resp.Body.String(), "cluster that generated the peering token")
+	})
+}
+
 func TestHTTP_Peering_List(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
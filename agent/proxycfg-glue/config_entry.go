@@ -67,12 +67,18 @@ func newConfigEntryRequest(req *structs.ConfigEntryQuery, deps ServerDataSourceD
 		topic = pbsubscribe.Topic_APIGateway
 	case structs.HTTPRoute:
 		topic = pbsubscribe.Topic_HTTPRoute
+	case structs.GRPCRoute:
+		topic = pbsubscribe.Topic_GRPCRoute
 	case structs.TCPRoute:
 		topic = pbsubscribe.Topic_TCPRoute
+	case structs.TLSRoute:
+		topic = pbsubscribe.Topic_TLSRoute
 	case structs.FileSystemCertificate:
 		topic = pbsubscribe.Topic_FileSystemCertificate
 	case structs.InlineCertificate:
 		topic = pbsubscribe.Topic_InlineCertificate
+	case structs.ACMECertificate:
+		topic = pbsubscribe.Topic_ACMECertificate
 	case structs.BoundAPIGateway:
 		topic = pbsubscribe.Topic_BoundAPIGateway
 	case structs.RateLimitIPConfig:
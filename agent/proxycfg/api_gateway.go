@@ -48,6 +48,17 @@ func (h *handlerAPIGateway) initialize(ctx context.Context) (ConfigSnapshot, err
 		return snap, err
 	}
 
+	// Watch acme-certificates for HTTP-01 challenges to respond to.
+	err = h.dataSources.ConfigEntryList.Notify(ctx, &structs.ConfigEntryQuery{
+		Kind:           structs.ACMECertificate,
+		Datacenter:     h.source.Datacenter,
+		QueryOptions:   structs.QueryOptions{Token: h.token},
+		EnterpriseMeta: *structs.WildcardEnterpriseMetaInPartition(h.proxyID.PartitionOrDefault()),
+	}, acmeChallengesWatchID, h.ch)
+	if err != nil {
+		return snap, err
+	}
+
 	// Watch the api-gateway's config entry
 	err = h.subscribeToConfigEntry(ctx, structs.APIGateway, h.service, h.proxyID.EnterpriseMeta, apiGatewayConfigWatchID)
 	if err != nil {
@@ -62,7 +73,9 @@ func (h *handlerAPIGateway) initialize(ctx context.Context) (ConfigSnapshot, err
 	snap.APIGateway.Listeners = make(map[string]structs.APIGatewayListener)
 	snap.APIGateway.BoundListeners = make(map[string]structs.BoundAPIGatewayListener)
 	snap.APIGateway.HTTPRoutes = watch.NewMap[structs.ResourceReference, *structs.HTTPRouteConfigEntry]()
+	snap.APIGateway.GRPCRoutes = watch.NewMap[structs.ResourceReference, *structs.GRPCRouteConfigEntry]()
 	snap.APIGateway.TCPRoutes = watch.NewMap[structs.ResourceReference, *structs.TCPRouteConfigEntry]()
+	snap.APIGateway.TLSRoutes = watch.NewMap[structs.ResourceReference, *structs.TLSRouteConfigEntry]()
 	snap.APIGateway.InlineCertificates = watch.NewMap[structs.ResourceReference, *structs.InlineCertificateConfigEntry]()
 	snap.APIGateway.FileSystemCertificates = watch.NewMap[structs.ResourceReference, *structs.FileSystemCertificateConfigEntry]()
 
@@ -194,8 +207,12 @@ func (h *handlerAPIGateway) handleGatewayConfigUpdate(ctx context.Context, u Upd
 				switch ref.Kind {
 				case structs.HTTPRoute:
 					snap.APIGateway.HTTPRoutes.InitWatch(ref, cancel)
+				case structs.GRPCRoute:
+					snap.APIGateway.GRPCRoutes.InitWatch(ref, cancel)
 				case structs.TCPRoute:
 					snap.APIGateway.TCPRoutes.InitWatch(ref, cancel)
+				case structs.TLSRoute:
+					snap.APIGateway.TLSRoutes.InitWatch(ref, cancel)
 				default:
 					cancel()
 					return fmt.Errorf("unexpected route kind on gateway: %s", ref.Kind)
@@ -241,6 +258,15 @@ func (h *handlerAPIGateway) handleGatewayConfigUpdate(ctx context.Context, u Upd
 			return true
 		})
 
+		snap.APIGateway.GRPCRoutes.ForEachKey(func(ref structs.ResourceReference) bool {
+			if _, ok := seenRefs[ref]; !ok {
+				snap.APIGateway.Upstreams.delete(ref)
+				snap.APIGateway.UpstreamsSet.delete(ref)
+				snap.APIGateway.GRPCRoutes.CancelWatch(ref)
+			}
+			return true
+		})
+
 		snap.APIGateway.TCPRoutes.ForEachKey(func(ref structs.ResourceReference) bool {
 			if _, ok := seenRefs[ref]; !ok {
 				snap.APIGateway.Upstreams.delete(ref)
@@ -250,6 +276,15 @@ func (h *handlerAPIGateway) handleGatewayConfigUpdate(ctx context.Context, u Upd
 			return true
 		})
 
+		snap.APIGateway.TLSRoutes.ForEachKey(func(ref structs.ResourceReference) bool {
+			if _, ok := seenRefs[ref]; !ok {
+				snap.APIGateway.Upstreams.delete(ref)
+				snap.APIGateway.UpstreamsSet.delete(ref)
+				snap.APIGateway.TLSRoutes.CancelWatch(ref)
+			}
+			return true
+		})
+
 		snap.APIGateway.InlineCertificates.ForEachKey(func(ref structs.ResourceReference) bool {
 			if _, ok := seenRefs[ref]; !ok {
 				snap.APIGateway.InlineCertificates.CancelWatch(ref)
@@ -408,6 +443,55 @@ func (h *handlerAPIGateway) handleRouteConfigUpdate(ctx context.Context, u Updat
 			}
 		}
 
+	case *structs.GRPCRouteConfigEntry:
+		snap.APIGateway.GRPCRoutes.Set(ref, route)
+
+		for _, service := range route.GetServices() {
+			for _, listener := range snap.APIGateway.Listeners {
+				shouldBind := false
+				for _, parent := range route.Parents {
+					if h.referenceIsForListener(parent, listener, snap) {
+						shouldBind = true
+						break
+					}
+				}
+				if !shouldBind {
+					continue
+				}
+
+				upstream := structs.Upstream{
+					DestinationName:      service.Name,
+					DestinationNamespace: service.NamespaceOrDefault(),
+					DestinationPartition: service.PartitionOrDefault(),
+					LocalBindPort:        listener.Port,
+					// gRPC always runs over HTTP/2, so force that protocol on the
+					// upstream clusters regardless of the listener protocol.
+					Config: map[string]interface{}{
+						"protocol": "grpc",
+					},
+				}
+
+				listenerKey := APIGatewayListenerKeyFromListener(listener)
+				upstreams[listenerKey] = append(upstreams[listenerKey], upstream)
+			}
+
+			upstreamID := NewUpstreamIDFromServiceName(service.ServiceName())
+			seenUpstreamIDs[upstreamID] = struct{}{}
+
+			watchOpts := discoveryChainWatchOpts{
+				id:         upstreamID,
+				name:       service.Name,
+				namespace:  service.NamespaceOrDefault(),
+				partition:  service.PartitionOrDefault(),
+				datacenter: h.stateConfig.source.Datacenter,
+			}
+
+			handler := &handlerUpstreams{handlerState: h.handlerState}
+			if err := handler.watchDiscoveryChain(ctx, snap, watchOpts); err != nil {
+				return fmt.Errorf("failed to watch discovery chain for %s: %w", upstreamID, err)
+			}
+		}
+
 	case *structs.TCPRouteConfigEntry:
 		snap.APIGateway.TCPRoutes.Set(ref, route)
 
@@ -452,6 +536,59 @@ func (h *handlerAPIGateway) handleRouteConfigUpdate(ctx context.Context, u Updat
 				datacenter: h.stateConfig.source.Datacenter,
 			}
 
+			handler := &handlerUpstreams{handlerState: h.handlerState}
+			if err := handler.watchDiscoveryChain(ctx, snap, watchOpts); err != nil {
+				return fmt.Errorf("failed to watch discovery chain for %s: %w", upstreamID, err)
+			}
+		}
+	case *structs.TLSRouteConfigEntry:
+		snap.APIGateway.TLSRoutes.Set(ref, route)
+
+		for _, service := range route.Services {
+			upstreamID := NewUpstreamIDFromServiceName(service.ServiceName())
+			seenUpstreamIDs.add(upstreamID)
+
+			// For each passthrough listener, check if this route should bind and, if so, create an upstream.
+			for _, listener := range snap.APIGateway.Listeners {
+				if !listener.AcceptsRouteKind(route.GetKind()) {
+					continue
+				}
+
+				shouldBind := false
+				for _, parent := range route.Parents {
+					if h.referenceIsForListener(parent, listener, snap) {
+						shouldBind = true
+						break
+					}
+				}
+				if !shouldBind {
+					continue
+				}
+
+				upstream := structs.Upstream{
+					DestinationName:      service.Name,
+					DestinationNamespace: service.NamespaceOrDefault(),
+					DestinationPartition: service.PartitionOrDefault(),
+					LocalBindPort:        listener.Port,
+					// TLS connections are proxied without being terminated, so
+					// the upstream is always treated as opaque TCP.
+					Config: map[string]interface{}{
+						"protocol": "tcp",
+					},
+				}
+
+				listenerKey := APIGatewayListenerKeyFromListener(listener)
+				upstreams[listenerKey] = append(upstreams[listenerKey], upstream)
+			}
+
+			watchOpts := discoveryChainWatchOpts{
+				id:         upstreamID,
+				name:       service.Name,
+				namespace:  service.NamespaceOrDefault(),
+				partition:  service.PartitionOrDefault(),
+				datacenter: h.stateConfig.source.Datacenter,
+			}
+
 			handler := &handlerUpstreams{handlerState: h.handlerState}
 			if err := handler.watchDiscoveryChain(ctx, snap, watchOpts); err != nil {
 				return fmt.Errorf("failed to watch discovery chain for %s: %w", upstreamID, err)
@@ -128,6 +128,22 @@ func (s *handlerConnectProxy) initialize(ctx context.Context) (ConfigSnapshot, e
 		return snap, err
 	}
 
+	// Watch the instances of our own service so that Envoy can compare their
+	// zones with those of the upstreams when zone-aware routing is enabled.
+	if s.locality != nil {
+		err = s.dataSources.Health.Notify(ctx, &structs.ServiceSpecificRequest{
+			Datacenter:     s.source.Datacenter,
+			QueryOptions:   structs.QueryOptions{Token: s.token},
+			ServiceName:    s.proxyCfg.DestinationServiceName,
+			Connect:        true,
+			Source:         *s.source,
+			EnterpriseMeta: s.proxyID.EnterpriseMeta,
+		}, localServiceInstancesWatchID, s.ch)
+		if err != nil {
+			return snap, err
+		}
+	}
+
 	if err := s.maybeInitializeTelemetryCollectorWatches(ctx, snap); err != nil {
 		return snap, fmt.Errorf("failed to initialize telemetry collector watches: %w", err)
 	}
@@ -320,6 +336,13 @@ func (s *handlerConnectProxy) handleUpdate(ctx context.Context, u UpdateEvent, s
 		}
 		snap.Roots = roots
 
+	case u.CorrelationID == localServiceInstancesWatchID:
+		resp, ok := u.Result.(*structs.IndexedCheckServiceNodes)
+		if !ok {
+			return fmt.Errorf("invalid type for response: %T", u.Result)
+		}
+		snap.ConnectProxy.LocalServiceInstances = resp.Nodes
+
 	case u.CorrelationID == peeringTrustBundlesWatchID:
 		resp, ok := u.Result.(*pbpeering.TrustBundleListByServiceResponse)
 		if !ok {
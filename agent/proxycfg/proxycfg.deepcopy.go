@@ -191,6 +191,10 @@ func (o *ConfigSnapshotUpstreams) DeepCopy() *ConfigSnapshotUpstreams {
 	if o.MeshConfig != nil {
 		cp.MeshConfig = o.MeshConfig.DeepCopy()
 	}
+	if o.ACMEChallenges != nil {
+		cp.ACMEChallenges = make([]structs.ACMEChallenge, len(o.ACMEChallenges))
+		copy(cp.ACMEChallenges, o.ACMEChallenges)
+	}
 	if o.DiscoveryChain != nil {
 		cp.DiscoveryChain = make(map[UpstreamID]*structs.CompiledDiscoveryChain, len(o.DiscoveryChain))
 		for k2, v2 := range o.DiscoveryChain {
@@ -420,7 +424,9 @@ func (o *configSnapshotAPIGateway) DeepCopy() *configSnapshotAPIGateway {
 		}
 	}
 	cp.HTTPRoutes = o.HTTPRoutes.DeepCopy()
+	cp.GRPCRoutes = o.GRPCRoutes.DeepCopy()
 	cp.TCPRoutes = o.TCPRoutes.DeepCopy()
+	cp.TLSRoutes = o.TLSRoutes.DeepCopy()
 	cp.InlineCertificates = o.InlineCertificates.DeepCopy()
 	cp.FileSystemCertificates = o.FileSystemCertificates.DeepCopy()
 	if o.Listeners != nil {
@@ -500,6 +506,7 @@ func (o *configSnapshotConnectProxy) DeepCopy() *configSnapshotConnectProxy {
 	}
 	cp.DestinationsUpstream = o.DestinationsUpstream.DeepCopy()
 	cp.DestinationGateways = o.DestinationGateways.DeepCopy()
+	cp.LocalServiceInstances = o.LocalServiceInstances.DeepCopy()
 	return &cp
 }
 
@@ -29,6 +29,10 @@ type ConfigSnapshotUpstreams struct {
 	MeshConfig    *structs.MeshConfigEntry
 	MeshConfigSet bool
 
+	// ACMEChallenges are the pending HTTP-01 challenges of acme-certificate
+	// config entries. Gateways respond to them on their HTTP listeners.
+	ACMEChallenges []structs.ACMEChallenge
+
 	// DiscoveryChain is a map of UpstreamID -> CompiledDiscoveryChain's, and
 	// is used to determine what services could be targeted by this upstream.
 	// We then instantiate watches for those targets.
@@ -161,6 +165,12 @@ type configSnapshotConnectProxy struct {
 
 	DestinationsUpstream watch.Map[UpstreamID, *structs.ServiceConfigEntry]
 	DestinationGateways  watch.Map[UpstreamID, structs.CheckServiceNodes]
+
+	// LocalServiceInstances are the instances of the proxy's own service. They
+	// are only watched when the proxy has a locality and let Envoy compare the
+	// zones of the local service with those of its upstreams for zone-aware
+	// routing.
+	LocalServiceInstances structs.CheckServiceNodes
 }
 
 // isEmpty is a test helper
@@ -188,7 +198,8 @@ func (c *configSnapshotConnectProxy) isEmpty() bool {
 		!c.InboundPeerTrustBundlesSet &&
 		!c.MeshConfigSet &&
 		c.PeerUpstreamEndpoints.Len() == 0 &&
-		len(c.PeerUpstreamEndpointsUseHostnames) == 0
+		len(c.PeerUpstreamEndpointsUseHostnames) == 0 &&
+		len(c.LocalServiceInstances) == 0
 }
 
 func (c *configSnapshotConnectProxy) IsImplicitUpstream(uid UpstreamID) bool {
@@ -740,7 +751,9 @@ type configSnapshotAPIGateway struct {
 	UpstreamsSet routeUpstreamSet
 
 	HTTPRoutes watch.Map[structs.ResourceReference, *structs.HTTPRouteConfigEntry]
+	GRPCRoutes watch.Map[structs.ResourceReference, *structs.GRPCRouteConfigEntry]
 	TCPRoutes  watch.Map[structs.ResourceReference, *structs.TCPRouteConfigEntry]
+	TLSRoutes  watch.Map[structs.ResourceReference, *structs.TLSRouteConfigEntry]
 
 	InlineCertificates     watch.Map[structs.ResourceReference, *structs.InlineCertificateConfigEntry]
 	FileSystemCertificates watch.Map[structs.ResourceReference, *structs.FileSystemCertificateConfigEntry]
@@ -783,14 +796,28 @@ DOMAIN_LOOP:
 
 	synthesizer := discoverychain.NewGatewayChainSynthesizer(datacenter, trustDomain, listener.Name, c.GatewayConfig)
 	synthesizer.SetHostname(listener.GetHostname())
+	respondsDirectly := false
 	for _, routeRef := range boundListener.Routes {
 		switch routeRef.Kind {
 		case structs.HTTPRoute:
 			route, ok := c.HTTPRoutes.Get(routeRef)
-			if !ok || listener.Protocol != structs.ListenerProtocolHTTP {
+			if !ok || !listener.Protocol.AcceptsRoute(route.GetProtocol()) {
 				continue
 			}
 			synthesizer.AddHTTPRoute(*route)
+			respondsDirectly = respondsDirectly || route.RespondsDirectly()
+			for _, service := range route.GetServices() {
+				id := NewUpstreamIDFromServiceName(structs.NewServiceName(service.Name, &service.EnterpriseMeta))
+				if chain := c.DiscoveryChain[id]; chain != nil {
+					chains = append(chains, chain)
+				}
+			}
+		case structs.GRPCRoute:
+			route, ok := c.GRPCRoutes.Get(routeRef)
+			if !ok || !listener.Protocol.AcceptsRoute(route.GetProtocol()) {
+				continue
+			}
+			synthesizer.AddGRPCRoute(*route)
 			for _, service := range route.GetServices() {
 				id := NewUpstreamIDFromServiceName(structs.NewServiceName(service.Name, &service.EnterpriseMeta))
 				if chain := c.DiscoveryChain[id]; chain != nil {
@@ -799,7 +826,7 @@ DOMAIN_LOOP:
 			}
 		case structs.TCPRoute:
 			route, ok := c.TCPRoutes.Get(routeRef)
-			if !ok || listener.Protocol != structs.ListenerProtocolTCP {
+			if !ok || !listener.Protocol.AcceptsRoute(route.GetProtocol()) {
 				continue
 			}
 			synthesizer.AddTCPRoute(*route)
@@ -809,12 +836,33 @@ DOMAIN_LOOP:
 					chains = append(chains, chain)
 				}
 			}
+		case structs.TLSRoute:
+			route, ok := c.TLSRoutes.Get(routeRef)
+			if !ok || !listener.AcceptsRouteKind(route.GetKind()) {
+				continue
+			}
+			// TLSRoutes are passed through without compiling a new chain, so the
+			// synthesized services must line up with the chains of the route.
+			// Wait until all of them have been received before adding it.
+			routeChains := make([]*structs.CompiledDiscoveryChain, 0, len(route.Services))
+			for _, service := range route.GetServices() {
+				id := NewUpstreamIDFromServiceName(structs.NewServiceName(service.Name, &service.EnterpriseMeta))
+				if chain := c.DiscoveryChain[id]; chain != nil {
+					routeChains = append(routeChains, chain)
+				}
+			}
+			if len(routeChains) != len(route.Services) {
+				continue
+			}
+			synthesizer.AddTLSRoute(*route)
+			chains = append(chains, routeChains...)
 		default:
 			return nil, nil, nil, fmt.Errorf("unknown route kind %q", routeRef.Kind)
 		}
 	}
 
-	if len(chains) == 0 {
+	// Routes answered by the gateway itself don't need any upstream chains.
+	if len(chains) == 0 && !respondsDirectly {
 		return nil, nil, nil, nil
 	}
 
@@ -41,6 +41,7 @@ const (
 	boundGatewayConfigWatchID          = "bound-gateway-config"
 	fileSystemCertificateConfigWatchID = "file-system-certificate-config"
 	inlineCertificateConfigWatchID     = "inline-certificate-config"
+	acmeChallengesWatchID              = "acme-challenges"
 	routeConfigWatchID                 = "route-config"
 	externalServiceIDPrefix            = "external-service:"
 	serviceLeafIDPrefix                = "service-leaf:"
@@ -55,6 +56,7 @@ const (
 	upstreamPeerWatchIDPrefix          = "upstream-peer:"
 	exportedServiceListWatchID         = "exported-service-list"
 	meshConfigEntryID                  = "mesh"
+	localServiceInstancesWatchID       = "local-service-instances"
 	DestinationConfigEntryID           = "destination:"
 	DestinationGatewayID               = "dest-gateway:"
 	svcChecksWatchIDPrefix             = cachetype.ServiceHTTPChecksName + ":"
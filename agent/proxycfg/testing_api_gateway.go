@@ -162,3 +162,22 @@ func TestConfigSnapshotAPIGateway_NilConfigEntry(
 		TaggedAddresses: nil,
 	}, nil, nil, testSpliceEvents(baseEvents, nil))
 }
+
+// TestACMEChallengesUpdateEvent returns the update event for an
+// acme-certificate config entry with the given pending challenges.
+func TestACMEChallengesUpdateEvent(challenges ...structs.ACMEChallenge) UpdateEvent {
+	return UpdateEvent{
+		CorrelationID: acmeChallengesWatchID,
+		Result: &structs.IndexedConfigEntries{
+			Kind: structs.ACMECertificate,
+			Entries: []structs.ConfigEntry{
+				&structs.ACMECertificateConfigEntry{
+					Kind:       structs.ACMECertificate,
+					Name:       "certificate",
+					Hostnames:  []string{"example.com"},
+					Challenges: challenges,
+				},
+			},
+		},
+	}
+}
@@ -151,6 +151,31 @@ func TestConfigSnapshotDiscoveryChain(
 		t, variation, enterprise, upstreams, additionalEntries...,
 	))
 
+	// Only connect proxies watch the JWT providers their routes match on.
+	if variation == "chain-and-jwt-routing" {
+		baseEvents = testSpliceEvents(baseEvents, []UpdateEvent{
+			{
+				CorrelationID: jwtProviderID,
+				Result: &structs.IndexedConfigEntries{
+					Kind: structs.JWTProvider,
+					Entries: []structs.ConfigEntry{
+						&structs.JWTProviderConfigEntry{
+							Kind:   structs.JWTProvider,
+							Name:   "okta",
+							Issuer: "https://okta.example.com",
+							JSONWebKeySet: &structs.JSONWebKeySet{
+								Remote: &structs.RemoteJWKS{
+									URI:                 "https://okta.example.com/.well-known/jwks.json",
+									FetchAsynchronously: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
 	return testConfigSnapshotFixture(t, &structs.NodeService{
 		Kind:    structs.ServiceKindConnectProxy,
 		Service: "web-sidecar-proxy",
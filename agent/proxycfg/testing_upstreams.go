@@ -37,6 +37,11 @@ func setupTestVariationConfigEntriesAndSnapshot(
 			node.Service.Kind = structs.ServiceKindTerminatingGateway
 		}
 	}
+	if variation == "chain-and-zone-aware" {
+		for i, node := range nodes {
+			node.Node.Locality = &structs.Locality{Region: "us-east-1", Zone: "us-east-1" + string(rune('a'+i))}
+		}
+	}
 	events := []UpdateEvent{
 		{
 			CorrelationID: "discovery-chain:" + dbUID.String(),
@@ -252,8 +257,25 @@ func setupTestVariationConfigEntriesAndSnapshot(
 			},
 		})
 	case "chain-and-splitter":
+	case "chain-and-mirrors":
+	case "chain-and-fault-injection":
+	case "chain-and-rate-limit":
+	case "chain-and-cors":
+	case "chain-and-jwt-routing":
 	case "grpc-router":
 	case "chain-and-router":
+	case "chain-and-resolver-health-checks":
+	case "chain-and-zone-aware":
+		localNodes := TestUpstreamNodes(t, "web")
+		for i, node := range localNodes {
+			node.Node.Locality = &structs.Locality{Region: "us-east-1", Zone: "us-east-1" + string(rune('a'+i))}
+		}
+		events = append(events, UpdateEvent{
+			CorrelationID: localServiceInstancesWatchID,
+			Result: &structs.IndexedCheckServiceNodes{
+				Nodes: localNodes,
+			},
+		})
 	case "lb-resolver":
 	case "register-to-terminating-gateway":
 	case "redirect-to-lb-node":
@@ -629,6 +651,134 @@ func setupTestVariationDiscoveryChain(
 				},
 			},
 		)
+	case "chain-and-mirrors":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "http",
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: &structs.ServiceRouteMatch{
+							HTTP: &structs.ServiceRouteHTTPMatch{
+								PathPrefix: "/shadowed",
+							},
+						},
+						Destination: &structs.ServiceRouteDestination{
+							Service: "db",
+							Mirror: []structs.ServiceRouteMirror{
+								{Service: "db-shadow", Percent: 12.5},
+							},
+						},
+					},
+				},
+			},
+			&structs.ServiceSplitterConfigEntry{
+				Kind:           structs.ServiceSplitter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Splits: []structs.ServiceSplit{
+					{
+						Weight:  90,
+						Service: "db",
+						Mirror: []structs.ServiceRouteMirror{
+							{Service: "db-canary"},
+						},
+					},
+					{
+						Weight:  10,
+						Service: "db-canary",
+					},
+				},
+			},
+		)
+	case "chain-and-fault-injection":
+		entries = append(entries, httpRouteToDestination(entMeta, "/chaos", &structs.ServiceRouteDestination{
+			Service: "db",
+			FaultInjection: &structs.FaultInjection{
+				Delay: &structs.FaultInjectionDelay{
+					FixedDelay: 2 * time.Second,
+					Percent:    50,
+				},
+				Abort: &structs.FaultInjectionAbort{
+					HTTPStatus: 503,
+					Percent:    12.5,
+				},
+				Headers: []structs.ServiceRouteHTTPMatchHeader{
+					{Name: "x-chaos", Exact: "enabled"},
+				},
+			},
+		})...)
+	case "chain-and-rate-limit":
+		entries = append(entries, httpRouteToDestination(entMeta, "/search", &structs.ServiceRouteDestination{
+			Service: "db",
+			RateLimit: &structs.LocalRateLimit{
+				RequestsPerSecond: 20,
+				RequestsMaxBurst:  40,
+				Descriptors: []structs.LocalRateLimitDescriptor{
+					{Header: "X-Tenant", Value: "free", RequestsPerSecond: 2},
+				},
+			},
+		})...)
+	case "chain-and-cors":
+		entries = append(entries, httpRouteToDestination(entMeta, "/api", &structs.ServiceRouteDestination{
+			Service: "db",
+			CORS: &structs.CORSPolicy{
+				AllowOrigins:       []string{"https://example.com"},
+				AllowOriginRegexes: []string{`https://.*\.example\.com`},
+				AllowMethods:       []string{"GET", "POST"},
+				AllowHeaders:       []string{"content-type", "authorization"},
+				ExposeHeaders:      []string{"x-request-id"},
+				MaxAge:             10 * time.Minute,
+				AllowCredentials:   true,
+			},
+		})...)
+	case "chain-and-jwt-routing":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "http",
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceRouterConfigEntry{
+				Kind:           structs.ServiceRouter,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				Routes: []structs.ServiceRoute{
+					{
+						Match: &structs.ServiceRouteMatch{
+							HTTP: &structs.ServiceRouteHTTPMatch{
+								PathPrefix: "/reports",
+								JWT: &structs.ServiceRouteHTTPMatchJWT{
+									Provider: "okta",
+									Claims: []structs.ServiceRouteHTTPMatchJWTClaim{
+										{Path: []string{"tier"}, Value: "premium"},
+										{Path: []string{"perms", "role"}, Value: "admin"},
+									},
+								},
+							},
+						},
+						Destination: &structs.ServiceRouteDestination{
+							Service:       "db",
+							PrefixRewrite: "/premium/reports",
+						},
+					},
+				},
+			},
+		)
 	case "splitter-overweight":
 		entries = append(entries,
 			&structs.ServiceResolverConfigEntry{
@@ -980,6 +1130,48 @@ func setupTestVariationDiscoveryChain(
 				},
 			},
 		)
+	case "chain-and-zone-aware":
+		entries = append(entries,
+			&structs.ServiceResolverConfigEntry{
+				Kind:           structs.ServiceResolver,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				PrioritizeByLocality: &structs.ServiceResolverPrioritizeByLocality{
+					Mode:           structs.PrioritizeByLocalityModeZoneAware,
+					MinClusterSize: 1,
+				},
+			},
+		)
+	case "chain-and-resolver-health-checks":
+		entries = append(entries,
+			&structs.ProxyConfigEntry{
+				Kind:           structs.ProxyDefaults,
+				Name:           structs.ProxyConfigGlobal,
+				EnterpriseMeta: entMeta,
+				Protocol:       "http",
+				Config: map[string]interface{}{
+					"protocol": "http",
+				},
+			},
+			&structs.ServiceResolverConfigEntry{
+				Kind:           structs.ServiceResolver,
+				Name:           "db",
+				EnterpriseMeta: entMeta,
+				OutlierDetection: &structs.PassiveHealthCheck{
+					Interval:    5 * time.Second,
+					MaxFailures: 3,
+				},
+				HealthChecks: []structs.ActiveHealthCheck{
+					{
+						Interval: 15 * time.Second,
+						HTTP: &structs.ActiveHealthCheckHTTP{
+							Path: "/health",
+							Host: "db.example.com",
+						},
+					},
+				},
+			},
+		)
 	case "lb-resolver":
 		entries = append(entries,
 			&structs.ProxyConfigEntry{
@@ -1116,6 +1308,33 @@ func setupTestVariationDiscoveryChain(
 	return discoverychain.TestCompileConfigEntries(t, "db", entMeta.NamespaceOrDefault(), entMeta.PartitionOrDefault(), "dc1", connect.TestClusterID+".consul", compileSetup, set)
 }
 
+// httpRouteToDestination returns the config entries for an HTTP db service
+// with a single route, matching the path prefix, to dest.
+func httpRouteToDestination(entMeta acl.EnterpriseMeta, pathPrefix string, dest *structs.ServiceRouteDestination) []structs.ConfigEntry {
+	return []structs.ConfigEntry{
+		&structs.ProxyConfigEntry{
+			Kind:           structs.ProxyDefaults,
+			Name:           structs.ProxyConfigGlobal,
+			EnterpriseMeta: entMeta,
+			Protocol:       "http",
+			Config: map[string]interface{}{
+				"protocol": "http",
+			},
+		},
+		&structs.ServiceRouterConfigEntry{
+			Kind:           structs.ServiceRouter,
+			Name:           "db",
+			EnterpriseMeta: entMeta,
+			Routes: []structs.ServiceRoute{
+				{
+					Match:       httpMatch(&structs.ServiceRouteHTTPMatch{PathPrefix: pathPrefix}),
+					Destination: dest,
+				},
+			},
+		},
+	}
+}
+
 func httpMatch(http *structs.ServiceRouteHTTPMatch) *structs.ServiceRouteMatch {
 	return &structs.ServiceRouteMatch{HTTP: http}
 }
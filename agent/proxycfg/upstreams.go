@@ -57,6 +57,22 @@ func (s *handlerUpstreams) handleUpdateUpstreams(ctx context.Context, u UpdateEv
 		}
 		upstreamsSnapshot.MeshConfigSet = true
 
+	case u.CorrelationID == acmeChallengesWatchID:
+		resp, ok := u.Result.(*structs.IndexedConfigEntries)
+		if !ok {
+			return fmt.Errorf("invalid type for response: %T", u.Result)
+		}
+
+		var challenges []structs.ACMEChallenge
+		for _, entry := range resp.Entries {
+			acmeCert, ok := entry.(*structs.ACMECertificateConfigEntry)
+			if !ok {
+				return fmt.Errorf("invalid type for config entry: %T", entry)
+			}
+			challenges = append(challenges, acmeCert.Challenges...)
+		}
+		upstreamsSnapshot.ACMEChallenges = challenges
+
 	case strings.HasPrefix(u.CorrelationID, "discovery-chain:"):
 		resp, ok := u.Result.(*structs.DiscoveryChainResponse)
 		if !ok {
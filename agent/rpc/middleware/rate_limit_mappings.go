@@ -24,6 +24,7 @@ var rpcRateLimitSpecs = map[string]rate.OperationSpec{
 	"ACL.PolicyBatchRead":   {Type: rate.OperationTypeRead, Category: rate.OperationCategoryACL},
 	"ACL.PolicyDelete":      {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryACL},
 	"ACL.PolicyList":        {Type: rate.OperationTypeRead, Category: rate.OperationCategoryACL},
+	"ACL.PolicyLint":        {Type: rate.OperationTypeRead, Category: rate.OperationCategoryACL},
 	"ACL.PolicyRead":        {Type: rate.OperationTypeRead, Category: rate.OperationCategoryACL},
 	"ACL.PolicyResolve":     {Type: rate.OperationTypeRead, Category: rate.OperationCategoryACL},
 	"ACL.PolicySet":         {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryACL},
@@ -58,6 +59,7 @@ var rpcRateLimitSpecs = map[string]rate.OperationSpec{
 	"Catalog.VirtualIPForService": {Type: rate.OperationTypeRead, Category: rate.OperationCategoryCatalog},
 
 	"ConfigEntry.Apply":                {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryConfigEntry},
+	"ConfigEntry.ApplyDryRun":          {Type: rate.OperationTypeRead, Category: rate.OperationCategoryConfigEntry},
 	"ConfigEntry.Delete":               {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryConfigEntry},
 	"ConfigEntry.Get":                  {Type: rate.OperationTypeRead, Category: rate.OperationCategoryConfigEntry},
 	"ConfigEntry.List":                 {Type: rate.OperationTypeRead, Category: rate.OperationCategoryConfigEntry},
@@ -88,11 +90,13 @@ var rpcRateLimitSpecs = map[string]rate.OperationSpec{
 	"Health.ServiceChecks": {Type: rate.OperationTypeRead, Category: rate.OperationCategoryHealth},
 	"Health.ServiceNodes":  {Type: rate.OperationTypeRead, Category: rate.OperationCategoryHealth},
 
-	"Intention.Apply": {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryIntention},
-	"Intention.Check": {Type: rate.OperationTypeRead, Category: rate.OperationCategoryIntention},
-	"Intention.Get":   {Type: rate.OperationTypeRead, Category: rate.OperationCategoryIntention},
-	"Intention.List":  {Type: rate.OperationTypeRead, Category: rate.OperationCategoryIntention},
-	"Intention.Match": {Type: rate.OperationTypeRead, Category: rate.OperationCategoryIntention},
+	"Intention.Apply":         {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryIntention},
+	"Intention.Check":         {Type: rate.OperationTypeRead, Category: rate.OperationCategoryIntention},
+	"Intention.Denials":       {Type: rate.OperationTypeRead, Category: rate.OperationCategoryIntention},
+	"Intention.Get":           {Type: rate.OperationTypeRead, Category: rate.OperationCategoryIntention},
+	"Intention.List":          {Type: rate.OperationTypeRead, Category: rate.OperationCategoryIntention},
+	"Intention.Match":         {Type: rate.OperationTypeRead, Category: rate.OperationCategoryIntention},
+	"Intention.ReportDenials": {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryIntention},
 
 	"Internal.CatalogOverview":               {Type: rate.OperationTypeRead, Category: rate.OperationCategoryInternal},
 	"Internal.EventFire":                     {Type: rate.OperationTypeWrite, Category: rate.OperationCategoryInternal},
@@ -157,6 +157,10 @@ type Backend interface {
 	// datacenter is configured to dial through mesh gateways.
 	GetDialAddresses(logger hclog.Logger, ws memdb.WatchSet, peerID string) (*ring.Ring, *ring.Ring, error)
 
+	// PeerThroughMeshGateways determines if the mesh config entry routes peering
+	// control plane traffic through the local mesh gateways.
+	PeerThroughMeshGateways(ws memdb.WatchSet) (bool, error)
+
 	EnterpriseCheckPartitions(partition string) error
 
 	EnterpriseCheckNamespaces(namespace string) error
@@ -601,6 +605,92 @@ func retryExchange(ctx context.Context, req *pbpeerstream.ExchangeSecretRequest,
 	return resp, dialErrors
 }
 
+// RotateSecrets replaces the stream secret of an established peering without
+// re-establishing it. A new pending secret is generated and handed over to the
+// dialing peer over the live stream. The previously active secret stays valid
+// until the dialer acknowledges the new secret or uses it to open a stream.
+//
+// Stream secrets are generated by the accepting side of the peering, so this
+// must be called in the cluster that generated the peering token.
+func (s *Server) RotateSecrets(ctx context.Context, req *pbpeering.RotateSecretsRequest) (*pbpeering.RotateSecretsResponse, error) {
+	if !s.Config.PeeringEnabled {
+		return nil, peeringNotEnabledErr
+	}
+
+	if err := s.Backend.EnterpriseCheckPartitions(req.Partition); err != nil {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &pbpeering.RotateSecretsResponse{}
+	handled, err := s.ForwardRPC(&writeRequest, func(conn *grpc.ClientConn) error {
+		ctx := external.ForwardMetadataContext(ctx)
+		var err error
+		resp, err = pbpeering.NewPeeringServiceClient(conn).RotateSecrets(ctx, req)
+		return err
+	})
+	if handled || err != nil {
+		return resp, err
+	}
+
+	defer metrics.MeasureSince([]string{"peering", "rotate_secrets"}, time.Now())
+
+	var authzCtx acl.AuthorizerContext
+	entMeta := structs.DefaultEnterpriseMetaInPartition(req.Partition)
+	options, err := external.QueryOptionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authz, err := s.Backend.ResolveTokenAndDefaultMeta(options.Token, entMeta, &authzCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.ToAllowAuthorizer().PeeringWriteAllowed(&authzCtx); err != nil {
+		return nil, err
+	}
+
+	q := state.Query{
+		Value:          strings.ToLower(req.Name),
+		EnterpriseMeta: *entMeta,
+	}
+	_, existing, err := s.Backend.Store().PeeringRead(nil, q)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || !existing.IsActive() {
+		return nil, grpcstatus.Errorf(codes.NotFound, "no peering with name %q", req.Name)
+	}
+	if existing.ShouldDial() {
+		return nil, grpcstatus.Errorf(codes.InvalidArgument,
+			"cannot rotate secrets of peering %q: stream secrets can only be rotated in the cluster that generated the peering token", req.Name)
+	}
+
+	secretID, err := lib.GenerateUUID(s.Backend.ValidateProposedPeeringSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate secret for peering stream: %w", err)
+	}
+
+	writeReq := &pbpeering.PeeringWriteRequest{
+		Peering: copyPeering(existing),
+		SecretsRequest: &pbpeering.SecretsWriteRequest{
+			PeerID: existing.ID,
+			Request: &pbpeering.SecretsWriteRequest_RotateSecret{
+				RotateSecret: &pbpeering.SecretsWriteRequest_RotateSecretRequest{
+					PendingStreamSecret: secretID,
+				},
+			},
+		},
+	}
+	if err := s.Backend.PeeringWrite(writeReq); err != nil {
+		if strings.Contains(err.Error(), "peering was not established") {
+			return nil, grpcstatus.Errorf(codes.FailedPrecondition, "cannot rotate secrets of peering %q: the peering was not established yet", req.Name)
+		}
+		return nil, fmt.Errorf("failed to write peering: %w", err)
+	}
+	return resp, nil
+}
+
 // PeeringRead returns the peering of the requested name and partition (enterprise only).
 // Note that for the purposes of the blocking query, changes are only observed as part of the
 // storage Index, which does not include the hydrated state from reconcilePeering, including
@@ -679,6 +769,138 @@ func (s *Server) PeeringRead(ctx context.Context, req *pbpeering.PeeringReadRequ
 	return res, nil
 }
 
+// PeeringDiagnostics returns detailed information about the replication
+// stream of a peering. Stream state is only tracked by the leader, so the
+// request is always forwarded to it.
+func (s *Server) PeeringDiagnostics(ctx context.Context, req *pbpeering.PeeringDiagnosticsRequest) (*pbpeering.PeeringDiagnosticsResponse, error) {
+	if !s.Config.PeeringEnabled {
+		return nil, peeringNotEnabledErr
+	}
+
+	if err := s.Backend.EnterpriseCheckPartitions(req.Partition); err != nil {
+		return nil, grpcstatus.Error(codes.InvalidArgument, err.Error())
+	}
+
+	options, err := external.QueryOptionsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	options.AllowStale = false
+
+	var resp *pbpeering.PeeringDiagnosticsResponse
+	handled, err := s.ForwardRPC(&readRequest{options, emptyDCSpecificRequest}, func(conn *grpc.ClientConn) error {
+		ctx := external.ForwardMetadataContext(ctx)
+		var err error
+		resp, err = pbpeering.NewPeeringServiceClient(conn).PeeringDiagnostics(ctx, req)
+		return err
+	})
+	if handled || err != nil {
+		return resp, err
+	}
+
+	defer metrics.MeasureSince([]string{"peering", "diagnostics"}, time.Now())
+
+	var authzCtx acl.AuthorizerContext
+	entMeta := structs.DefaultEnterpriseMetaInPartition(req.Partition)
+
+	authz, err := s.Backend.ResolveTokenAndDefaultMeta(options.Token, entMeta, &authzCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.ToAllowAuthorizer().PeeringReadAllowed(&authzCtx); err != nil {
+		return nil, err
+	}
+
+	q := state.Query{
+		Value:          strings.ToLower(req.Name),
+		EnterpriseMeta: *entMeta,
+	}
+	_, peering, err := s.Backend.Store().PeeringRead(nil, q)
+	if err != nil {
+		return nil, err
+	}
+	if peering == nil {
+		return &pbpeering.PeeringDiagnosticsResponse{}, nil
+	}
+
+	diag, _ := s.Tracker.StreamDiagnostics(peering.ID)
+	out := &pbpeering.PeeringDiagnostics{
+		Name:                 peering.Name,
+		ID:                   peering.ID,
+		State:                s.reconcilePeering(peering).State,
+		Connected:            diag.Connected,
+		Dialer:               peering.ShouldDial(),
+		LastHeartbeat:        pbpeering.TimePtrToProto(diag.LastRecvHeartbeat),
+		LastAck:              pbpeering.TimePtrToProto(diag.LastAck),
+		LastNack:             pbpeering.TimePtrToProto(diag.LastNack),
+		LastNackMessage:      diag.LastNackMessage,
+		LastSendError:        pbpeering.TimePtrToProto(diag.LastSendError),
+		LastSendErrorMessage: diag.LastSendErrorMessage,
+		LastRecvError:        pbpeering.TimePtrToProto(diag.LastRecvError),
+		LastRecvErrorMessage: diag.LastRecvErrorMessage,
+	}
+	for _, r := range diag.Resources {
+		out.Resources = append(out.Resources, &pbpeering.ResourceReplicationStatus{
+			ResourceURL:     r.ResourceURL,
+			ResourceID:      r.ResourceID,
+			LastSent:        pbpeering.TimePtrToProto(r.LastSent),
+			LastSentNonce:   r.LastSentNonce,
+			LastAck:         pbpeering.TimePtrToProto(r.LastAck),
+			LastNack:        pbpeering.TimePtrToProto(r.LastNack),
+			LastNackMessage: r.LastNackMessage,
+		})
+	}
+	for _, e := range diag.StreamEvents {
+		out.StreamEvents = append(out.StreamEvents, &pbpeering.StreamEvent{
+			Time:         timestamppb.New(e.Time),
+			Connected:    e.Connected,
+			ErrorMessage: e.ErrorMessage,
+		})
+	}
+	if out.Dialer {
+		out.Dial = s.dialDiagnostics(peering, diag)
+	}
+
+	return &pbpeering.PeeringDiagnosticsResponse{Diagnostics: out}, nil
+}
+
+// dialDiagnostics resolves the addresses the leader cycles through when
+// dialing the peer, and whether the last dialed address is a local mesh gateway.
+func (s *Server) dialDiagnostics(peering *pbpeering.Peering, diag peerstream.Diagnostics) *pbpeering.PeeringDialDiagnostics {
+	out := &pbpeering.PeeringDialDiagnostics{
+		ServerName:      peering.PeerServerName,
+		ServerAddresses: peering.GetAddressesToDial(),
+		LastDialAddress: diag.LastDialAddress,
+		LastDialTime:    pbpeering.TimePtrToProto(diag.LastDialTime),
+	}
+
+	useGateways, err := s.Backend.PeerThroughMeshGateways(nil)
+	if err != nil {
+		out.Error = err.Error()
+		return out
+	}
+	out.PeerThroughMeshGateways = useGateways
+
+	_, gatewayAddrs, err := s.Backend.GetDialAddresses(s.Logger, nil, peering.ID)
+	if err != nil {
+		out.Error = err.Error()
+		return out
+	}
+	if useGateways && gatewayAddrs == nil {
+		out.Error = "peering through mesh gateways is enabled but no local mesh gateway addresses could be resolved, dialing the peer's servers directly"
+	}
+	if gatewayAddrs != nil {
+		gatewayAddrs.Do(func(addr any) {
+			out.GatewayAddresses = append(out.GatewayAddresses, addr.(string))
+			if addr.(string) == diag.LastDialAddress {
+				out.ViaMeshGateway = true
+			}
+		})
+	}
+	return out
+}
+
 // PeeringList returns the list of peerings in the requested partition(s) (enterprise only).
 // Note that for the purposes of the blocking query, changes are only observed as part of the
 // storage Index, which does not include the hydrated state from reconcilePeering, including
@@ -1059,6 +1059,141 @@ func TestPeeringService_Delete_ACLEnforcement(t *testing.T) {
 
 }
 
+func TestPeeringService_RotateSecrets(t *testing.T) {
+	// TODO(peering): see note on newTestServer, refactor to not use this
+	s := newTestServer(t, nil)
+	store := s.Server.FSM().State()
+
+	var (
+		acceptorID   = testUUID(t)
+		dialerID     = testUUID(t)
+		pendingID    = testUUID(t)
+		activeSecret = testUUID(t)
+	)
+
+	// Write a peering that was established with this cluster as the acceptor.
+	establishmentSecret := testUUID(t)
+	acceptor := &pbpeering.Peering{
+		ID:   acceptorID,
+		Name: "acceptor",
+	}
+	for idx, req := range []*pbpeering.SecretsWriteRequest{
+		{
+			PeerID: acceptorID,
+			Request: &pbpeering.SecretsWriteRequest_GenerateToken{
+				GenerateToken: &pbpeering.SecretsWriteRequest_GenerateTokenRequest{
+					EstablishmentSecret: establishmentSecret,
+				},
+			},
+		},
+		{
+			PeerID: acceptorID,
+			Request: &pbpeering.SecretsWriteRequest_ExchangeSecret{
+				ExchangeSecret: &pbpeering.SecretsWriteRequest_ExchangeSecretRequest{
+					EstablishmentSecret: establishmentSecret,
+					PendingStreamSecret: activeSecret,
+				},
+			},
+		},
+		{
+			PeerID: acceptorID,
+			Request: &pbpeering.SecretsWriteRequest_PromotePending{
+				PromotePending: &pbpeering.SecretsWriteRequest_PromotePendingRequest{
+					ActiveStreamSecret: activeSecret,
+				},
+			},
+		},
+	} {
+		require.NoError(t, store.PeeringWrite(uint64(10+idx), &pbpeering.PeeringWriteRequest{
+			Peering:        acceptor,
+			SecretsRequest: req,
+		}))
+	}
+
+	// Write a peering that was established with this cluster as the dialer.
+	require.NoError(t, store.PeeringWrite(20, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:                  dialerID,
+			Name:                "dialer",
+			PeerServerAddresses: []string{"127.0.0.1:5300"},
+		},
+	}))
+
+	// Write a peering whose token was generated but never used.
+	require.NoError(t, store.PeeringWrite(21, &pbpeering.PeeringWriteRequest{
+		Peering: &pbpeering.Peering{
+			ID:   pendingID,
+			Name: "pending",
+		},
+		SecretsRequest: &pbpeering.SecretsWriteRequest{
+			PeerID: pendingID,
+			Request: &pbpeering.SecretsWriteRequest_GenerateToken{
+				GenerateToken: &pbpeering.SecretsWriteRequest_GenerateTokenRequest{
+					EstablishmentSecret: testUUID(t),
+				},
+			},
+		},
+	}))
+
+	client := pbpeering.NewPeeringServiceClient(s.ClientConn(t))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	requireErrCode := func(t *testing.T, err error, code codes.Code, msg string) {
+		t.Helper()
+
+		grpcErr, ok := grpcstatus.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, code, grpcErr.Code())
+		require.Contains(t, grpcErr.Message(), msg)
+	}
+
+	t.Run("unknown peering", func(t *testing.T) {
+		_, err := client.RotateSecrets(ctx, &pbpeering.RotateSecretsRequest{Name: "unknown"})
+		requireErrCode(t, err, codes.NotFound, `no peering with name "unknown"`)
+	})
+
+	t.Run("dialing peering", func(t *testing.T) {
+		_, err := client.RotateSecrets(ctx, &pbpeering.RotateSecretsRequest{Name: "dialer"})
+		requireErrCode(t, err, codes.InvalidArgument, "cluster that generated the peering token")
+	})
+
+	t.Run("peering not established", func(t *testing.T) {
+		_, err := client.RotateSecrets(ctx, &pbpeering.RotateSecretsRequest{Name: "pending"})
+		requireErrCode(t, err, codes.FailedPrecondition, "peering was not established")
+	})
+
+	var firstPending string
+
+	t.Run("rotate secret", func(t *testing.T) {
+		_, err := client.RotateSecrets(ctx, &pbpeering.RotateSecretsRequest{Name: "acceptor"})
+		require.NoError(t, err)
+
+		secrets, err := store.PeeringSecretsRead(nil, acceptorID)
+		require.NoError(t, err)
+		require.NotNil(t, secrets)
+
+		// The active secret must remain valid until the dialer acknowledges the new one.
+		require.Equal(t, activeSecret, secrets.GetStream().GetActiveSecretID())
+		require.NotEmpty(t, secrets.GetStream().GetPendingSecretID())
+		require.NotEqual(t, activeSecret, secrets.GetStream().GetPendingSecretID())
+
+		firstPending = secrets.GetStream().GetPendingSecretID()
+	})
+
+	t.Run("rotate again replaces pending secret", func(t *testing.T) {
+		_, err := client.RotateSecrets(ctx, &pbpeering.RotateSecretsRequest{Name: "acceptor"})
+		require.NoError(t, err)
+
+		secrets, err := store.PeeringSecretsRead(nil, acceptorID)
+		require.NoError(t, err)
+		require.Equal(t, activeSecret, secrets.GetStream().GetActiveSecretID())
+		require.NotEmpty(t, secrets.GetStream().GetPendingSecretID())
+		require.NotEqual(t, firstPending, secrets.GetStream().GetPendingSecretID())
+	})
+}
+
 func TestPeeringService_List(t *testing.T) {
 	// TODO(peering): see note on newTestServer, refactor to not use this
 	s := newTestServer(t, nil)
@@ -1636,6 +1771,11 @@ func TestPeeringService_PeeringDisabled(t *testing.T) {
 		assertFailedResponse(t, err)
 	})
 
+	t.Run("RotateSecrets", func(t *testing.T) {
+		_, err := client.RotateSecrets(ctx, &pbpeering.RotateSecretsRequest{})
+		assertFailedResponse(t, err)
+	})
+
 	t.Run("Establish", func(t *testing.T) {
 		_, err := client.Establish(ctx, &pbpeering.EstablishRequest{})
 		assertFailedResponse(t, err)
@@ -1558,6 +1558,25 @@ type ACLPolicyBatchResponse struct {
 	QueryMeta
 }
 
+// ACLPolicyLintRequest is used at the RPC layer to lint policy rules without
+// persisting them
+type ACLPolicyLintRequest struct {
+	Rules      string // The policy rules to lint
+	Datacenter string // The datacenter to perform the request within
+	acl.EnterpriseMeta
+	QueryOptions
+}
+
+func (r *ACLPolicyLintRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// ACLPolicyLintResponse returns the findings for linted policy rules
+type ACLPolicyLintResponse struct {
+	Findings []acl.PolicyLintFinding
+	QueryMeta
+}
+
 // ACLPolicyBatchSetRequest is used at the Raft layer for batching
 // multiple policy creations and updates
 //
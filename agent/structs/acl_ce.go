@@ -74,6 +74,10 @@ func (p *ACLPolicy) EnterprisePolicyMeta() *acl.EnterprisePolicyMeta {
 	return nil
 }
 
+func (r *ACLPolicyLintRequest) EnterprisePolicyMeta() *acl.EnterprisePolicyMeta {
+	return nil
+}
+
 func (t *ACLToken) NodeIdentityList() []*ACLNodeIdentity {
 	if len(t.NodeIdentities) == 0 {
 		return nil
@@ -36,13 +36,17 @@ const (
 	ServiceIntentions     string = "service-intentions"
 	MeshConfig            string = "mesh"
 	ExportedServices      string = "exported-services"
+	ImportedServices      string = "imported-services"
 	SamenessGroup         string = "sameness-group"
 	APIGateway            string = "api-gateway"
 	BoundAPIGateway       string = "bound-api-gateway"
 	FileSystemCertificate string = "file-system-certificate"
 	InlineCertificate     string = "inline-certificate"
+	ACMECertificate       string = "acme-certificate"
 	HTTPRoute             string = "http-route"
+	GRPCRoute             string = "grpc-route"
 	TCPRoute              string = "tcp-route"
+	TLSRoute              string = "tls-route"
 	// TODO: decide if we want to highlight 'ip' keyword in the name of RateLimitIPConfig
 	RateLimitIPConfig string = "control-plane-request-limit"
 	JWTProvider       string = "jwt-provider"
@@ -66,13 +70,17 @@ var AllConfigEntryKinds = []string{
 	ServiceIntentions,
 	MeshConfig,
 	ExportedServices,
+	ImportedServices,
 	SamenessGroup,
 	APIGateway,
 	BoundAPIGateway,
 	HTTPRoute,
+	GRPCRoute,
 	TCPRoute,
+	TLSRoute,
 	FileSystemCertificate,
 	InlineCertificate,
+	ACMECertificate,
 	RateLimitIPConfig,
 	JWTProvider,
 }
@@ -177,6 +185,8 @@ type ServiceConfigEntry struct {
 	LocalRequestTimeoutMs     int                    `json:",omitempty" alias:"local_request_timeout_ms"`
 	BalanceInboundConnections string                 `json:",omitempty" alias:"balance_inbound_connections"`
 	RateLimits                *RateLimits            `json:",omitempty" alias:"rate_limits"`
+	FaultInjection            *FaultInjection        `json:",omitempty" alias:"fault_injection"`
+	RateLimit                 *LocalRateLimit        `json:",omitempty" alias:"rate_limit"`
 	EnvoyExtensions           EnvoyExtensions        `json:",omitempty" alias:"envoy_extensions"`
 
 	Meta               map[string]string `json:",omitempty"`
@@ -322,6 +332,24 @@ func (e *ServiceConfigEntry) Validate() error {
 		validationErr = multierror.Append(validationErr, err)
 	}
 
+	if e.RateLimit != nil {
+		if e.Protocol != "" && !IsProtocolHTTPLike(e.Protocol) {
+			validationErr = multierror.Append(validationErr, fmt.Errorf("RateLimit requires an HTTP-based protocol, not %q", e.Protocol))
+		}
+		if err := e.RateLimit.Validate(); err != nil {
+			validationErr = multierror.Append(validationErr, fmt.Errorf("RateLimit %s", err))
+		}
+	}
+
+	if e.FaultInjection != nil {
+		if e.Protocol != "" && !IsProtocolHTTPLike(e.Protocol) {
+			validationErr = multierror.Append(validationErr, fmt.Errorf("FaultInjection requires an HTTP-based protocol, not %q", e.Protocol))
+		}
+		if err := e.FaultInjection.Validate(); err != nil {
+			validationErr = multierror.Append(validationErr, fmt.Errorf("FaultInjection %s", err))
+		}
+	}
+
 	if err := envoyextensions.ValidateExtensions(e.EnvoyExtensions.ToAPI()); err != nil {
 		validationErr = multierror.Append(validationErr, err)
 	}
@@ -465,6 +493,110 @@ type InstanceLevelRouteRateLimits struct {
 	RequestsMaxBurst  int `alias:"requests_max_burst"`
 }
 
+// LocalRateLimit configures request rate limiting that each proxy enforces
+// on its own, without coordinating with other proxies. Requests over the
+// limit are rejected with a 429 status.
+type LocalRateLimit struct {
+	// RequestsPerSecond is the average number of requests per second allowed
+	// by each proxy. This field is required.
+	RequestsPerSecond uint32 `json:",omitempty" alias:"requests_per_second"`
+
+	// RequestsMaxBurst is the maximum number of requests that can be sent in
+	// a burst. Must be equal to or greater than RequestsPerSecond. If unset,
+	// defaults to RequestsPerSecond.
+	RequestsMaxBurst uint32 `json:",omitempty" alias:"requests_max_burst"`
+
+	// Routes is a list of limits applied to requests matching a path instead
+	// of the top-level limit. The first matching route applies.
+	Routes []LocalRateLimitRoute `json:",omitempty"`
+
+	// Descriptors is a list of limits applied to requests carrying a header
+	// with a given value instead of the top-level limit.
+	Descriptors []LocalRateLimitDescriptor `json:",omitempty"`
+}
+
+// LocalRateLimitRoute is a rate limit applied to requests whose path matches
+// one of PathExact, PathPrefix or PathRegex.
+type LocalRateLimitRoute struct {
+	PathExact  string `json:",omitempty" alias:"path_exact"`
+	PathPrefix string `json:",omitempty" alias:"path_prefix"`
+	PathRegex  string `json:",omitempty" alias:"path_regex"`
+
+	RequestsPerSecond uint32 `json:",omitempty" alias:"requests_per_second"`
+	RequestsMaxBurst  uint32 `json:",omitempty" alias:"requests_max_burst"`
+}
+
+// LocalRateLimitDescriptor is a rate limit applied to requests where the
+// value of Header equals Value.
+type LocalRateLimitDescriptor struct {
+	Header string
+	Value  string
+
+	RequestsPerSecond uint32 `json:",omitempty" alias:"requests_per_second"`
+	RequestsMaxBurst  uint32 `json:",omitempty" alias:"requests_max_burst"`
+}
+
+// Validate checks that the rate limit configuration is well formed.
+func (l *LocalRateLimit) Validate() error {
+	if err := validateLocalRateLimitBucket(l.RequestsPerSecond, l.RequestsMaxBurst); err != nil {
+		return err
+	}
+
+	for i, route := range l.Routes {
+		pathParts := 0
+		if route.PathExact != "" {
+			pathParts++
+			if !strings.HasPrefix(route.PathExact, "/") {
+				return fmt.Errorf("Routes[%d] PathExact doesn't start with '/': %q", i, route.PathExact)
+			}
+		}
+		if route.PathPrefix != "" {
+			pathParts++
+			if !strings.HasPrefix(route.PathPrefix, "/") {
+				return fmt.Errorf("Routes[%d] PathPrefix doesn't start with '/': %q", i, route.PathPrefix)
+			}
+		}
+		if route.PathRegex != "" {
+			pathParts++
+		}
+		if pathParts != 1 {
+			return fmt.Errorf("Routes[%d] should contain exactly one of PathExact, PathPrefix, or PathRegex", i)
+		}
+		if err := validateLocalRateLimitBucket(route.RequestsPerSecond, route.RequestsMaxBurst); err != nil {
+			return fmt.Errorf("Routes[%d] %s", i, err)
+		}
+	}
+
+	seen := make(map[LocalRateLimitDescriptor]struct{}, len(l.Descriptors))
+	for i, desc := range l.Descriptors {
+		if desc.Header == "" {
+			return fmt.Errorf("Descriptors[%d] missing required Header field", i)
+		}
+		if desc.Value == "" {
+			return fmt.Errorf("Descriptors[%d] missing required Value field", i)
+		}
+		key := LocalRateLimitDescriptor{Header: strings.ToLower(desc.Header), Value: desc.Value}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("Descriptors[%d] duplicates the limit for header %q with value %q", i, desc.Header, desc.Value)
+		}
+		seen[key] = struct{}{}
+		if err := validateLocalRateLimitBucket(desc.RequestsPerSecond, desc.RequestsMaxBurst); err != nil {
+			return fmt.Errorf("Descriptors[%d] %s", i, err)
+		}
+	}
+	return nil
+}
+
+func validateLocalRateLimitBucket(perSecond, maxBurst uint32) error {
+	if perSecond == 0 {
+		return fmt.Errorf("RequestsPerSecond must be greater than 0")
+	}
+	if maxBurst != 0 && maxBurst < perSecond {
+		return fmt.Errorf("RequestsMaxBurst must be equal to or greater than RequestsPerSecond")
+	}
+	return nil
+}
+
 // ProxyConfigEntry is the top-level struct for global proxy configuration defaults.
 type ProxyConfigEntry struct {
 	Kind                 string
@@ -833,6 +965,8 @@ func MakeConfigEntry(kind, name string) (ConfigEntry, error) {
 		return &MeshConfigEntry{}, nil
 	case ExportedServices:
 		return &ExportedServicesConfigEntry{Name: name}, nil
+	case ImportedServices:
+		return &ImportedServicesConfigEntry{Name: name}, nil
 	case SamenessGroup:
 		return &SamenessGroupConfigEntry{Name: name}, nil
 	case APIGateway:
@@ -843,10 +977,16 @@ func MakeConfigEntry(kind, name string) (ConfigEntry, error) {
 		return &FileSystemCertificateConfigEntry{Name: name}, nil
 	case InlineCertificate:
 		return &InlineCertificateConfigEntry{Name: name}, nil
+	case ACMECertificate:
+		return &ACMECertificateConfigEntry{Name: name}, nil
 	case HTTPRoute:
 		return &HTTPRouteConfigEntry{Name: name}, nil
+	case GRPCRoute:
+		return &GRPCRouteConfigEntry{Name: name}, nil
 	case TCPRoute:
 		return &TCPRouteConfigEntry{Name: name}, nil
+	case TLSRoute:
+		return &TLSRouteConfigEntry{Name: name}, nil
 	case JWTProvider:
 		return &JWTProviderConfigEntry{Name: name}, nil
 	default:
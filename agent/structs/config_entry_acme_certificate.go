@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/lib"
+)
+
+const (
+	// DefaultACMEDirectoryURL is the ACME directory used when an
+	// acme-certificate config entry doesn't specify one.
+	DefaultACMEDirectoryURL = "https://acme-v02.api.letsencrypt.org/directory"
+
+	// DefaultACMERenewBefore is how long before expiry a certificate is
+	// renewed when an acme-certificate config entry doesn't specify it.
+	DefaultACMERenewBefore = 30 * 24 * time.Hour
+
+	// ACMECertificateMetaKey is set on the inline-certificate config entries
+	// managed by the ACME controller to the name of the acme-certificate
+	// config entry they were issued for.
+	ACMECertificateMetaKey = "consul-acme-certificate"
+
+	// ACMEChallengePathPrefix is the path under which gateways serve the
+	// responses to pending HTTP-01 challenges.
+	ACMEChallengePathPrefix = "/.well-known/acme-challenge/"
+)
+
+// ACMECertificateConfigEntry manages a certificate that is obtained and
+// renewed from an ACME certificate authority by a leader controller. The
+// certificate is stored in an inline-certificate config entry of the same
+// name, so gateways reference it like any other inline certificate.
+type ACMECertificateConfigEntry struct {
+	// Kind of config entry. This will be set to structs.ACMECertificate.
+	Kind string
+
+	// Name is used to match the config entry with the inline-certificate
+	// the issued certificate is stored in.
+	Name string
+
+	// DirectoryURL is the URL of the ACME directory of the certificate
+	// authority. Defaults to the Let's Encrypt production directory.
+	DirectoryURL string `json:",omitempty" alias:"directory_url"`
+
+	// Email is the contact address registered with the ACME account.
+	Email string `json:",omitempty"`
+
+	// Hostnames are the DNS names the certificate is issued for. Each
+	// hostname must route to a gateway that serves HTTP on port 80 so that
+	// HTTP-01 challenges can be validated.
+	Hostnames []string
+
+	// RenewBefore is how long before the certificate expires it is renewed.
+	RenewBefore time.Duration `json:",omitempty" alias:"renew_before"`
+
+	// Challenges are the pending HTTP-01 challenges that gateways respond
+	// to. They are managed by the ACME controller.
+	Challenges []ACMEChallenge `json:",omitempty"`
+
+	// Status is the asynchronous status which an acme-certificate propagates
+	// to the user.
+	Status Status
+
+	Meta               map[string]string `json:",omitempty"`
+	Hash               uint64            `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+// ACMEChallenge is a pending HTTP-01 challenge. Gateways respond to requests
+// for ACMEChallengePathPrefix followed by the Token with the KeyAuthorization.
+type ACMEChallenge struct {
+	Token            string
+	KeyAuthorization string
+}
+
+func (e *ACMECertificateConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *ACMECertificateConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *ACMECertificateConfigEntry) GetKind() string            { return ACMECertificate }
+func (e *ACMECertificateConfigEntry) GetName() string            { return e.Name }
+func (e *ACMECertificateConfigEntry) GetMeta() map[string]string { return e.Meta }
+func (e *ACMECertificateConfigEntry) GetRaftIndex() *RaftIndex   { return &e.RaftIndex }
+func (e *ACMECertificateConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta {
+	return &e.EnterpriseMeta
+}
+
+var _ ControlledConfigEntry = (*ACMECertificateConfigEntry)(nil)
+
+func (e *ACMECertificateConfigEntry) GetStatus() Status       { return e.Status }
+func (e *ACMECertificateConfigEntry) SetStatus(status Status) { e.Status = status }
+func (e *ACMECertificateConfigEntry) DefaultStatus() Status {
+	return Status{
+		Conditions: []Condition{
+			NewACMECertificateCondition(
+				api.ACMECertificateConditionIssued,
+				api.ConditionStatusFalse,
+				api.ACMECertificateReasonPending,
+				"certificate has not been issued yet",
+			),
+		},
+	}
+}
+
+func (e *ACMECertificateConfigEntry) Normalize() error {
+	if e.DirectoryURL == "" {
+		e.DirectoryURL = DefaultACMEDirectoryURL
+	}
+	if e.RenewBefore == 0 {
+		e.RenewBefore = DefaultACMERenewBefore
+	}
+	for i, hostname := range e.Hostnames {
+		e.Hostnames[i] = strings.ToLower(hostname)
+	}
+	e.EnterpriseMeta.Normalize()
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+	return nil
+}
+
+func (e *ACMECertificateConfigEntry) Validate() error {
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	u, err := url.Parse(e.DirectoryURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("DirectoryURL %q must be an absolute http or https URL", e.DirectoryURL)
+	}
+
+	if e.Email != "" {
+		if _, err := mail.ParseAddress(e.Email); err != nil {
+			return fmt.Errorf("Email %q is not a valid email address", e.Email)
+		}
+	}
+
+	if len(e.Hostnames) == 0 {
+		return errors.New("at least one hostname must be specified")
+	}
+
+	seen := make(map[string]struct{}, len(e.Hostnames))
+	for _, hostname := range e.Hostnames {
+		if _, ok := dns.IsDomainName(hostname); !ok {
+			return fmt.Errorf("hostname %q must be a valid DNS hostname", hostname)
+		}
+		if strings.ContainsRune(hostname, '*') {
+			return fmt.Errorf("hostname %q must not be a wildcard, HTTP-01 challenges cannot validate wildcard hostnames", hostname)
+		}
+		if _, ok := seen[hostname]; ok {
+			return fmt.Errorf("hostname %q is specified more than once", hostname)
+		}
+		seen[hostname] = struct{}{}
+	}
+
+	if e.RenewBefore < 0 {
+		return errors.New("RenewBefore must not be negative")
+	}
+
+	return nil
+}
+
+func (e *ACMECertificateConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *ACMECertificateConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+func (e *ACMECertificateConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias ACMECertificateConfigEntry
+	exported := &struct {
+		RenewBefore string `json:",omitempty"`
+		*Alias
+	}{
+		Alias: (*Alias)(e),
+	}
+	if e.RenewBefore != 0 {
+		exported.RenewBefore = e.RenewBefore.String()
+	}
+
+	return json.Marshal(exported)
+}
+
+func (e *ACMECertificateConfigEntry) UnmarshalJSON(data []byte) error {
+	type Alias ACMECertificateConfigEntry
+	aux := &struct {
+		RenewBefore string
+		*Alias
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.RenewBefore != "" {
+		if e.RenewBefore, err = time.ParseDuration(aux.RenewBefore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewACMECertificateCondition is a helper to build allowable Conditions for
+// an acme-certificate config entry.
+func NewACMECertificateCondition(name api.ACMECertificateConditionType, status api.ConditionStatus, reason api.ACMECertificateConditionReason, message string) Condition {
+	if err := api.ValidateACMECertificateConditionReason(name, status, reason); err != nil {
+		// note we panic here because an invalid combination is a programmer error
+		// this  should never actually be hit
+		panic(err)
+	}
+
+	return Condition{
+		Type:               string(name),
+		Status:             string(status),
+		Reason:             string(reason),
+		Message:            message,
+		LastTransitionTime: ptrTo(time.Now().UTC()),
+	}
+}
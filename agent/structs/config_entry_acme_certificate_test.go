@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+func TestACMECertificate(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]configEntryTestcase{
+		"defaults": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:      ACMECertificate,
+				Name:      "cert",
+				Hostnames: []string{"Example.COM"},
+			},
+			expected: &ACMECertificateConfigEntry{
+				Kind:           ACMECertificate,
+				Name:           "cert",
+				DirectoryURL:   DefaultACMEDirectoryURL,
+				Hostnames:      []string{"example.com"},
+				RenewBefore:    DefaultACMERenewBefore,
+				EnterpriseMeta: *acl.DefaultEnterpriseMeta(),
+			},
+		},
+		"valid": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:         ACMECertificate,
+				Name:         "cert",
+				DirectoryURL: "https://acme.example.com/directory",
+				Email:        "admin@example.com",
+				Hostnames:    []string{"example.com", "www.example.com"},
+				RenewBefore:  7 * 24 * time.Hour,
+			},
+		},
+		"invalid directory url": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:         ACMECertificate,
+				Name:         "cert",
+				DirectoryURL: "acme.example.com/directory",
+				Hostnames:    []string{"example.com"},
+			},
+			validateErr: "must be an absolute http or https URL",
+		},
+		"invalid email": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:      ACMECertificate,
+				Name:      "cert",
+				Email:     "not an email",
+				Hostnames: []string{"example.com"},
+			},
+			validateErr: "is not a valid email address",
+		},
+		"no hostnames": {
+			entry: &ACMECertificateConfigEntry{
+				Kind: ACMECertificate,
+				Name: "cert",
+			},
+			validateErr: "at least one hostname must be specified",
+		},
+		"wildcard hostname": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:      ACMECertificate,
+				Name:      "cert",
+				Hostnames: []string{"*.example.com"},
+			},
+			validateErr: "must not be a wildcard",
+		},
+		"duplicate hostname": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:      ACMECertificate,
+				Name:      "cert",
+				Hostnames: []string{"example.com", "EXAMPLE.com"},
+			},
+			validateErr: "is specified more than once",
+		},
+		"negative renew before": {
+			entry: &ACMECertificateConfigEntry{
+				Kind:        ACMECertificate,
+				Name:        "cert",
+				Hostnames:   []string{"example.com"},
+				RenewBefore: -time.Hour,
+			},
+			validateErr: "RenewBefore must not be negative",
+		},
+	}
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
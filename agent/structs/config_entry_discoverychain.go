@@ -185,27 +185,8 @@ func (e *ServiceRouterConfigEntry) Validate() error {
 			}
 
 			for j, hdr := range route.Match.HTTP.Header {
-				if hdr.Name == "" {
-					return fmt.Errorf("Route[%d] Header[%d] missing required Name field", i, j)
-				}
-				hdrParts := 0
-				if hdr.Present {
-					hdrParts++
-				}
-				if hdr.Exact != "" {
-					hdrParts++
-				}
-				if hdr.Regex != "" {
-					hdrParts++
-				}
-				if hdr.Prefix != "" {
-					hdrParts++
-				}
-				if hdr.Suffix != "" {
-					hdrParts++
-				}
-				if hdrParts != 1 {
-					return fmt.Errorf("Route[%d] Header[%d] should only contain one of Present, Exact, Prefix, Suffix, or Regex", i, j)
+				if err := hdr.validate(); err != nil {
+					return fmt.Errorf("Route[%d] Header[%d] %s", i, j, err)
 				}
 			}
 
@@ -241,6 +222,12 @@ func (e *ServiceRouterConfigEntry) Validate() error {
 					found[m] = struct{}{}
 				}
 			}
+
+			if route.Match.HTTP.JWT != nil {
+				if err := route.Match.HTTP.JWT.validate(); err != nil {
+					return fmt.Errorf("Route[%d] JWT %s", i, err)
+				}
+			}
 		}
 
 		if route.Destination != nil {
@@ -253,6 +240,49 @@ func (e *ServiceRouterConfigEntry) Validate() error {
 					return fmt.Errorf("Route[%d] contains an invalid retry condition: %q", i, r)
 				}
 			}
+
+			for j, mirror := range route.Destination.Mirror {
+				if err := mirror.validate(); err != nil {
+					return fmt.Errorf("Route[%d] Mirror[%d] %s", i, j, err)
+				}
+			}
+
+			if route.Destination.FaultInjection != nil {
+				if err := route.Destination.FaultInjection.Validate(); err != nil {
+					return fmt.Errorf("Route[%d] FaultInjection %s", i, err)
+				}
+			}
+
+			if route.Destination.RateLimit != nil {
+				if len(route.Destination.RateLimit.Routes) > 0 {
+					return fmt.Errorf("Route[%d] RateLimit cannot contain Routes", i)
+				}
+				if err := route.Destination.RateLimit.Validate(); err != nil {
+					return fmt.Errorf("Route[%d] RateLimit %s", i, err)
+				}
+			}
+
+			if route.Destination.CORS != nil {
+				if err := route.Destination.CORS.Validate(); err != nil {
+					return fmt.Errorf("Route[%d] CORS %s", i, err)
+				}
+			}
+
+			if route.Destination.RequestRedirect != nil && route.Destination.DirectResponse != nil {
+				return fmt.Errorf("Route[%d] cannot specify both RequestRedirect and DirectResponse", i)
+			}
+
+			if route.Destination.RequestRedirect != nil {
+				if err := route.Destination.RequestRedirect.Validate(); err != nil {
+					return fmt.Errorf("Route[%d] RequestRedirect %s", i, err)
+				}
+			}
+
+			if route.Destination.DirectResponse != nil {
+				if err := route.Destination.DirectResponse.Validate(); err != nil {
+					return fmt.Errorf("Route[%d] DirectResponse %s", i, err)
+				}
+			}
 		}
 	}
 
@@ -325,6 +355,7 @@ func (e *ServiceRouterConfigEntry) ListRelatedServices() []ServiceID {
 			if destID != svcID {
 				found[destID] = struct{}{}
 			}
+			addMirroredServices(found, svcID, route.Destination.Mirror, &e.EnterpriseMeta)
 		}
 	}
 
@@ -380,6 +411,10 @@ type ServiceRouteHTTPMatch struct {
 	Header     []ServiceRouteHTTPMatchHeader     `json:",omitempty"`
 	QueryParam []ServiceRouteHTTPMatchQueryParam `json:",omitempty" alias:"query_param"`
 	Methods    []string                          `json:",omitempty"`
+
+	// JWT matches on the claims of a JSON Web Token verified by a
+	// jwt-provider config entry.
+	JWT *ServiceRouteHTTPMatchJWT `json:",omitempty"`
 }
 
 func (m *ServiceRouteHTTPMatch) IsEmpty() bool {
@@ -389,7 +424,8 @@ func (m *ServiceRouteHTTPMatch) IsEmpty() bool {
 		!m.CaseInsensitive &&
 		len(m.Header) == 0 &&
 		len(m.QueryParam) == 0 &&
-		len(m.Methods) == 0
+		len(m.Methods) == 0 &&
+		m.JWT == nil
 }
 
 type ServiceRouteHTTPMatchHeader struct {
@@ -402,6 +438,32 @@ type ServiceRouteHTTPMatchHeader struct {
 	Invert  bool   `json:",omitempty"`
 }
 
+func (h *ServiceRouteHTTPMatchHeader) validate() error {
+	if h.Name == "" {
+		return fmt.Errorf("missing required Name field")
+	}
+	hdrParts := 0
+	if h.Present {
+		hdrParts++
+	}
+	if h.Exact != "" {
+		hdrParts++
+	}
+	if h.Regex != "" {
+		hdrParts++
+	}
+	if h.Prefix != "" {
+		hdrParts++
+	}
+	if h.Suffix != "" {
+		hdrParts++
+	}
+	if hdrParts != 1 {
+		return fmt.Errorf("should only contain one of Present, Exact, Prefix, Suffix, or Regex")
+	}
+	return nil
+}
+
 type ServiceRouteHTTPMatchQueryParam struct {
 	Name    string
 	Present bool   `json:",omitempty"`
@@ -409,6 +471,50 @@ type ServiceRouteHTTPMatchQueryParam struct {
 	Regex   string `json:",omitempty"`
 }
 
+// ServiceRouteHTTPMatchJWT matches requests carrying a JSON Web Token that
+// was verified by the given provider and contains all of the given claims.
+type ServiceRouteHTTPMatchJWT struct {
+	// Provider is the name of the jwt-provider config entry used to verify
+	// the token. There MUST be a corresponding "jwt-provider" config entry
+	// with this name.
+	Provider string
+
+	// Claims is the list of claims that must all be present in the token.
+	Claims []ServiceRouteHTTPMatchJWTClaim `json:",omitempty"`
+}
+
+func (j *ServiceRouteHTTPMatchJWT) validate() error {
+	if j.Provider == "" {
+		return fmt.Errorf("missing required Provider field")
+	}
+	if len(j.Claims) == 0 {
+		return fmt.Errorf("must contain at least one claim")
+	}
+	for i, c := range j.Claims {
+		if len(c.Path) == 0 {
+			return fmt.Errorf("Claims[%d] missing required Path field", i)
+		}
+		for _, segment := range c.Path {
+			if segment == "" {
+				return fmt.Errorf("Claims[%d] Path cannot contain empty segments", i)
+			}
+		}
+		if c.Value == "" {
+			return fmt.Errorf("Claims[%d] missing required Value field", i)
+		}
+	}
+	return nil
+}
+
+// ServiceRouteHTTPMatchJWTClaim matches a single claim of a verified token.
+type ServiceRouteHTTPMatchJWTClaim struct {
+	// Path is the path to the claim in the token JSON.
+	Path []string
+
+	// Value is the expected value of the claim at the given path.
+	Value string
+}
+
 // ServiceRouteDestination describes how to proxy the actual matching request
 // to a service.
 type ServiceRouteDestination struct {
@@ -471,6 +577,35 @@ type ServiceRouteDestination struct {
 	// Allow HTTP header manipulation to be configured.
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+
+	// Mirror is a list of additional targets that should receive a copy of
+	// the requests matching this route. Responses from mirrored targets are
+	// discarded.
+	Mirror []ServiceRouteMirror `json:",omitempty"`
+
+	// FaultInjection configures delays and aborts to inject into requests
+	// matching this route.
+	FaultInjection *FaultInjection `json:",omitempty" alias:"fault_injection"`
+
+	// RateLimit limits the rate of requests matching this route. Routes
+	// cannot be set since the limit already applies to a single route.
+	RateLimit *LocalRateLimit `json:",omitempty" alias:"rate_limit"`
+
+	// CORS configures the cross-origin resource sharing policy for requests
+	// matching this route.
+	CORS *CORSPolicy `json:",omitempty"`
+
+	// RequestRedirect and DirectResponse answer requests matching this
+	// route directly instead of forwarding them to Service. They are set
+	// from the filters of http-route rules.
+	RequestRedirect *RequestRedirectFilter `json:",omitempty" alias:"request_redirect"`
+	DirectResponse  *DirectResponseFilter  `json:",omitempty" alias:"direct_response"`
+}
+
+// RespondsDirectly returns true when requests matching the route are
+// answered by the proxy rather than forwarded to the destination service.
+func (d *ServiceRouteDestination) RespondsDirectly() bool {
+	return d != nil && (d.RequestRedirect != nil || d.DirectResponse != nil)
 }
 
 func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
@@ -525,6 +660,275 @@ func (d *ServiceRouteDestination) HasRetryFeatures() bool {
 	return d.NumRetries > 0 || d.RetryOnConnectFailure || len(d.RetryOnStatusCodes) > 0 || len(d.RetryOn) > 0
 }
 
+// ServiceRouteMirror identifies a target that receives a copy of requests
+// sent to a route or split. Mirrored requests are sent fire-and-forget so
+// they can be used to exercise a new version of a service with production
+// traffic without affecting the response returned to the caller.
+type ServiceRouteMirror struct {
+	// Service is the service to mirror requests to.
+	Service string
+
+	// ServiceSubset is a named subset of the given service to mirror requests
+	// to instead of the one defined as that service's DefaultSubset. If empty
+	// the default subset is used.
+	ServiceSubset string `json:",omitempty" alias:"service_subset"`
+
+	// Namespace is the namespace to resolve the service from instead of the
+	// current namespace. If empty the current namespace is assumed.
+	Namespace string `json:",omitempty"`
+
+	// Partition is the partition to resolve the service from instead of the
+	// current partition. If empty the current partition is assumed.
+	Partition string `json:",omitempty"`
+
+	// Peer is the name of the cluster peer to resolve the service from
+	// instead of the current one. If empty the local cluster is assumed.
+	Peer string `json:",omitempty"`
+
+	// Percent is a value between 0 and 100 reflecting the portion of
+	// requests that should be mirrored. If zero all requests are mirrored.
+	Percent float32 `json:",omitempty"`
+}
+
+func (m *ServiceRouteMirror) validate() error {
+	if m.Service == "" {
+		return fmt.Errorf("missing required Service field")
+	}
+	if m.Peer != "" && m.Partition != "" {
+		return fmt.Errorf("cannot set both Peer and Partition")
+	}
+	if m.Percent < 0 || m.Percent > 100 {
+		return fmt.Errorf("Percent must be between 0 and 100, not %f", m.Percent)
+	}
+	return nil
+}
+
+// EffectivePercent returns the portion of requests that should be mirrored,
+// treating an unset Percent as 100.
+func (m *ServiceRouteMirror) EffectivePercent() float32 {
+	if m.Percent == 0 {
+		return 100
+	}
+	return m.Percent
+}
+
+// FaultInjection configures faults that are injected into HTTP requests
+// before they are forwarded, for use in chaos testing.
+type FaultInjection struct {
+	// Delay adds a fixed delay before requests are forwarded.
+	Delay *FaultInjectionDelay `json:",omitempty"`
+
+	// Abort terminates requests early with the given status.
+	Abort *FaultInjectionAbort `json:",omitempty"`
+
+	// Headers is a set of criteria a request must match for faults to be
+	// injected. If empty faults apply to all requests.
+	Headers []ServiceRouteHTTPMatchHeader `json:",omitempty"`
+}
+
+// FaultInjectionDelay delays a portion of requests by a fixed duration.
+type FaultInjectionDelay struct {
+	// FixedDelay is the amount of time requests are delayed by.
+	FixedDelay time.Duration `json:",omitempty" alias:"fixed_delay"`
+
+	// Percent is a value between 0 and 100 reflecting the portion of
+	// requests that should be delayed. If zero all requests are delayed.
+	Percent float32 `json:",omitempty"`
+}
+
+func (d *FaultInjectionDelay) MarshalJSON() ([]byte, error) {
+	type Alias FaultInjectionDelay
+	exported := &struct {
+		FixedDelay string `json:",omitempty"`
+		*Alias
+	}{
+		FixedDelay: d.FixedDelay.String(),
+		Alias:      (*Alias)(d),
+	}
+	if d.FixedDelay == 0 {
+		exported.FixedDelay = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (d *FaultInjectionDelay) UnmarshalJSON(data []byte) error {
+	type Alias FaultInjectionDelay
+	aux := &struct {
+		FixedDelay string
+		*Alias
+	}{
+		Alias: (*Alias)(d),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.FixedDelay != "" {
+		if d.FixedDelay, err = time.ParseDuration(aux.FixedDelay); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FaultInjectionAbort terminates a portion of requests with an HTTP or gRPC
+// status instead of forwarding them.
+type FaultInjectionAbort struct {
+	// HTTPStatus is the HTTP status code to respond with.
+	HTTPStatus uint32 `json:",omitempty" alias:"http_status"`
+
+	// GRPCStatus is the gRPC status code to respond with.
+	GRPCStatus uint32 `json:",omitempty" alias:"grpc_status"`
+
+	// Percent is a value between 0 and 100 reflecting the portion of
+	// requests that should be aborted. If zero all requests are aborted.
+	Percent float32 `json:",omitempty"`
+}
+
+// Validate checks that the fault injection configuration is well formed.
+func (f *FaultInjection) Validate() error {
+	if f.Delay == nil && f.Abort == nil {
+		return fmt.Errorf("must contain at least one of Delay or Abort")
+	}
+	if f.Delay != nil {
+		if f.Delay.FixedDelay <= 0 {
+			return fmt.Errorf("Delay FixedDelay must be greater than 0")
+		}
+		if err := validateFaultPercent(f.Delay.Percent); err != nil {
+			return fmt.Errorf("Delay %s", err)
+		}
+	}
+	if f.Abort != nil {
+		switch {
+		case f.Abort.HTTPStatus != 0 && f.Abort.GRPCStatus != 0:
+			return fmt.Errorf("Abort cannot set both HTTPStatus and GRPCStatus")
+		case f.Abort.HTTPStatus != 0:
+			if f.Abort.HTTPStatus < 200 || f.Abort.HTTPStatus > 599 {
+				return fmt.Errorf("Abort HTTPStatus must be between 200 and 599, not %d", f.Abort.HTTPStatus)
+			}
+		case f.Abort.GRPCStatus != 0:
+			if f.Abort.GRPCStatus > 16 {
+				return fmt.Errorf("Abort GRPCStatus must be between 1 and 16, not %d", f.Abort.GRPCStatus)
+			}
+		default:
+			return fmt.Errorf("Abort must contain one of HTTPStatus or GRPCStatus")
+		}
+		if err := validateFaultPercent(f.Abort.Percent); err != nil {
+			return fmt.Errorf("Abort %s", err)
+		}
+	}
+	for i, hdr := range f.Headers {
+		if err := hdr.validate(); err != nil {
+			return fmt.Errorf("Header[%d] %s", i, err)
+		}
+	}
+	return nil
+}
+
+func validateFaultPercent(percent float32) error {
+	if percent < 0 || percent > 100 {
+		return fmt.Errorf("Percent must be between 0 and 100, not %f", percent)
+	}
+	return nil
+}
+
+// CORSPolicy configures how cross-origin resource sharing requests are
+// handled, including responding to preflight requests.
+type CORSPolicy struct {
+	// AllowOrigins is a list of origins that are allowed to make requests.
+	// The value "*" allows any origin.
+	AllowOrigins []string `json:",omitempty" alias:"allow_origins"`
+
+	// AllowOriginRegexes is a list of regular expressions matching origins
+	// that are allowed to make requests.
+	AllowOriginRegexes []string `json:",omitempty" alias:"allow_origin_regexes"`
+
+	// AllowMethods is the list of methods returned in the
+	// Access-Control-Allow-Methods header.
+	AllowMethods []string `json:",omitempty" alias:"allow_methods"`
+
+	// AllowHeaders is the list of headers returned in the
+	// Access-Control-Allow-Headers header.
+	AllowHeaders []string `json:",omitempty" alias:"allow_headers"`
+
+	// ExposeHeaders is the list of headers returned in the
+	// Access-Control-Expose-Headers header.
+	ExposeHeaders []string `json:",omitempty" alias:"expose_headers"`
+
+	// MaxAge is how long the results of a preflight request can be cached.
+	MaxAge time.Duration `json:",omitempty" alias:"max_age"`
+
+	// AllowCredentials indicates whether the response to the request can be
+	// exposed when the credentials flag is true.
+	AllowCredentials bool `json:",omitempty" alias:"allow_credentials"`
+}
+
+func (c *CORSPolicy) MarshalJSON() ([]byte, error) {
+	type Alias CORSPolicy
+	exported := &struct {
+		MaxAge string `json:",omitempty"`
+		*Alias
+	}{
+		MaxAge: c.MaxAge.String(),
+		Alias:  (*Alias)(c),
+	}
+	if c.MaxAge == 0 {
+		exported.MaxAge = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (c *CORSPolicy) UnmarshalJSON(data []byte) error {
+	type Alias CORSPolicy
+	aux := &struct {
+		MaxAge string
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := lib.UnmarshalJSON(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.MaxAge != "" {
+		if c.MaxAge, err = time.ParseDuration(aux.MaxAge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks that the CORS policy is well formed.
+func (c *CORSPolicy) Validate() error {
+	if len(c.AllowOrigins) == 0 && len(c.AllowOriginRegexes) == 0 {
+		return fmt.Errorf("must contain at least one of AllowOrigins or AllowOriginRegexes")
+	}
+	for i, origin := range c.AllowOrigins {
+		if origin == "" {
+			return fmt.Errorf("AllowOrigins[%d] cannot be empty", i)
+		}
+		if origin == "*" && c.AllowCredentials {
+			return fmt.Errorf("AllowCredentials cannot be used with a wildcard origin")
+		}
+	}
+	for i, re := range c.AllowOriginRegexes {
+		if _, err := regexp.Compile(re); err != nil {
+			return fmt.Errorf("AllowOriginRegexes[%d] is not a valid regular expression: %v", i, err)
+		}
+	}
+	for _, m := range c.AllowMethods {
+		if !isValidHTTPMethod(m) {
+			return fmt.Errorf("AllowMethods contains an invalid method %q", m)
+		}
+	}
+	if c.MaxAge < 0 {
+		return fmt.Errorf("MaxAge cannot be negative")
+	}
+	return nil
+}
+
 // ServiceSplitterConfigEntry defines how incoming requests are split across
 // different subsets of a single service (like during staged canary rollouts),
 // or perhaps across different services (like during a v2 rewrite or other type
@@ -634,25 +1038,35 @@ func (e *ServiceSplitterConfigEntry) Validate() error {
 
 	const maxScaledWeight = 100 * 100
 
-	copyAsKey := func(s ServiceSplit) ServiceSplit {
-		s.Weight = 0
-		return s
+	type splitKey struct {
+		Service, ServiceSubset, Namespace, Partition string
+		RequestHeaders, ResponseHeaders              *HTTPHeaderModifiers
 	}
 
 	// Make sure we didn't refer to the same thing twice.
-	found := make(map[ServiceSplit]struct{})
-	for _, split := range e.Splits {
-		splitKey := copyAsKey(split)
-		if splitKey.Service == "" {
-			splitKey.Service = e.Name
+	found := make(map[splitKey]struct{})
+	for i, split := range e.Splits {
+		key := splitKey{
+			Service:         defaultIfEmpty(split.Service, e.Name),
+			ServiceSubset:   split.ServiceSubset,
+			Namespace:       split.Namespace,
+			Partition:       split.Partition,
+			RequestHeaders:  split.RequestHeaders,
+			ResponseHeaders: split.ResponseHeaders,
 		}
-		if _, ok := found[splitKey]; ok {
+		if _, ok := found[key]; ok {
 			return fmt.Errorf(
 				"split destination occurs more than once: service=%q, subset=%q, namespace=%q, partition=%q",
-				splitKey.Service, splitKey.ServiceSubset, splitKey.Namespace, splitKey.Partition,
+				key.Service, key.ServiceSubset, key.Namespace, key.Partition,
 			)
 		}
-		found[splitKey] = struct{}{}
+		found[key] = struct{}{}
+
+		for j, mirror := range split.Mirror {
+			if err := mirror.validate(); err != nil {
+				return fmt.Errorf("Splits[%d] Mirror[%d] %s", i, j, err)
+			}
+		}
 	}
 
 	sumScaled := 0
@@ -709,6 +1123,7 @@ func (e *ServiceSplitterConfigEntry) ListRelatedServices() []ServiceID {
 		if splitID != svcID {
 			found[splitID] = struct{}{}
 		}
+		addMirroredServices(found, svcID, split.Mirror, &e.EnterpriseMeta)
 	}
 
 	if len(found) == 0 {
@@ -726,6 +1141,51 @@ func (e *ServiceSplitterConfigEntry) ListRelatedServices() []ServiceID {
 	return out
 }
 
+// RelatedPeers returns the names of the cluster peers that routes mirror
+// requests to.
+func (e *ServiceRouterConfigEntry) RelatedPeers() []string {
+	peers := make(map[string]struct{})
+	for _, route := range e.Routes {
+		if route.Destination == nil {
+			continue
+		}
+		for _, mirror := range route.Destination.Mirror {
+			if mirror.Peer != "" {
+				peers[mirror.Peer] = struct{}{}
+			}
+		}
+	}
+	return maps.SliceOfKeys(peers)
+}
+
+// RelatedPeers returns the names of the cluster peers that splits mirror
+// requests to.
+func (e *ServiceSplitterConfigEntry) RelatedPeers() []string {
+	peers := make(map[string]struct{})
+	for _, split := range e.Splits {
+		for _, mirror := range split.Mirror {
+			if mirror.Peer != "" {
+				peers[mirror.Peer] = struct{}{}
+			}
+		}
+	}
+	return maps.SliceOfKeys(peers)
+}
+
+// addMirroredServices adds the local services targeted by mirrors to found.
+// We can't know about related services on cluster peers so those are skipped.
+func addMirroredServices(found map[ServiceID]struct{}, svcID ServiceID, mirrors []ServiceRouteMirror, entMeta *acl.EnterpriseMeta) {
+	for _, mirror := range mirrors {
+		if mirror.Peer != "" {
+			continue
+		}
+		mirrorID := NewServiceID(mirror.Service, mirror.GetEnterpriseMeta(entMeta))
+		if mirrorID != svcID {
+			found[mirrorID] = struct{}{}
+		}
+	}
+}
+
 // ServiceSplit defines how much traffic to send to which set of service
 // instances during a traffic split.
 type ServiceSplit struct {
@@ -769,6 +1229,11 @@ type ServiceSplit struct {
 	// Allow HTTP header manipulation to be configured.
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+
+	// Mirror is a list of additional targets that should receive a copy of
+	// the requests sent to this split. Responses from mirrored targets are
+	// discarded.
+	Mirror []ServiceRouteMirror `json:",omitempty"`
 }
 
 // MergeParent is called by the discovery chain compiler when a split directs to
@@ -807,11 +1272,20 @@ func (s *ServiceSplit) MergeParent(parent *ServiceSplit) (*ServiceSplit, error)
 		if err != nil {
 			return nil, err
 		}
+		copy.Mirror = mergeServiceRouteMirrors(parent.Mirror, nil)
 		return &copy, nil
 	} else {
 		copy = *s
 	}
 
+	// Requests reaching the child also passed through the parent so they are
+	// mirrored to the targets of both.
+	if parent != nil {
+		copy.Mirror = mergeServiceRouteMirrors(parent.Mirror, s.Mirror)
+	} else {
+		copy.Mirror = mergeServiceRouteMirrors(nil, s.Mirror)
+	}
+
 	var parentReq *HTTPHeaderModifiers
 	if parent != nil {
 		parentReq = parent.RequestHeaders
@@ -839,6 +1313,17 @@ func (s *ServiceSplit) MergeParent(parent *ServiceSplit) (*ServiceSplit, error)
 	return &copy, nil
 }
 
+// mergeServiceRouteMirrors returns a new slice with the mirrors of parent
+// followed by those of child.
+func mergeServiceRouteMirrors(parent, child []ServiceRouteMirror) []ServiceRouteMirror {
+	if len(parent)+len(child) == 0 {
+		return nil
+	}
+	out := make([]ServiceRouteMirror, 0, len(parent)+len(child))
+	out = append(out, parent...)
+	return append(out, child...)
+}
+
 // ServiceResolverConfigEntry defines which instances of a service should
 // satisfy discovery requests for a given named service.
 //
@@ -905,6 +1390,15 @@ type ServiceResolverConfigEntry struct {
 	// issuing requests to this upstream service.
 	LoadBalancer *LoadBalancer `json:",omitempty" alias:"load_balancer"`
 
+	// OutlierDetection configures passive health checking of the instances of
+	// this service by the proxies issuing requests to it. Fields set in an
+	// upstream's PassiveHealthCheck configuration take precedence.
+	OutlierDetection *PassiveHealthCheck `json:",omitempty" alias:"outlier_detection"`
+
+	// HealthChecks configures active health checks that the proxies issuing
+	// requests to this service send to each of its instances.
+	HealthChecks []ActiveHealthCheck `json:",omitempty" alias:"health_checks"`
+
 	Meta               map[string]string `json:",omitempty"`
 	Hash               uint64            `json:",omitempty" hash:"ignore"`
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
@@ -1006,7 +1500,9 @@ func (e *ServiceResolverConfigEntry) IsDefault() bool {
 		e.ConnectTimeout == 0 &&
 		e.RequestTimeout == 0 &&
 		e.LoadBalancer == nil &&
-		e.PrioritizeByLocality == nil
+		e.PrioritizeByLocality == nil &&
+		e.OutlierDetection == nil &&
+		len(e.HealthChecks) == 0
 }
 
 func (e *ServiceResolverConfigEntry) GetKind() string {
@@ -1292,6 +1788,18 @@ func (e *ServiceResolverConfigEntry) Validate() error {
 		}
 	}
 
+	if e.OutlierDetection != nil {
+		if err := e.OutlierDetection.Validate(); err != nil {
+			return fmt.Errorf("Bad OutlierDetection: %v", err)
+		}
+	}
+
+	for i, hc := range e.HealthChecks {
+		if err := hc.validate(); err != nil {
+			return fmt.Errorf("Bad HealthChecks[%d]: %v", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -1539,13 +2047,105 @@ func (fp *ServiceResolverFailoverPolicy) validate() error {
 	return nil
 }
 
+// ActiveHealthCheck configures a health check that proxies actively send to
+// each instance of an upstream service. Exactly one of HTTP or GRPC must be set.
+type ActiveHealthCheck struct {
+	// Interval is the time between health checks. Defaults to 10s.
+	Interval time.Duration `json:",omitempty"`
+
+	// Timeout is the time to wait for a health check response. Defaults to 5s.
+	Timeout time.Duration `json:",omitempty"`
+
+	// UnhealthyThreshold is the number of consecutive failed health checks
+	// before an instance is considered unhealthy. Defaults to 3.
+	UnhealthyThreshold uint32 `json:",omitempty" alias:"unhealthy_threshold"`
+
+	// HealthyThreshold is the number of consecutive successful health checks
+	// before an unhealthy instance is considered healthy again. Defaults to 2.
+	HealthyThreshold uint32 `json:",omitempty" alias:"healthy_threshold"`
+
+	// HTTP configures an HTTP health check.
+	HTTP *ActiveHealthCheckHTTP `json:",omitempty"`
+
+	// GRPC configures a gRPC health check using the gRPC health checking
+	// protocol. It requires the service protocol to be grpc or http2.
+	GRPC *ActiveHealthCheckGRPC `json:",omitempty"`
+}
+
+// ActiveHealthCheckHTTP configures an HTTP health check. A response status
+// in the 2xx range is considered healthy.
+type ActiveHealthCheckHTTP struct {
+	// Path is the request path of the health check.
+	Path string
+
+	// Host is the value of the host header of the health check. Defaults to
+	// the name of the upstream cluster.
+	Host string `json:",omitempty"`
+}
+
+// ActiveHealthCheckGRPC configures a gRPC health check.
+type ActiveHealthCheckGRPC struct {
+	// ServiceName is the service name sent in the health check request.
+	ServiceName string `json:",omitempty" alias:"service_name"`
+
+	// Authority is the value of the :authority header of the health check.
+	// Defaults to the name of the upstream cluster.
+	Authority string `json:",omitempty"`
+}
+
+// WithDefaults returns a copy of the health check with defaults applied to
+// the unset fields.
+func (hc ActiveHealthCheck) WithDefaults() ActiveHealthCheck {
+	if hc.Interval == 0 {
+		hc.Interval = 10 * time.Second
+	}
+	if hc.Timeout == 0 {
+		hc.Timeout = 5 * time.Second
+	}
+	if hc.UnhealthyThreshold == 0 {
+		hc.UnhealthyThreshold = 3
+	}
+	if hc.HealthyThreshold == 0 {
+		hc.HealthyThreshold = 2
+	}
+	return hc
+}
+
+func (hc ActiveHealthCheck) validate() error {
+	if (hc.HTTP == nil) == (hc.GRPC == nil) {
+		return fmt.Errorf("exactly one of HTTP or GRPC must be set")
+	}
+	if hc.Interval < 0 {
+		return fmt.Errorf("Interval cannot be negative")
+	}
+	if hc.Timeout < 0 {
+		return fmt.Errorf("Timeout cannot be negative")
+	}
+	if hc.HTTP != nil && !strings.HasPrefix(hc.HTTP.Path, "/") {
+		return fmt.Errorf("HTTP Path must start with '/': %q", hc.HTTP.Path)
+	}
+	return nil
+}
+
 type ServiceResolverPrioritizeByLocality struct {
 	// Mode specifies the type of prioritization that will be performed
 	// when selecting nodes in the local partition.
-	// Valid values are: "" (default "none"), "none", and "failover".
+	// Valid values are: "" (default "none"), "none", "failover", and "zone-aware".
 	Mode string `json:",omitempty"`
+
+	// MinClusterSize is the minimum number of instances the service must
+	// have for zone-aware routing to be used. Smaller services are load
+	// balanced across all zones. Only used by the "zone-aware" mode and
+	// defaults to Envoy's default of 6 when unset.
+	MinClusterSize uint32 `json:",omitempty" alias:"min_cluster_size"`
 }
 
+const (
+	// PrioritizeByLocalityModeZoneAware keeps requests within the zone of the
+	// proxy as long as that zone has enough instances of the service.
+	PrioritizeByLocalityModeZoneAware = "zone-aware"
+)
+
 type ServiceResolverFailoverTarget struct {
 	// Service specifies the name of the service to try during failover.
 	Service string `json:",omitempty"`
@@ -1729,6 +2329,10 @@ type DiscoveryChainRequest struct {
 	// overridden for any resolver in the compiled chain.
 	OverrideConnectTimeout time.Duration
 
+	// Explain requests that each node of the compiled chain lists the config
+	// entries that produced it.
+	Explain bool
+
 	Datacenter string // where to route the RPC
 	QueryOptions
 }
@@ -1755,6 +2359,7 @@ func (r *DiscoveryChainRequest) CacheInfo() cache.RequestInfo {
 		OverrideMeshGateway    MeshGatewayConfig
 		OverrideProtocol       string
 		OverrideConnectTimeout time.Duration
+		Explain                bool
 		Filter                 string
 	}{
 		Name:                   r.Name,
@@ -1764,6 +2369,7 @@ func (r *DiscoveryChainRequest) CacheInfo() cache.RequestInfo {
 		OverrideMeshGateway:    r.OverrideMeshGateway,
 		OverrideProtocol:       r.OverrideProtocol,
 		OverrideConnectTimeout: r.OverrideConnectTimeout,
+		Explain:                r.Explain,
 		Filter:                 r.QueryOptions.Filter,
 	}, nil)
 	if err == nil {
@@ -23,6 +23,12 @@ func (split *ServiceSplit) GetEnterpriseMeta(_ *acl.EnterpriseMeta) *acl.Enterpr
 	return DefaultEnterpriseMetaInDefaultPartition()
 }
 
+// GetEnterpriseMeta is used to synthesize the EnterpriseMeta struct from
+// fields in the ServiceRouteMirror
+func (mirror *ServiceRouteMirror) GetEnterpriseMeta(_ *acl.EnterpriseMeta) *acl.EnterpriseMeta {
+	return DefaultEnterpriseMetaInDefaultPartition()
+}
+
 // GetEnterpriseMeta is used to synthesize the EnterpriseMeta struct from
 // fields in the ServiceResolverRedirect
 func (redir *ServiceResolverRedirect) GetEnterpriseMeta(_ *acl.EnterpriseMeta) *acl.EnterpriseMeta {
@@ -123,5 +129,11 @@ func (pbl *ServiceResolverPrioritizeByLocality) validate() error {
 	if pbl == nil || *pbl == zero {
 		return nil
 	}
+	if pbl.Mode == PrioritizeByLocalityModeZoneAware {
+		return nil
+	}
+	if pbl.MinClusterSize != 0 {
+		return fmt.Errorf("Prioritize-by-locality MinClusterSize is only supported by the %q mode", PrioritizeByLocalityModeZoneAware)
+	}
 	return fmt.Errorf("Prioritize-by-locality requires Consul Enterprise ")
 }
@@ -131,6 +131,35 @@ func TestServiceResolverConfigEntry_CE(t *testing.T) {
 			},
 			validateErr: `Redirect: Setting Partition requires Consul Enterprise`,
 		},
+		{
+			name: "prioritize by locality failover on CE",
+			entry: &ServiceResolverConfigEntry{
+				Kind:                 ServiceResolver,
+				Name:                 "test",
+				PrioritizeByLocality: &ServiceResolverPrioritizeByLocality{Mode: "failover"},
+			},
+			validateErr: `Prioritize-by-locality requires Consul Enterprise`,
+		},
+		{
+			name: "prioritize by locality zone-aware on CE",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				PrioritizeByLocality: &ServiceResolverPrioritizeByLocality{
+					Mode:           PrioritizeByLocalityModeZoneAware,
+					MinClusterSize: 3,
+				},
+			},
+		},
+		{
+			name: "prioritize by locality min cluster size requires zone-aware",
+			entry: &ServiceResolverConfigEntry{
+				Kind:                 ServiceResolver,
+				Name:                 "test",
+				PrioritizeByLocality: &ServiceResolverPrioritizeByLocality{MinClusterSize: 3},
+			},
+			validateErr: `MinClusterSize is only supported by the "zone-aware" mode`,
+		},
 	}
 
 	// Bulk add a bunch of similar validation cases.
@@ -1978,6 +1978,120 @@ func TestServiceResolverConfigEntry_LoadBalancer(t *testing.T) {
 	}
 }
 
+func TestServiceResolverConfigEntry_HealthChecks(t *testing.T) {
+	type testcase struct {
+		name        string
+		entry       *ServiceResolverConfigEntry
+		validateErr string
+	}
+
+	cases := []testcase{
+		{
+			name: "outlier detection",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				OutlierDetection: &PassiveHealthCheck{
+					Interval:    10 * time.Second,
+					MaxFailures: 5,
+				},
+			},
+		},
+		{
+			name: "bad outlier detection",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				OutlierDetection: &PassiveHealthCheck{
+					MaxEjectionPercent: uintPointer(200),
+				},
+			},
+			validateErr: "Bad OutlierDetection: passive health check max_ejection_percent must be a percentage between 0 and 100",
+		},
+		{
+			name: "http and grpc health checks",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				HealthChecks: []ActiveHealthCheck{
+					{HTTP: &ActiveHealthCheckHTTP{Path: "/health"}},
+					{Interval: time.Second, GRPC: &ActiveHealthCheckGRPC{ServiceName: "test"}},
+				},
+			},
+		},
+		{
+			name: "health check without checker",
+			entry: &ServiceResolverConfigEntry{
+				Kind:         ServiceResolver,
+				Name:         "test",
+				HealthChecks: []ActiveHealthCheck{{Interval: time.Second}},
+			},
+			validateErr: "Bad HealthChecks[0]: exactly one of HTTP or GRPC must be set",
+		},
+		{
+			name: "health check with both checkers",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				HealthChecks: []ActiveHealthCheck{{
+					HTTP: &ActiveHealthCheckHTTP{Path: "/health"},
+					GRPC: &ActiveHealthCheckGRPC{},
+				}},
+			},
+			validateErr: "Bad HealthChecks[0]: exactly one of HTTP or GRPC must be set",
+		},
+		{
+			name: "health check negative interval",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				HealthChecks: []ActiveHealthCheck{
+					{HTTP: &ActiveHealthCheckHTTP{Path: "/health"}},
+					{Interval: -time.Second, GRPC: &ActiveHealthCheckGRPC{}},
+				},
+			},
+			validateErr: "Bad HealthChecks[1]: Interval cannot be negative",
+		},
+		{
+			name: "health check negative timeout",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				HealthChecks: []ActiveHealthCheck{
+					{Timeout: -time.Second, GRPC: &ActiveHealthCheckGRPC{}},
+				},
+			},
+			validateErr: "Bad HealthChecks[0]: Timeout cannot be negative",
+		},
+		{
+			name: "http health check relative path",
+			entry: &ServiceResolverConfigEntry{
+				Kind: ServiceResolver,
+				Name: "test",
+				HealthChecks: []ActiveHealthCheck{
+					{HTTP: &ActiveHealthCheckHTTP{Path: "health"}},
+				},
+			},
+			validateErr: `Bad HealthChecks[0]: HTTP Path must start with '/': "health"`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.entry.Normalize())
+
+			err := tc.entry.Validate()
+			if tc.validateErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.validateErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestServiceSplitterConfigEntry(t *testing.T) {
 
 	makesplitter := func(splits ...ServiceSplit) *ServiceSplitterConfigEntry {
@@ -2136,6 +2250,29 @@ func TestServiceSplitterConfigEntry(t *testing.T) {
 			},
 			validateErr: "split destination occurs more than once",
 		},
+		{
+			name: "split with mirror",
+			entry: makesplitter(ServiceSplit{
+				Weight: 100,
+				Mirror: []ServiceRouteMirror{{Service: "shadow", Percent: 12.5}},
+			}),
+		},
+		{
+			name: "split mirror missing service",
+			entry: makesplitter(ServiceSplit{
+				Weight: 100,
+				Mirror: []ServiceRouteMirror{{Percent: 50}},
+			}),
+			validateErr: "Splits[0] Mirror[0] missing required Service field",
+		},
+		{
+			name: "split mirror percent out of range",
+			entry: makesplitter(ServiceSplit{
+				Weight: 100,
+				Mirror: []ServiceRouteMirror{{Service: "shadow", Percent: 101}},
+			}),
+			validateErr: "Percent must be between 0 and 100",
+		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
@@ -2267,6 +2404,27 @@ func TestServiceSplitMergeParent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "mirrors from parent and child",
+			split: &ServiceSplit{
+				Weight:  50,
+				Service: "foo",
+				Mirror:  []ServiceRouteMirror{{Service: "child-shadow"}},
+			},
+			parent: &ServiceSplit{
+				Weight:  50,
+				Service: "bar",
+				Mirror:  []ServiceRouteMirror{{Service: "parent-shadow", Percent: 10}},
+			},
+			want: &ServiceSplit{
+				Weight:  50,
+				Service: "foo",
+				Mirror: []ServiceRouteMirror{
+					{Service: "parent-shadow", Percent: 10},
+					{Service: "child-shadow"},
+				},
+			},
+		},
 		{
 			name: "no header manip",
 			split: &ServiceSplit{
@@ -2742,6 +2900,294 @@ func TestServiceRouterConfigEntry(t *testing.T) {
 			}),
 			validateErr: "contains an invalid retry condition: \"invalid-retry-condition\"",
 		},
+		{
+			name: "route with mirror",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					Mirror:  []ServiceRouteMirror{{Service: "shadow", ServiceSubset: "v2", Percent: 5}},
+				},
+			}),
+		},
+		{
+			name: "route mirror with peer and partition",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					Mirror:  []ServiceRouteMirror{{Service: "shadow", Peer: "cluster-02", Partition: "part"}},
+				},
+			}),
+			validateErr: "Route[0] Mirror[0] cannot set both Peer and Partition",
+		},
+		{
+			name: "route mirror with negative percent",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					Mirror:  []ServiceRouteMirror{{Service: "shadow", Percent: -1}},
+				},
+			}),
+			validateErr: "Percent must be between 0 and 100",
+		},
+		{
+			name: "route with fault injection",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &FaultInjection{
+						Delay:   &FaultInjectionDelay{FixedDelay: 2 * time.Second, Percent: 10},
+						Abort:   &FaultInjectionAbort{HTTPStatus: 503, Percent: 5},
+						Headers: []ServiceRouteHTTPMatchHeader{{Name: "x-chaos", Present: true}},
+					},
+				},
+			}),
+		},
+		{
+			name: "route fault injection with no faults",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service:        "other",
+					FaultInjection: &FaultInjection{},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection must contain at least one of Delay or Abort",
+		},
+		{
+			name: "route fault injection with zero delay",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &FaultInjection{
+						Delay: &FaultInjectionDelay{Percent: 10},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Delay FixedDelay must be greater than 0",
+		},
+		{
+			name: "route fault injection abort with both statuses",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &FaultInjection{
+						Abort: &FaultInjectionAbort{HTTPStatus: 503, GRPCStatus: 14},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Abort cannot set both HTTPStatus and GRPCStatus",
+		},
+		{
+			name: "route fault injection abort with no status",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &FaultInjection{
+						Abort: &FaultInjectionAbort{Percent: 50},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Abort must contain one of HTTPStatus or GRPCStatus",
+		},
+		{
+			name: "route fault injection abort with invalid http status",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &FaultInjection{
+						Abort: &FaultInjectionAbort{HTTPStatus: 700},
+					},
+				},
+			}),
+			validateErr: "Abort HTTPStatus must be between 200 and 599, not 700",
+		},
+		{
+			name: "route fault injection abort with invalid grpc status",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &FaultInjection{
+						Abort: &FaultInjectionAbort{GRPCStatus: 17},
+					},
+				},
+			}),
+			validateErr: "Abort GRPCStatus must be between 1 and 16, not 17",
+		},
+		{
+			name: "route fault injection with invalid percent",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &FaultInjection{
+						Abort: &FaultInjectionAbort{GRPCStatus: 14, Percent: 150},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Abort Percent must be between 0 and 100",
+		},
+		{
+			name: "route fault injection with invalid header",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					FaultInjection: &FaultInjection{
+						Abort:   &FaultInjectionAbort{HTTPStatus: 503},
+						Headers: []ServiceRouteHTTPMatchHeader{{Name: "x-chaos"}},
+					},
+				},
+			}),
+			validateErr: "Route[0] FaultInjection Header[0] should only contain one of Present, Exact, Prefix, Suffix, or Regex",
+		},
+		{
+			name: "route with rate limit",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					RateLimit: &LocalRateLimit{
+						RequestsPerSecond: 50,
+						Descriptors: []LocalRateLimitDescriptor{
+							{Header: "x-tenant", Value: "acme", RequestsPerSecond: 5},
+						},
+					},
+				},
+			}),
+		},
+		{
+			name: "route rate limit with routes",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					RateLimit: &LocalRateLimit{
+						RequestsPerSecond: 50,
+						Routes:            []LocalRateLimitRoute{{PathPrefix: "/", RequestsPerSecond: 5}},
+					},
+				},
+			}),
+			validateErr: "Route[0] RateLimit cannot contain Routes",
+		},
+		{
+			name: "route rate limit with invalid descriptor",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					RateLimit: &LocalRateLimit{
+						RequestsPerSecond: 50,
+						Descriptors: []LocalRateLimitDescriptor{
+							{Value: "acme", RequestsPerSecond: 5},
+						},
+					},
+				},
+			}),
+			validateErr: "Route[0] RateLimit Descriptors[0] missing required Header field",
+		},
+		{
+			name: "route with cors policy",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					CORS: &CORSPolicy{
+						AllowOrigins:  []string{"https://example.com"},
+						AllowMethods:  []string{"GET", "PUT"},
+						AllowHeaders:  []string{"content-type"},
+						ExposeHeaders: []string{"x-request-id"},
+						MaxAge:        10 * time.Minute,
+					},
+				},
+			}),
+		},
+		{
+			name: "route cors policy without origins",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					CORS: &CORSPolicy{
+						AllowMethods: []string{"GET"},
+					},
+				},
+			}),
+			validateErr: "Route[0] CORS must contain at least one of AllowOrigins or AllowOriginRegexes",
+		},
+		{
+			name: "route cors policy with invalid method",
+			entry: makerouter(ServiceRoute{
+				Destination: &ServiceRouteDestination{
+					Service: "other",
+					CORS: &CORSPolicy{
+						AllowOrigins: []string{"https://example.com"},
+						AllowMethods: []string{"FETCH"},
+					},
+				},
+			}),
+			validateErr: `Route[0] CORS AllowMethods contains an invalid method "FETCH"`,
+		},
+		////////////////
+		{
+			name: "route with jwt claims match",
+			entry: makerouter(routeMatch(httpMatch(&ServiceRouteHTTPMatch{
+				JWT: &ServiceRouteHTTPMatchJWT{
+					Provider: "okta",
+					Claims: []ServiceRouteHTTPMatchJWTClaim{
+						{Path: []string{"tier"}, Value: "premium"},
+						{Path: []string{"perms", "role"}, Value: "admin"},
+					},
+				},
+			}))),
+		},
+		{
+			name: "route jwt match without provider",
+			entry: makerouter(routeMatch(httpMatch(&ServiceRouteHTTPMatch{
+				JWT: &ServiceRouteHTTPMatchJWT{
+					Claims: []ServiceRouteHTTPMatchJWTClaim{
+						{Path: []string{"tier"}, Value: "premium"},
+					},
+				},
+			}))),
+			validateErr: "Route[0] JWT missing required Provider field",
+		},
+		{
+			name: "route jwt match without claims",
+			entry: makerouter(routeMatch(httpMatch(&ServiceRouteHTTPMatch{
+				JWT: &ServiceRouteHTTPMatchJWT{
+					Provider: "okta",
+				},
+			}))),
+			validateErr: "Route[0] JWT must contain at least one claim",
+		},
+		{
+			name: "route jwt match claim without path",
+			entry: makerouter(routeMatch(httpMatch(&ServiceRouteHTTPMatch{
+				JWT: &ServiceRouteHTTPMatchJWT{
+					Provider: "okta",
+					Claims: []ServiceRouteHTTPMatchJWTClaim{
+						{Value: "premium"},
+					},
+				},
+			}))),
+			validateErr: "Route[0] JWT Claims[0] missing required Path field",
+		},
+		{
+			name: "route jwt match claim with empty path segment",
+			entry: makerouter(routeMatch(httpMatch(&ServiceRouteHTTPMatch{
+				JWT: &ServiceRouteHTTPMatchJWT{
+					Provider: "okta",
+					Claims: []ServiceRouteHTTPMatchJWTClaim{
+						{Path: []string{"perms", ""}, Value: "admin"},
+					},
+				},
+			}))),
+			validateErr: "Route[0] JWT Claims[0] Path cannot contain empty segments",
+		},
+		{
+			name: "route jwt match claim without value",
+			entry: makerouter(routeMatch(httpMatch(&ServiceRouteHTTPMatch{
+				JWT: &ServiceRouteHTTPMatchJWT{
+					Provider: "okta",
+					Claims: []ServiceRouteHTTPMatchJWTClaim{
+						{Path: []string{"tier"}},
+					},
+				},
+			}))),
+			validateErr: "Route[0] JWT Claims[0] missing required Value field",
+		},
 		////////////////
 		{
 			name: "default route with case insensitive match",
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/miekg/dns"
 	"golang.org/x/exp/slices"
@@ -20,6 +21,10 @@ import (
 
 const (
 	wildcardPrefix = "*."
+
+	// maxRequestHeadersKB is the largest request header size limit Envoy
+	// accepts.
+	maxRequestHeadersKB = 8192
 )
 
 // IngressGatewayConfigEntry manages the configuration for an ingress service
@@ -87,6 +92,22 @@ type IngressListener struct {
 	// For "tcp" protocol listeners, only a single service is allowed.
 	// For "http" listeners, multiple services can be declared.
 	Services []IngressService
+
+	// RateLimit limits the rate of requests accepted by the listener. Services
+	// that configure their own RateLimit are limited by it instead. Only
+	// allowed on layer 7 protocols.
+	RateLimit *LocalRateLimit `json:",omitempty" alias:"rate_limit"`
+
+	// MaxRequestBytes is the maximum size of a request body in bytes. Larger
+	// requests are rejected with a 413 status. Services that configure their
+	// own MaxRequestBytes are limited by it instead. Only allowed on layer 7
+	// protocols.
+	MaxRequestBytes uint32 `json:",omitempty" alias:"max_request_bytes"`
+
+	// MaxRequestHeadersKB is the maximum size of the request headers in
+	// kilobytes. Requests with larger headers are rejected with a 431 status.
+	// Only allowed on layer 7 protocols.
+	MaxRequestHeadersKB uint32 `json:",omitempty" alias:"max_request_headers_kb"`
 }
 
 type IngressService struct {
@@ -130,6 +151,19 @@ type IngressService struct {
 	// be monitored for removal from the load balancing pool.
 	PassiveHealthCheck *PassiveHealthCheck `json:",omitempty" alias:"passive_health_check"`
 
+	// CORS configures the cross-origin resource sharing policy for requests
+	// to this service. Only allowed on layer 7 protocols.
+	CORS *CORSPolicy `json:",omitempty"`
+
+	// RateLimit limits the rate of requests to this service, replacing the
+	// listener's RateLimit. Only allowed on layer 7 protocols.
+	RateLimit *LocalRateLimit `json:",omitempty" alias:"rate_limit"`
+
+	// MaxRequestBytes is the maximum size of a request body to this service
+	// in bytes, replacing the listener's MaxRequestBytes. Only allowed on
+	// layer 7 protocols.
+	MaxRequestBytes uint32 `json:",omitempty" alias:"max_request_bytes"`
+
 	Meta               map[string]string `json:",omitempty"`
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
 }
@@ -332,6 +366,20 @@ func (e *IngressGatewayConfigEntry) Validate() error {
 			}
 		}
 
+		if !IsProtocolHTTPLike(listener.Protocol) {
+			if listener.RateLimit != nil || listener.MaxRequestBytes != 0 || listener.MaxRequestHeadersKB != 0 {
+				return fmt.Errorf("RateLimit, MaxRequestBytes and MaxRequestHeadersKB are only valid for HTTP-based protocols (listener on port %d)", listener.Port)
+			}
+		}
+		if listener.RateLimit != nil {
+			if err := listener.RateLimit.Validate(); err != nil {
+				return fmt.Errorf("RateLimit %s (listener on port %d)", err, listener.Port)
+			}
+		}
+		if listener.MaxRequestHeadersKB > maxRequestHeadersKB {
+			return fmt.Errorf("MaxRequestHeadersKB must be at most %d (listener on port %d)", maxRequestHeadersKB, listener.Port)
+		}
+
 		declaredHosts := make(map[string]bool)
 		serviceNames := make(map[ServiceID]struct{})
 		for _, s := range listener.Services {
@@ -342,6 +390,24 @@ func (e *IngressGatewayConfigEntry) Validate() error {
 			if err := s.ResponseHeaders.Validate(listener.Protocol); err != nil {
 				return fmt.Errorf("response headers %s (service %q on listener on port %d)", err, sn.String(), listener.Port)
 			}
+			if s.CORS != nil {
+				if !IsProtocolHTTPLike(listener.Protocol) {
+					return fmt.Errorf("CORS is only valid for HTTP-based protocols (service %q on listener on port %d)", sn.String(), listener.Port)
+				}
+				if err := s.CORS.Validate(); err != nil {
+					return fmt.Errorf("CORS %s (service %q on listener on port %d)", err, sn.String(), listener.Port)
+				}
+			}
+			if s.RateLimit != nil || s.MaxRequestBytes != 0 {
+				if !IsProtocolHTTPLike(listener.Protocol) {
+					return fmt.Errorf("RateLimit and MaxRequestBytes are only valid for HTTP-based protocols (service %q on listener on port %d)", sn.String(), listener.Port)
+				}
+			}
+			if s.RateLimit != nil {
+				if err := s.RateLimit.Validate(); err != nil {
+					return fmt.Errorf("RateLimit %s (service %q on listener on port %d)", err, sn.String(), listener.Port)
+				}
+			}
 
 			if listener.Protocol == "tcp" {
 				if s.Name == WildcardSpecifier {
@@ -521,9 +587,99 @@ type LinkedService struct {
 	//DisableAutoHostRewrite disables terminating gateways auto host rewrite feature when set to true.
 	DisableAutoHostRewrite bool `json:",omitempty"`
 
+	// HealthCheck is the optional active health check that the gateway performs
+	// against each instance of the linked service. The results are reported to
+	// the catalog as checks on the service instances.
+	HealthCheck *LinkedServiceHealthCheck `json:",omitempty" alias:"health_check"`
+
 	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
 }
 
+const (
+	LinkedServiceHealthCheckTCP  = "tcp"
+	LinkedServiceHealthCheckHTTP = "http"
+	LinkedServiceHealthCheckTLS  = "tls"
+)
+
+// LinkedServiceHealthCheck configures a health check that a terminating
+// gateway actively sends to each instance of a linked service.
+type LinkedServiceHealthCheck struct {
+	// Type is the kind of health check: "tcp", "http", or "tls". A tcp check
+	// passes when a connection can be established, an http check passes on a
+	// 2xx response status and a tls check passes when a TLS handshake using the
+	// service's CAFile, CertFile, KeyFile, and SNI succeeds. HTTP checks use TLS
+	// when a CAFile is configured for the service.
+	Type string
+
+	// Path is the request path of an http check. Defaults to "/".
+	Path string `json:",omitempty"`
+
+	// Interval is the time between health checks. Defaults to 10s.
+	Interval time.Duration `json:",omitempty"`
+
+	// Timeout is the time to wait for a health check response. Defaults to 5s.
+	Timeout time.Duration `json:",omitempty"`
+
+	// UnhealthyThreshold is the number of consecutive failed health checks
+	// before an instance is considered unhealthy. Defaults to 3.
+	UnhealthyThreshold uint32 `json:",omitempty" alias:"unhealthy_threshold"`
+
+	// HealthyThreshold is the number of consecutive successful health checks
+	// before an unhealthy instance is considered healthy again. Defaults to 2.
+	HealthyThreshold uint32 `json:",omitempty" alias:"healthy_threshold"`
+}
+
+// WithDefaults returns a copy of the health check with defaults applied to
+// the unset fields.
+func (hc LinkedServiceHealthCheck) WithDefaults() LinkedServiceHealthCheck {
+	if hc.Type == LinkedServiceHealthCheckHTTP && hc.Path == "" {
+		hc.Path = "/"
+	}
+	if hc.Interval == 0 {
+		hc.Interval = 10 * time.Second
+	}
+	if hc.Timeout == 0 {
+		hc.Timeout = 5 * time.Second
+	}
+	if hc.UnhealthyThreshold == 0 {
+		hc.UnhealthyThreshold = 3
+	}
+	if hc.HealthyThreshold == 0 {
+		hc.HealthyThreshold = 2
+	}
+	return hc
+}
+
+func (hc *LinkedServiceHealthCheck) validate() error {
+	switch hc.Type {
+	case LinkedServiceHealthCheckTCP, LinkedServiceHealthCheckTLS:
+		if hc.Path != "" {
+			return fmt.Errorf("Path is only valid for http health checks")
+		}
+	case LinkedServiceHealthCheckHTTP:
+		if hc.Path != "" && !strings.HasPrefix(hc.Path, "/") {
+			return fmt.Errorf("Path must start with '/': %q", hc.Path)
+		}
+	default:
+		return fmt.Errorf("Type must be one of %q, %q, or %q",
+			LinkedServiceHealthCheckTCP, LinkedServiceHealthCheckHTTP, LinkedServiceHealthCheckTLS)
+	}
+	if hc.Interval < 0 {
+		return fmt.Errorf("Interval cannot be negative")
+	}
+	if hc.Timeout < 0 {
+		return fmt.Errorf("Timeout cannot be negative")
+	}
+	return nil
+}
+
+func (hc *LinkedServiceHealthCheck) isSame(o *LinkedServiceHealthCheck) bool {
+	if hc == nil || o == nil {
+		return hc == o
+	}
+	return *hc == *o
+}
+
 func (e *TerminatingGatewayConfigEntry) GetKind() string {
 	return TerminatingGateway
 }
@@ -600,6 +756,15 @@ func (e *TerminatingGatewayConfigEntry) Validate() error {
 
 			return fmt.Errorf("Service %q must have a CertFile, CAFile, and KeyFile specified for TLS origination", svc.Name)
 		}
+
+		if svc.HealthCheck != nil {
+			if err := svc.HealthCheck.validate(); err != nil {
+				return fmt.Errorf("Service %q has an invalid HealthCheck: %w", svc.Name, err)
+			}
+			if svc.HealthCheck.Type == LinkedServiceHealthCheckTLS && svc.CAFile == "" {
+				return fmt.Errorf("Service %q must have a CAFile specified for a tls HealthCheck", svc.Name)
+			}
+		}
 	}
 	return nil
 }
@@ -671,7 +836,8 @@ type GatewayService struct {
 	FromWildcard bool               `json:",omitempty"`
 	ServiceKind  GatewayServiceKind `json:",omitempty"`
 	RaftIndex
-	AutoHostRewrite bool `json:",omitempty"`
+	AutoHostRewrite bool                      `json:",omitempty"`
+	HealthCheck     *LinkedServiceHealthCheck `json:",omitempty"`
 }
 
 type GatewayServices []*GatewayService
@@ -708,11 +874,12 @@ func (g *GatewayService) IsSame(o *GatewayService) bool {
 		g.KeyFile == o.KeyFile &&
 		g.SNI == o.SNI &&
 		g.ServiceKind == o.ServiceKind &&
-		g.FromWildcard == o.FromWildcard
+		g.FromWildcard == o.FromWildcard &&
+		g.HealthCheck.isSame(o.HealthCheck)
 }
 
 func (g *GatewayService) Clone() *GatewayService {
-	return &GatewayService{
+	cp := &GatewayService{
 		Gateway:     g.Gateway,
 		Service:     g.Service,
 		GatewayKind: g.GatewayKind,
@@ -729,6 +896,11 @@ func (g *GatewayService) Clone() *GatewayService {
 		ServiceKind:     g.ServiceKind,
 		AutoHostRewrite: g.AutoHostRewrite,
 	}
+	if g.HealthCheck != nil {
+		hc := *g.HealthCheck
+		cp.HealthCheck = &hc
+	}
+	return cp
 }
 
 // APIGatewayConfigEntry manages the configuration for an API gateway service
@@ -797,6 +969,7 @@ func (e *APIGatewayConfigEntry) Normalize() error {
 	for i, listener := range e.Listeners {
 		protocol := strings.ToLower(string(listener.Protocol))
 		listener.Protocol = APIGatewayListenerProtocol(protocol)
+		listener.TLS.Mode = APIGatewayTLSMode(strings.ToLower(string(listener.TLS.Mode)))
 		e.Listeners[i] = listener
 
 		for i, cert := range listener.TLS.Certificates {
@@ -866,8 +1039,10 @@ func (e *APIGatewayConfigEntry) validateMergedListeners() error {
 
 func (e *APIGatewayConfigEntry) validateListeners() error {
 	validProtocols := map[APIGatewayListenerProtocol]bool{
-		ListenerProtocolHTTP: true,
-		ListenerProtocolTCP:  true,
+		ListenerProtocolHTTP:  true,
+		ListenerProtocolHTTP2: true,
+		ListenerProtocolGRPC:  true,
+		ListenerProtocolTCP:   true,
 	}
 	allowedCertificateKinds := map[string]bool{
 		FileSystemCertificate: true,
@@ -876,11 +1051,13 @@ func (e *APIGatewayConfigEntry) validateListeners() error {
 
 	for _, listener := range e.Listeners {
 		if !validProtocols[listener.Protocol] {
-			return fmt.Errorf("unsupported listener protocol %q, must be one of 'tcp', or 'http'", listener.Protocol)
+			return fmt.Errorf("unsupported listener protocol %q, must be one of 'tcp', 'http', 'http2', or 'grpc'", listener.Protocol)
+		}
+		if err := validateListenerTLSMode(listener); err != nil {
+			return err
 		}
-		if listener.Protocol == ListenerProtocolTCP && listener.Hostname != "" {
-			// TODO: once we have SNI matching we should be able to implement this
-			return fmt.Errorf("hostname specification is not supported when using TCP")
+		if listener.Protocol == ListenerProtocolTCP && listener.Hostname != "" && listener.TLS.Mode != APIGatewayTLSModePassthrough {
+			return fmt.Errorf("hostname specification is not supported when using TCP without TLS passthrough")
 		}
 		if listener.Port <= 0 || listener.Port > 65535 {
 			return fmt.Errorf("listener port %d not in the range 1-65535", listener.Port)
@@ -903,6 +1080,29 @@ func (e *APIGatewayConfigEntry) validateListeners() error {
 	return nil
 }
 
+// validateListenerTLSMode ensures that passthrough listeners do not specify
+// any settings that only apply when the gateway terminates TLS.
+func validateListenerTLSMode(listener APIGatewayListener) error {
+	switch listener.TLS.Mode {
+	case APIGatewayTLSModeDefault, APIGatewayTLSModeTerminate:
+		return nil
+	case APIGatewayTLSModePassthrough:
+	default:
+		return fmt.Errorf("unsupported TLS mode %q for listener %q, must be one of 'terminate' or 'passthrough'", listener.TLS.Mode, listener.Name)
+	}
+
+	if listener.Protocol != ListenerProtocolTCP {
+		return fmt.Errorf("listener %q must use the 'tcp' protocol to use TLS passthrough", listener.Name)
+	}
+	if len(listener.TLS.Certificates) > 0 {
+		return fmt.Errorf("listener %q cannot specify certificates when using TLS passthrough", listener.Name)
+	}
+	if listener.TLS.MinVersion != types.TLSVersionUnspecified || listener.TLS.MaxVersion != types.TLSVersionUnspecified || len(listener.TLS.CipherSuites) > 0 {
+		return fmt.Errorf("listener %q cannot specify TLS versions or cipher suites when using TLS passthrough", listener.Name)
+	}
+	return nil
+}
+
 func (e *APIGatewayConfigEntry) CanRead(authz acl.Authorizer) error {
 	var authzContext acl.AuthorizerContext
 	e.FillAuthzContext(&authzContext)
@@ -919,10 +1119,29 @@ func (e *APIGatewayConfigEntry) CanWrite(authz acl.Authorizer) error {
 type APIGatewayListenerProtocol string
 
 const (
-	ListenerProtocolHTTP APIGatewayListenerProtocol = "http"
-	ListenerProtocolTCP  APIGatewayListenerProtocol = "tcp"
+	ListenerProtocolHTTP  APIGatewayListenerProtocol = "http"
+	ListenerProtocolHTTP2 APIGatewayListenerProtocol = "http2"
+	ListenerProtocolGRPC  APIGatewayListenerProtocol = "grpc"
+	ListenerProtocolTCP   APIGatewayListenerProtocol = "tcp"
 )
 
+// AcceptsRoute returns whether a route of the given protocol can be bound to
+// a listener using this protocol. HTTP/2 listeners accept both HTTP and gRPC
+// routes, all other listeners only accept routes of their own protocol.
+func (p APIGatewayListenerProtocol) AcceptsRoute(route APIGatewayListenerProtocol) bool {
+	if p == ListenerProtocolHTTP2 {
+		return route == ListenerProtocolHTTP || route == ListenerProtocolGRPC
+	}
+	return p == route
+}
+
+// AcceptsRouteKind returns whether a route of the given kind can be bound to
+// the listener. Passthrough listeners only accept TLSRoutes, and TLSRoutes
+// can only be bound to passthrough listeners.
+func (l APIGatewayListener) AcceptsRouteKind(kind string) bool {
+	return (l.TLS.Mode == APIGatewayTLSModePassthrough) == (kind == TLSRoute)
+}
+
 // APIGatewayListener represents an individual listener for an APIGateway
 type APIGatewayListener struct {
 	// Name is the name of the listener in a given gateway. This must be
@@ -958,9 +1177,27 @@ func (l APIGatewayListener) GetHostname() string {
 	return "*"
 }
 
+// APIGatewayTLSMode is the way that an APIGateway listener handles TLS
+// connections.
+type APIGatewayTLSMode string
+
+const (
+	// APIGatewayTLSModeDefault is equivalent to APIGatewayTLSModeTerminate.
+	APIGatewayTLSModeDefault APIGatewayTLSMode = ""
+	// APIGatewayTLSModeTerminate terminates TLS at the gateway using the
+	// certificates configured on the listener.
+	APIGatewayTLSModeTerminate APIGatewayTLSMode = "terminate"
+	// APIGatewayTLSModePassthrough forwards TLS connections to the upstream
+	// selected by their SNI hostname without terminating them.
+	APIGatewayTLSModePassthrough APIGatewayTLSMode = "passthrough"
+)
+
 // APIGatewayTLSConfiguration specifies the configuration of a listener’s
 // TLS settings.
 type APIGatewayTLSConfiguration struct {
+	// Mode is whether the listener terminates TLS or passes it through to
+	// the upstream service. Defaults to terminate.
+	Mode APIGatewayTLSMode `json:",omitempty"`
 	// Certificates is a set of references to certificates
 	// that a gateway listener uses for TLS termination.
 	Certificates []ResourceReference
@@ -974,7 +1211,7 @@ type APIGatewayTLSConfiguration struct {
 	CipherSuites []types.TLSCipherSuite
 }
 
-// IsEmpty returns true if all values in the struct are nil or empty.
+// IsEmpty returns true if none of the TLS termination settings are set.
 func (a *APIGatewayTLSConfiguration) IsEmpty() bool {
 	return len(a.Certificates) == 0 && len(a.MaxVersion) == 0 && len(a.MinVersion) == 0 && len(a.CipherSuites) == 0
 }
@@ -1166,7 +1403,9 @@ func (e *BoundAPIGatewayConfigEntry) Validate() error {
 	}
 	allowedRouteKinds := map[string]bool{
 		HTTPRoute: true,
+		GRPCRoute: true,
 		TCPRoute:  true,
+		TLSRoute:  true,
 	}
 
 	// These should already be validated by upstream validation
@@ -1183,7 +1422,7 @@ func (e *BoundAPIGatewayConfigEntry) Validate() error {
 		}
 		for _, route := range listener.Routes {
 			if !allowedRouteKinds[route.Kind] {
-				return fmt.Errorf("unsupported route kind: %q, must be one of 'http-route', or 'tcp-route'", route.Kind)
+				return fmt.Errorf("unsupported route kind: %q, must be one of 'http-route', 'grpc-route', or 'tcp-route'", route.Kind)
 			}
 			if route.Name == "" {
 				return fmt.Errorf("route reference must have a name")
@@ -4,11 +4,14 @@
 package structs
 
 import (
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/types"
 )
 
 func TestIngressGatewayConfigEntry(t *testing.T) {
@@ -506,6 +509,195 @@ func TestIngressGatewayConfigEntry(t *testing.T) {
 			},
 			validateErr: "response headers only valid for http",
 		},
+		"cors policy": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						Services: []IngressService{
+							{
+								Name: "web",
+								CORS: &CORSPolicy{
+									AllowOrigins: []string{"*"},
+									AllowMethods: []string{"GET"},
+									MaxAge:       time.Hour,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"cors policy not allowed for non-http protocol": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "tcp",
+						Services: []IngressService{
+							{
+								Name: "db",
+								CORS: &CORSPolicy{
+									AllowOrigins: []string{"*"},
+								},
+							},
+						},
+					},
+				},
+			},
+			validateErr: "CORS is only valid for HTTP-based protocols",
+		},
+		"cors policy with credentials and wildcard origin": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						Services: []IngressService{
+							{
+								Name: "web",
+								CORS: &CORSPolicy{
+									AllowOrigins:     []string{"*"},
+									AllowCredentials: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			validateErr: "CORS AllowCredentials cannot be used with a wildcard origin",
+		},
+		"request limits": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						RateLimit: &LocalRateLimit{
+							RequestsPerSecond: 100,
+						},
+						MaxRequestBytes:     1048576,
+						MaxRequestHeadersKB: 32,
+						Services: []IngressService{
+							{
+								Name: "web",
+								RateLimit: &LocalRateLimit{
+									RequestsPerSecond: 10,
+								},
+								MaxRequestBytes: 4096,
+							},
+						},
+					},
+				},
+			},
+		},
+		"listener request limits not allowed for non-http protocol": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:                1111,
+						Protocol:            "tcp",
+						MaxRequestHeadersKB: 32,
+						Services: []IngressService{
+							{
+								Name: "db",
+							},
+						},
+					},
+				},
+			},
+			validateErr: "RateLimit, MaxRequestBytes and MaxRequestHeadersKB are only valid for HTTP-based protocols (listener on port 1111)",
+		},
+		"service request limits not allowed for non-http protocol": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "tcp",
+						Services: []IngressService{
+							{
+								Name:            "db",
+								MaxRequestBytes: 4096,
+							},
+						},
+					},
+				},
+			},
+			validateErr: "RateLimit and MaxRequestBytes are only valid for HTTP-based protocols",
+		},
+		"invalid listener rate limit": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:      1111,
+						Protocol:  "http",
+						RateLimit: &LocalRateLimit{},
+						Services: []IngressService{
+							{
+								Name: "web",
+							},
+						},
+					},
+				},
+			},
+			validateErr: "RateLimit RequestsPerSecond must be greater than 0 (listener on port 1111)",
+		},
+		"invalid service rate limit": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:     1111,
+						Protocol: "http",
+						Services: []IngressService{
+							{
+								Name: "web",
+								RateLimit: &LocalRateLimit{
+									RequestsPerSecond: 10,
+									RequestsMaxBurst:  5,
+								},
+							},
+						},
+					},
+				},
+			},
+			validateErr: "RateLimit RequestsMaxBurst must be equal to or greater than RequestsPerSecond",
+		},
+		"max request headers too large": {
+			entry: &IngressGatewayConfigEntry{
+				Kind: "ingress-gateway",
+				Name: "ingress-web",
+				Listeners: []IngressListener{
+					{
+						Port:                1111,
+						Protocol:            "http",
+						MaxRequestHeadersKB: 8193,
+						Services: []IngressService{
+							{
+								Name: "web",
+							},
+						},
+					},
+				},
+			},
+			validateErr: "MaxRequestHeadersKB must be at most 8192 (listener on port 1111)",
+		},
 		"duplicate services not allowed": {
 			entry: &IngressGatewayConfigEntry{
 				Kind: "ingress-gateway",
@@ -1056,6 +1248,100 @@ func TestTerminatingGatewayConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		"http health check": {
+			entry: &TerminatingGatewayConfigEntry{
+				Kind: "terminating-gateway",
+				Name: "terminating-gw-west",
+				Services: []LinkedService{
+					{
+						Name: "web",
+						HealthCheck: &LinkedServiceHealthCheck{
+							Type:     LinkedServiceHealthCheckHTTP,
+							Path:     "/healthz",
+							Interval: 5 * time.Second,
+						},
+					},
+				},
+			},
+		},
+		"tls health check": {
+			entry: &TerminatingGatewayConfigEntry{
+				Kind: "terminating-gateway",
+				Name: "terminating-gw-west",
+				Services: []LinkedService{
+					{
+						Name:   "web",
+						CAFile: "ca.crt",
+						SNI:    "web.example.com",
+						HealthCheck: &LinkedServiceHealthCheck{
+							Type: LinkedServiceHealthCheckTLS,
+						},
+					},
+				},
+			},
+		},
+		"tls health check without ca file": {
+			entry: &TerminatingGatewayConfigEntry{
+				Kind: "terminating-gateway",
+				Name: "terminating-gw-west",
+				Services: []LinkedService{
+					{
+						Name: "web",
+						HealthCheck: &LinkedServiceHealthCheck{
+							Type: LinkedServiceHealthCheckTLS,
+						},
+					},
+				},
+			},
+			validateErr: "must have a CAFile specified for a tls HealthCheck",
+		},
+		"health check invalid type": {
+			entry: &TerminatingGatewayConfigEntry{
+				Kind: "terminating-gateway",
+				Name: "terminating-gw-west",
+				Services: []LinkedService{
+					{
+						Name: "web",
+						HealthCheck: &LinkedServiceHealthCheck{
+							Type: "grpc",
+						},
+					},
+				},
+			},
+			validateErr: `Type must be one of "tcp", "http", or "tls"`,
+		},
+		"health check path on tcp check": {
+			entry: &TerminatingGatewayConfigEntry{
+				Kind: "terminating-gateway",
+				Name: "terminating-gw-west",
+				Services: []LinkedService{
+					{
+						Name: "web",
+						HealthCheck: &LinkedServiceHealthCheck{
+							Type: LinkedServiceHealthCheckTCP,
+							Path: "/healthz",
+						},
+					},
+				},
+			},
+			validateErr: "Path is only valid for http health checks",
+		},
+		"health check negative timeout": {
+			entry: &TerminatingGatewayConfigEntry{
+				Kind: "terminating-gateway",
+				Name: "terminating-gw-west",
+				Services: []LinkedService{
+					{
+						Name: "web",
+						HealthCheck: &LinkedServiceHealthCheck{
+							Type:    LinkedServiceHealthCheckHTTP,
+							Timeout: -time.Second,
+						},
+					},
+				},
+			},
+			validateErr: "Timeout cannot be negative",
+		},
 		"only providing ca file is allowed": {
 			entry: &TerminatingGatewayConfigEntry{
 				Kind: "terminating-gateway",
@@ -1235,6 +1521,124 @@ func TestAPIGateway_Listeners(t *testing.T) {
 			},
 			validateErr: "unsupported listener protocol",
 		},
+		"http2 and grpc protocols": {
+			entry: &APIGatewayConfigEntry{
+				Kind: "api-gateway",
+				Name: "api-gw-four",
+				Listeners: []APIGatewayListener{
+					{
+						Name:     "http2",
+						Port:     80,
+						Hostname: "host.one",
+						Protocol: APIGatewayListenerProtocol("HTTP2"),
+					},
+					{
+						Name:     "grpc",
+						Port:     81,
+						Protocol: ListenerProtocolGRPC,
+					},
+				},
+			},
+			check: func(t *testing.T, entry ConfigEntry) {
+				gateway := entry.(*APIGatewayConfigEntry)
+				require.Equal(t, ListenerProtocolHTTP2, gateway.Listeners[0].Protocol)
+				require.Equal(t, ListenerProtocolGRPC, gateway.Listeners[1].Protocol)
+			},
+		},
+		"passthrough listener": {
+			entry: &APIGatewayConfigEntry{
+				Kind: "api-gateway",
+				Name: "api-gw-passthrough",
+				Listeners: []APIGatewayListener{
+					{
+						Name:     "passthrough",
+						Port:     443,
+						Hostname: "*.example.com",
+						Protocol: ListenerProtocolTCP,
+						TLS: APIGatewayTLSConfiguration{
+							Mode: "PassThrough",
+						},
+					},
+				},
+			},
+			check: func(t *testing.T, entry ConfigEntry) {
+				gateway := entry.(*APIGatewayConfigEntry)
+				require.Equal(t, APIGatewayTLSModePassthrough, gateway.Listeners[0].TLS.Mode)
+			},
+		},
+		"passthrough listener with http protocol": {
+			entry: &APIGatewayConfigEntry{
+				Kind: "api-gateway",
+				Name: "api-gw-passthrough",
+				Listeners: []APIGatewayListener{
+					{
+						Name:     "passthrough",
+						Port:     443,
+						Protocol: ListenerProtocolHTTP,
+						TLS: APIGatewayTLSConfiguration{
+							Mode: APIGatewayTLSModePassthrough,
+						},
+					},
+				},
+			},
+			validateErr: "must use the 'tcp' protocol to use TLS passthrough",
+		},
+		"passthrough listener with certificates": {
+			entry: &APIGatewayConfigEntry{
+				Kind: "api-gateway",
+				Name: "api-gw-passthrough",
+				Listeners: []APIGatewayListener{
+					{
+						Name:     "passthrough",
+						Port:     443,
+						Protocol: ListenerProtocolTCP,
+						TLS: APIGatewayTLSConfiguration{
+							Mode: APIGatewayTLSModePassthrough,
+							Certificates: []ResourceReference{{
+								Kind: InlineCertificate,
+								Name: "cert",
+							}},
+						},
+					},
+				},
+			},
+			validateErr: "cannot specify certificates when using TLS passthrough",
+		},
+		"passthrough listener with tls versions": {
+			entry: &APIGatewayConfigEntry{
+				Kind: "api-gateway",
+				Name: "api-gw-passthrough",
+				Listeners: []APIGatewayListener{
+					{
+						Name:     "passthrough",
+						Port:     443,
+						Protocol: ListenerProtocolTCP,
+						TLS: APIGatewayTLSConfiguration{
+							Mode:       APIGatewayTLSModePassthrough,
+							MinVersion: types.TLSv1_2,
+						},
+					},
+				},
+			},
+			validateErr: "cannot specify TLS versions or cipher suites when using TLS passthrough",
+		},
+		"invalid tls mode": {
+			entry: &APIGatewayConfigEntry{
+				Kind: "api-gateway",
+				Name: "api-gw-passthrough",
+				Listeners: []APIGatewayListener{
+					{
+						Name:     "listener",
+						Port:     443,
+						Protocol: ListenerProtocolTCP,
+						TLS: APIGatewayTLSConfiguration{
+							Mode: "mirror",
+						},
+					},
+				},
+			},
+			validateErr: "unsupported TLS mode \"mirror\"",
+		},
 		"hostname in unsupported protocol": {
 			entry: &APIGatewayConfigEntry{
 				Kind: "api-gateway",
@@ -1323,6 +1727,34 @@ func TestAPIGateway_Listeners(t *testing.T) {
 	testConfigEntryNormalizeAndValidate(t, cases)
 }
 
+func TestAPIGatewayListenerProtocol_AcceptsRoute(t *testing.T) {
+	for listener, accepted := range map[APIGatewayListenerProtocol][]APIGatewayListenerProtocol{
+		ListenerProtocolHTTP:  {ListenerProtocolHTTP},
+		ListenerProtocolHTTP2: {ListenerProtocolHTTP, ListenerProtocolGRPC},
+		ListenerProtocolGRPC:  {ListenerProtocolGRPC},
+		ListenerProtocolTCP:   {ListenerProtocolTCP},
+	} {
+		for _, route := range []APIGatewayListenerProtocol{ListenerProtocolHTTP, ListenerProtocolGRPC, ListenerProtocolTCP} {
+			require.Equal(t, slices.Contains(accepted, route), listener.AcceptsRoute(route), "listener %s, route %s", listener, route)
+		}
+	}
+}
+
+func TestAPIGatewayListener_AcceptsRouteKind(t *testing.T) {
+	terminate := APIGatewayListener{Protocol: ListenerProtocolTCP}
+	passthrough := APIGatewayListener{
+		Protocol: ListenerProtocolTCP,
+		TLS:      APIGatewayTLSConfiguration{Mode: APIGatewayTLSModePassthrough},
+	}
+
+	for _, kind := range []string{HTTPRoute, GRPCRoute, TCPRoute} {
+		require.True(t, terminate.AcceptsRouteKind(kind), kind)
+		require.False(t, passthrough.AcceptsRouteKind(kind), kind)
+	}
+	require.False(t, terminate.AcceptsRouteKind(TLSRoute))
+	require.True(t, passthrough.AcceptsRouteKind(TLSRoute))
+}
+
 func TestBoundAPIGateway(t *testing.T) {
 	cases := map[string]configEntryTestcase{
 		"invalid certificate, no name": {
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/consul/acl"
+)
+
+// ImportedServicesConfigEntry controls which of the services exported by a
+// cluster peer are imported into the local catalog. Without this entry every
+// service exported to the local cluster is imported.
+type ImportedServicesConfigEntry struct {
+	// Name is the local name of the peer the filters apply to.
+	Name string
+
+	// Allow is a list of filters of which at least one must match a service
+	// instance for it to be imported. When empty, all instances that are not
+	// denied are imported.
+	Allow []ImportedServiceFilter `json:",omitempty"`
+
+	// Deny is a list of filters matching service instances that must not be
+	// imported. Deny filters take precedence over Allow filters.
+	Deny []ImportedServiceFilter `json:",omitempty"`
+
+	Meta               map[string]string `json:",omitempty"`
+	Hash               uint64            `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+// ImportedServiceFilter matches service instances imported from a peer. All
+// of the specified fields must match for the filter to apply.
+type ImportedServiceFilter struct {
+	// Name is the name of the service, or the wildcard "*" to match all
+	// services.
+	Name string
+
+	// Namespace is the namespace of the service in the exporting cluster, or
+	// the wildcard "*" to match all namespaces.
+	Namespace string `json:",omitempty"`
+
+	// Tags is a list of tags that the service instance must all have.
+	Tags []string `json:",omitempty"`
+
+	// ServiceMeta is a set of key/value pairs that the service instance
+	// metadata must all contain.
+	ServiceMeta map[string]string `json:",omitempty" alias:"service_meta"`
+}
+
+func (e *ImportedServicesConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *ImportedServicesConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *ImportedServicesConfigEntry) GetKind() string {
+	return ImportedServices
+}
+
+func (e *ImportedServicesConfigEntry) GetName() string {
+	if e == nil {
+		return ""
+	}
+
+	return e.Name
+}
+
+func (e *ImportedServicesConfigEntry) GetMeta() map[string]string {
+	if e == nil {
+		return nil
+	}
+	return e.Meta
+}
+
+func (e *ImportedServicesConfigEntry) Normalize() error {
+	if e == nil {
+		return fmt.Errorf("config entry is nil")
+	}
+	e.EnterpriseMeta.Normalize()
+
+	for i := range e.Allow {
+		e.Allow[i].Namespace = acl.NormalizeNamespace(e.Allow[i].Namespace)
+	}
+	for i := range e.Deny {
+		e.Deny[i].Namespace = acl.NormalizeNamespace(e.Deny[i].Namespace)
+	}
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+
+	return nil
+}
+
+func (e *ImportedServicesConfigEntry) Validate() error {
+	if e.Name == "" {
+		return fmt.Errorf("Name is required")
+	}
+	if e.Name == WildcardSpecifier {
+		return fmt.Errorf("Name must be the name of a single peer; wildcards are not supported")
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	if err := validateImportedServiceFilters("Allow", e.Allow); err != nil {
+		return err
+	}
+	return validateImportedServiceFilters("Deny", e.Deny)
+}
+
+func validateImportedServiceFilters(field string, filters []ImportedServiceFilter) error {
+	for i, f := range filters {
+		if f.Name == "" {
+			return fmt.Errorf("%s[%d]: service name cannot be empty", field, i)
+		}
+		for j, tag := range f.Tags {
+			if tag == "" {
+				return fmt.Errorf("%s[%d].Tags[%d]: tag cannot be empty", field, i, j)
+			}
+		}
+		for k := range f.ServiceMeta {
+			if k == "" {
+				return fmt.Errorf("%s[%d].ServiceMeta: key cannot be empty", field, i)
+			}
+		}
+	}
+	return nil
+}
+
+func (e *ImportedServicesConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *ImportedServicesConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+func (e *ImportedServicesConfigEntry) GetRaftIndex() *RaftIndex {
+	if e == nil {
+		return &RaftIndex{}
+	}
+
+	return &e.RaftIndex
+}
+
+func (e *ImportedServicesConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta {
+	if e == nil {
+		return nil
+	}
+
+	return &e.EnterpriseMeta
+}
+
+// ImportsService returns whether any instance of the service could be
+// imported. It only considers the name and namespace of the filters, so that
+// it can be used for services whose instances are not known, such as the
+// synthetic sidecar proxies of imported services. A nil entry imports all
+// services.
+func (e *ImportedServicesConfigEntry) ImportsService(sn ServiceName) bool {
+	if e == nil {
+		return true
+	}
+
+	if len(e.Allow) > 0 {
+		allowed := false
+		for _, f := range e.Allow {
+			if f.matchesServiceName(sn) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+
+	for _, f := range e.Deny {
+		// Deny filters that constrain tags or metadata only apply to some
+		// instances of the service.
+		if f.matchesServiceName(sn) && !f.hasInstanceFilters() {
+			return false
+		}
+	}
+	return true
+}
+
+// ImportsInstance returns whether the service instance should be imported.
+// A nil entry imports all instances.
+func (e *ImportedServicesConfigEntry) ImportsInstance(svc *NodeService) bool {
+	if e == nil {
+		return true
+	}
+
+	if len(e.Allow) > 0 {
+		allowed := false
+		for _, f := range e.Allow {
+			if f.matchesInstance(svc) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+
+	for _, f := range e.Deny {
+		if f.matchesInstance(svc) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *ImportedServiceFilter) hasInstanceFilters() bool {
+	return len(f.Tags) > 0 || len(f.ServiceMeta) > 0
+}
+
+func (f *ImportedServiceFilter) matchesServiceName(sn ServiceName) bool {
+	if f.Name != WildcardSpecifier && f.Name != sn.Name {
+		return false
+	}
+	if f.Namespace == WildcardSpecifier {
+		return true
+	}
+	return acl.NamespaceOrDefault(f.Namespace) == sn.NamespaceOrDefault()
+}
+
+func (f *ImportedServiceFilter) matchesInstance(svc *NodeService) bool {
+	if !f.matchesServiceName(svc.CompoundServiceName()) {
+		return false
+	}
+
+	for _, want := range f.Tags {
+		found := false
+		for _, tag := range svc.Tags {
+			if tag == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for k, v := range f.ServiceMeta {
+		if got, ok := svc.Meta[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalJSON adds the Kind field so that the JSON can be decoded back into the
+// correct type.
+// This method is implemented on the structs type (as apposed to the api type)
+// because that is what the API currently uses to return a response.
+func (e *ImportedServicesConfigEntry) MarshalJSON() ([]byte, error) {
+	type Alias ImportedServicesConfigEntry
+	source := &struct {
+		Kind string
+		*Alias
+	}{
+		Kind:  ImportedServices,
+		Alias: (*Alias)(e),
+	}
+	return json.Marshal(source)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package structs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportedServicesConfigEntry(t *testing.T) {
+	cases := map[string]configEntryTestcase{
+		"validate: empty name": {
+			entry:       &ImportedServicesConfigEntry{},
+			validateErr: `Name is required`,
+		},
+		"validate: wildcard name": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "*",
+			},
+			validateErr: `wildcards are not supported`,
+		},
+		"validate: empty allowed service name": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "cluster-02",
+				Allow: []ImportedServiceFilter{
+					{Name: "web"},
+					{Name: ""},
+				},
+			},
+			validateErr: `Allow[1]: service name cannot be empty`,
+		},
+		"validate: empty denied service name": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "cluster-02",
+				Deny: []ImportedServiceFilter{
+					{Tags: []string{"canary"}},
+				},
+			},
+			validateErr: `Deny[0]: service name cannot be empty`,
+		},
+		"validate: empty tag": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "cluster-02",
+				Deny: []ImportedServiceFilter{
+					{Name: "*", Tags: []string{"canary", ""}},
+				},
+			},
+			validateErr: `Deny[0].Tags[1]: tag cannot be empty`,
+		},
+		"validate: empty service meta key": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "cluster-02",
+				Allow: []ImportedServiceFilter{
+					{Name: "*", ServiceMeta: map[string]string{"": "prod"}},
+				},
+			},
+			validateErr: `Allow[0].ServiceMeta: key cannot be empty`,
+		},
+		"normalize and validate": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "cluster-02",
+				Allow: []ImportedServiceFilter{
+					{Name: "web", Tags: []string{"v2"}},
+				},
+				Deny: []ImportedServiceFilter{
+					{Name: "*", ServiceMeta: map[string]string{"env": "dev"}},
+				},
+			},
+			expectUnchanged: true,
+		},
+	}
+
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
+func TestImportedServicesConfigEntry_Imports(t *testing.T) {
+	instance := func(name string, tags []string, meta map[string]string) *NodeService {
+		return &NodeService{
+			ID:      name + "-1",
+			Service: name,
+			Tags:    tags,
+			Meta:    meta,
+		}
+	}
+
+	type testCase struct {
+		entry *ImportedServicesConfigEntry
+
+		// expectService is keyed by service name.
+		expectService  map[string]bool
+		expectInstance map[*NodeService]bool
+	}
+
+	var (
+		webV1   = instance("web", []string{"v1"}, nil)
+		webV2   = instance("web", []string{"v2", "canary"}, map[string]string{"env": "prod"})
+		webDev  = instance("web", []string{"v2"}, map[string]string{"env": "dev"})
+		api     = instance("api", nil, map[string]string{"env": "prod"})
+		billing = instance("billing", []string{"v1"}, nil)
+	)
+
+	run := func(t *testing.T, tc testCase) {
+		for name, expect := range tc.expectService {
+			require.Equal(t, expect, tc.entry.ImportsService(NewServiceName(name, nil)), "service %q", name)
+		}
+		for svc, expect := range tc.expectInstance {
+			require.Equal(t, expect, tc.entry.ImportsInstance(svc), "instance %q with tags %v", svc.Service, svc.Tags)
+		}
+	}
+
+	tt := map[string]testCase{
+		"nil entry imports everything": {
+			entry:          nil,
+			expectService:  map[string]bool{"web": true, "api": true},
+			expectInstance: map[*NodeService]bool{webV1: true, api: true},
+		},
+		"empty entry imports everything": {
+			entry:          &ImportedServicesConfigEntry{Name: "cluster-02"},
+			expectService:  map[string]bool{"web": true, "api": true},
+			expectInstance: map[*NodeService]bool{webV1: true, api: true},
+		},
+		"allow by name": {
+			entry: &ImportedServicesConfigEntry{
+				Name:  "cluster-02",
+				Allow: []ImportedServiceFilter{{Name: "web"}},
+			},
+			expectService:  map[string]bool{"web": true, "api": false},
+			expectInstance: map[*NodeService]bool{webV1: true, webV2: true, api: false},
+		},
+		"deny by name": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "cluster-02",
+				Deny: []ImportedServiceFilter{{Name: "billing"}},
+			},
+			expectService:  map[string]bool{"web": true, "billing": false},
+			expectInstance: map[*NodeService]bool{webV1: true, billing: false},
+		},
+		"deny takes precedence over allow": {
+			entry: &ImportedServicesConfigEntry{
+				Name:  "cluster-02",
+				Allow: []ImportedServiceFilter{{Name: "*"}},
+				Deny:  []ImportedServiceFilter{{Name: "billing"}},
+			},
+			expectService:  map[string]bool{"web": true, "billing": false},
+			expectInstance: map[*NodeService]bool{webV1: true, billing: false},
+		},
+		"allow by tag and meta": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "cluster-02",
+				Allow: []ImportedServiceFilter{
+					{Name: "web", Tags: []string{"v2"}, ServiceMeta: map[string]string{"env": "prod"}},
+					{Name: "api"},
+				},
+			},
+			// Service-level decisions ignore the tag and meta constraints.
+			expectService: map[string]bool{"web": true, "api": true, "billing": false},
+			expectInstance: map[*NodeService]bool{
+				webV1:  false,
+				webV2:  true,
+				webDev: false,
+				api:    true,
+			},
+		},
+		"deny by tag only affects matching instances": {
+			entry: &ImportedServicesConfigEntry{
+				Name: "cluster-02",
+				Deny: []ImportedServiceFilter{{Name: "*", Tags: []string{"canary"}}},
+			},
+			expectService: map[string]bool{"web": true},
+			expectInstance: map[*NodeService]bool{
+				webV1: true,
+				webV2: false,
+			},
+		},
+		"wildcard namespace": {
+			entry: &ImportedServicesConfigEntry{
+				Name:  "cluster-02",
+				Allow: []ImportedServiceFilter{{Name: "web", Namespace: "*"}},
+			},
+			expectService:  map[string]bool{"web": true, "api": false},
+			expectInstance: map[*NodeService]bool{webV1: true, api: false},
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			run(t, tc)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package structs
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -90,6 +91,17 @@ func (e *HTTPRouteConfigEntry) GetServices() []HTTPService {
 	return targets
 }
 
+// RespondsDirectly returns true if any rule of the route answers requests
+// with a redirect or direct response instead of routing them to a service.
+func (e *HTTPRouteConfigEntry) RespondsDirectly() bool {
+	for _, rule := range e.Rules {
+		if rule.Filters.RequestRedirect != nil || rule.Filters.DirectResponse != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *HTTPRouteConfigEntry) Normalize() error {
 	for i, parent := range e.Parents {
 		if parent.Kind == "" {
@@ -191,6 +203,10 @@ func validateRule(rule HTTPRouteRule) error {
 		return err
 	}
 
+	if err := validateRuleResponseFilters(rule); err != nil {
+		return err
+	}
+
 	for i, match := range rule.Matches {
 		if err := validateMatch(match); err != nil {
 			return fmt.Errorf("Match[%d], %w", i, err)
@@ -203,6 +219,12 @@ func validateRule(rule HTTPRouteRule) error {
 		}
 	}
 
+	if rule.CORS != nil {
+		if err := rule.CORS.Validate(); err != nil {
+			return fmt.Errorf("CORS %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -233,9 +255,47 @@ func validateMatch(match HTTPMatch) error {
 }
 
 func validateHTTPService(service HTTPService) error {
+	if err := validateServiceFilters(service.Filters); err != nil {
+		return err
+	}
 	return validateFilters(service.Filters)
 }
 
+// validateRuleResponseFilters checks the filters that answer requests
+// at the gateway rather than routing them to the rule's services.
+func validateRuleResponseFilters(rule HTTPRouteRule) error {
+	redirect, direct := rule.Filters.RequestRedirect, rule.Filters.DirectResponse
+	if redirect == nil && direct == nil {
+		return nil
+	}
+
+	if redirect != nil && direct != nil {
+		return errors.New("HTTPFilters, cannot specify both RequestRedirect and DirectResponse")
+	}
+
+	if len(rule.Services) > 0 {
+		return errors.New("HTTPFilters, Services cannot be specified with RequestRedirect or DirectResponse")
+	}
+
+	if rule.Filters.URLRewrite != nil {
+		return errors.New("HTTPFilters, URLRewrite cannot be specified with RequestRedirect or DirectResponse")
+	}
+
+	return nil
+}
+
+// validateServiceFilters rejects filters that are only valid on a rule,
+// since they replace routing to the rule's services entirely.
+func validateServiceFilters(filter HTTPFilters) error {
+	if filter.RequestRedirect != nil {
+		return errors.New("HTTPFilters, RequestRedirect is only supported on rule filters")
+	}
+	if filter.DirectResponse != nil {
+		return errors.New("HTTPFilters, DirectResponse is only supported on rule filters")
+	}
+	return nil
+}
+
 func validateFilters(filter HTTPFilters) error {
 	for i, header := range filter.Headers {
 		if err := validateHeaderFilter(header); err != nil {
@@ -247,6 +307,18 @@ func validateFilters(filter HTTPFilters) error {
 		return fmt.Errorf("HTTPFilters, URLRewrite, %w", err)
 	}
 
+	if filter.RequestRedirect != nil {
+		if err := filter.RequestRedirect.Validate(); err != nil {
+			return fmt.Errorf("HTTPFilters, RequestRedirect, %w", err)
+		}
+	}
+
+	if filter.DirectResponse != nil {
+		if err := filter.DirectResponse.Validate(); err != nil {
+			return fmt.Errorf("HTTPFilters, DirectResponse, %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -324,7 +396,11 @@ func (e *HTTPRouteConfigEntry) CanWrite(authz acl.Authorizer) error {
 }
 
 func (e *HTTPRouteConfigEntry) FilteredHostnames(listenerHostname string) []string {
-	if len(e.Hostnames) == 0 {
+	return filteredHostnames(e.Hostnames, listenerHostname)
+}
+
+func filteredHostnames(routeHostnames []string, listenerHostname string) []string {
+	if len(routeHostnames) == 0 {
 		// we have no hostnames specified here, so treat it like a wildcard
 		return []string{listenerHostname}
 	}
@@ -333,7 +409,7 @@ func (e *HTTPRouteConfigEntry) FilteredHostnames(listenerHostname string) []stri
 	listenerHostname = strings.TrimPrefix(strings.TrimPrefix(listenerHostname, "*"), ".")
 
 	hostnames := []string{}
-	for _, hostname := range e.Hostnames {
+	for _, hostname := range routeHostnames {
 		if wildcardHostname {
 			if strings.HasSuffix(hostname, listenerHostname) {
 				hostnames = append(hostnames, hostname)
@@ -470,6 +546,14 @@ type HTTPFilters struct {
 	RetryFilter   *RetryFilter
 	TimeoutFilter *TimeoutFilter
 	JWT           *JWTFilter
+
+	// RequestRedirect responds to matching requests with a redirect
+	// instead of routing them to a service.
+	RequestRedirect *RequestRedirectFilter `json:",omitempty"`
+
+	// DirectResponse responds to matching requests with a fixed response
+	// instead of routing them to a service.
+	DirectResponse *DirectResponseFilter `json:",omitempty"`
 }
 
 // HTTPResponseFilters specifies a list of filters used to modify the
@@ -501,6 +585,92 @@ type TimeoutFilter struct {
 	IdleTimeout    time.Duration
 }
 
+const (
+	// DefaultRequestRedirectStatusCode is the status code used for
+	// redirects that don't specify one.
+	DefaultRequestRedirectStatusCode = 302
+
+	// maxDirectResponseBodySize mirrors the default limit Envoy places on
+	// the body of direct responses.
+	maxDirectResponseBodySize = 4096
+)
+
+// RequestRedirectFilter redirects a request to a different location. Any
+// part of the location that is not set is taken from the original request.
+type RequestRedirectFilter struct {
+	// Scheme is the scheme of the redirect location, either "http" or "https".
+	Scheme string `json:",omitempty"`
+
+	// Hostname is the hostname of the redirect location.
+	Hostname string `json:",omitempty"`
+
+	// Port is the port of the redirect location.
+	Port uint32 `json:",omitempty"`
+
+	// Path replaces the full path of the request.
+	Path string `json:",omitempty"`
+
+	// StatusCode is the status code of the redirect response, one of
+	// 301, 302, 303, 307 or 308. Defaults to 302.
+	StatusCode int `json:",omitempty" alias:"status_code"`
+}
+
+func (r *RequestRedirectFilter) Validate() error {
+	switch r.Scheme {
+	case "", "http", "https":
+	default:
+		return fmt.Errorf("Scheme must be one of 'http' or 'https', got %q", r.Scheme)
+	}
+
+	if r.Hostname != "" {
+		if _, ok := dns.IsDomainName(r.Hostname); !ok || strings.ContainsRune(r.Hostname, '*') {
+			return fmt.Errorf("Hostname %q must be a valid DNS hostname", r.Hostname)
+		}
+	}
+
+	if r.Port > 65535 {
+		return fmt.Errorf("Port must be between 1 and 65535, got %d", r.Port)
+	}
+
+	if r.Path != "" && !strings.HasPrefix(r.Path, "/") {
+		return fmt.Errorf("Path must begin with a '/', got %q", r.Path)
+	}
+
+	if r.Scheme == "" && r.Hostname == "" && r.Port == 0 && r.Path == "" {
+		return errors.New("must set at least one of Scheme, Hostname, Port or Path")
+	}
+
+	switch r.StatusCode {
+	case 0, 301, 302, 303, 307, 308:
+	default:
+		return fmt.Errorf("StatusCode must be one of 301, 302, 303, 307 or 308, got %d", r.StatusCode)
+	}
+
+	return nil
+}
+
+// DirectResponseFilter responds to a request with a fixed status code and
+// body, for example to serve a maintenance page.
+type DirectResponseFilter struct {
+	// StatusCode is the status code of the response.
+	StatusCode int `alias:"status_code"`
+
+	// Body is the body of the response.
+	Body string `json:",omitempty"`
+}
+
+func (r *DirectResponseFilter) Validate() error {
+	if r.StatusCode < 200 || r.StatusCode > 599 {
+		return fmt.Errorf("StatusCode must be between 200 and 599, got %d", r.StatusCode)
+	}
+
+	if len(r.Body) > maxDirectResponseBodySize {
+		return fmt.Errorf("Body must not be larger than %d bytes", maxDirectResponseBodySize)
+	}
+
+	return nil
+}
+
 // HTTPRouteRule specifies the routing rules used to determine what upstream
 // service an HTTP request is routed to.
 type HTTPRouteRule struct {
@@ -517,6 +687,9 @@ type HTTPRouteRule struct {
 	// Services is a list of HTTP-based services to route to if the request matches
 	// the rules specified in the Matches field.
 	Services []HTTPService
+	// CORS configures the cross-origin resource sharing policy for requests
+	// matching this rule.
+	CORS *CORSPolicy `json:",omitempty"`
 }
 
 // HTTPService is a service reference for HTTP-based routing rules
@@ -676,3 +849,483 @@ type TCPService struct {
 func (s TCPService) ServiceName() ServiceName {
 	return NewServiceName(s.Name, &s.EnterpriseMeta)
 }
+
+// TLSRouteConfigEntry manages the configuration for a TLS route
+// with the given name. TLS routes are bound to passthrough listeners
+// and select an upstream service by the SNI hostname of the connection
+// without terminating TLS at the gateway.
+type TLSRouteConfigEntry struct {
+	// Kind of the config entry. This will be set to structs.TLSRoute.
+	Kind string
+
+	// Name is used to match the config entry with its associated set
+	// of resources.
+	Name string
+
+	// Parents is a list of gateways that this route should be bound to
+	Parents []ResourceReference
+
+	// Hostnames are the SNI hostnames for which this TLSRoute should
+	// accept connections. If empty, the route matches any hostname
+	// accepted by the listener.
+	Hostnames []string
+
+	// Services is a list of TCP-based services that this should route to.
+	// Currently, this must specify at maximum one service.
+	Services []TCPService
+
+	Meta map[string]string `json:",omitempty"`
+	// Status is the asynchronous reconciliation status which a TLSRoute propagates to the user.
+	Status             Status
+	Hash               uint64 `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+func (e *TLSRouteConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *TLSRouteConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *TLSRouteConfigEntry) GetKind() string                        { return TLSRoute }
+func (e *TLSRouteConfigEntry) GetName() string                        { return e.Name }
+func (e *TLSRouteConfigEntry) GetMeta() map[string]string             { return e.Meta }
+func (e *TLSRouteConfigEntry) GetRaftIndex() *RaftIndex               { return &e.RaftIndex }
+func (e *TLSRouteConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta { return &e.EnterpriseMeta }
+
+var _ ControlledConfigEntry = (*TLSRouteConfigEntry)(nil)
+
+func (e *TLSRouteConfigEntry) GetStatus() Status       { return e.Status }
+func (e *TLSRouteConfigEntry) SetStatus(status Status) { e.Status = status }
+func (e *TLSRouteConfigEntry) DefaultStatus() Status   { return Status{} }
+
+var _ BoundRoute = (*TLSRouteConfigEntry)(nil)
+
+func (e *TLSRouteConfigEntry) GetParents() []ResourceReference { return e.Parents }
+
+// GetProtocol returns the protocol of the listeners this route can be bound
+// to. Passthrough listeners proxy raw TCP connections, so TLSRoutes only bind
+// to TCP listeners that have their TLS mode set to passthrough.
+func (e *TLSRouteConfigEntry) GetProtocol() APIGatewayListenerProtocol { return ListenerProtocolTCP }
+
+func (e *TLSRouteConfigEntry) GetServiceNames() []ServiceName {
+	services := []ServiceName{}
+	for _, service := range e.Services {
+		services = append(services, NewServiceName(service.Name, &service.EnterpriseMeta))
+	}
+	return services
+}
+
+func (e *TLSRouteConfigEntry) GetServices() []TCPService { return e.Services }
+
+func (e *TLSRouteConfigEntry) Normalize() error {
+	for i, parent := range e.Parents {
+		if parent.Kind == "" {
+			parent.Kind = APIGateway
+		}
+		parent.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
+		parent.EnterpriseMeta.Normalize()
+		e.Parents[i] = parent
+	}
+
+	for i, service := range e.Services {
+		service.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
+		service.EnterpriseMeta.Normalize()
+		e.Services[i] = service
+	}
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+	return nil
+}
+
+func (e *TLSRouteConfigEntry) Validate() error {
+	for _, host := range e.Hostnames {
+		// SNI hostnames may only use a wildcard as the left-most label
+		if _, ok := dns.IsDomainName(host); !ok {
+			return fmt.Errorf("host %q must be a valid DNS hostname", host)
+		}
+
+		if strings.ContainsRune(strings.TrimPrefix(host, wildcardPrefix), '*') {
+			return fmt.Errorf("host %q is not valid, a wildcard specifier is only allowed as the left-most label", host)
+		}
+	}
+
+	validParentKinds := map[string]bool{
+		APIGateway: true,
+	}
+
+	if len(e.Services) > 1 {
+		return fmt.Errorf("tls-route currently only supports one service")
+	}
+
+	uniques := make(map[ResourceReference]struct{}, len(e.Parents))
+
+	for _, parent := range e.Parents {
+		if !validParentKinds[parent.Kind] {
+			return fmt.Errorf("unsupported parent kind: %q, must be 'api-gateway'", parent.Kind)
+		}
+
+		if _, ok := uniques[parent]; ok {
+			return errors.New("route parents must be unique")
+		}
+		uniques[parent] = struct{}{}
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FilteredHostnames returns the SNI hostnames of this route that are
+// compatible with the given listener hostname.
+func (e *TLSRouteConfigEntry) FilteredHostnames(listenerHostname string) []string {
+	return filteredHostnames(e.Hostnames, listenerHostname)
+}
+
+func (e *TLSRouteConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *TLSRouteConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+// GRPCRouteConfigEntry manages the configuration for a gRPC route
+// with the given name.
+type GRPCRouteConfigEntry struct {
+	// Kind of the config entry. This will be set to structs.GRPCRoute.
+	Kind string
+
+	// Name is used to match the config entry with its associated set
+	// of resources, which may include routers, splitters, filters, etc.
+	Name string
+
+	// Parents is a list of gateways that this route should be bound to
+	Parents []ResourceReference
+	// Rules are a list of gRPC-based routing rules that this route should
+	// use for constructing a routing table.
+	Rules []GRPCRouteRule
+	// Hostnames are the hostnames for which this GRPCRoute should respond to requests.
+	Hostnames []string
+
+	Meta map[string]string `json:",omitempty"`
+	// Status is the asynchronous reconciliation status which a GRPCRoute propagates to the user.
+	Status             Status
+	Hash               uint64 `json:",omitempty" hash:"ignore"`
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	RaftIndex          `hash:"ignore"`
+}
+
+func (e *GRPCRouteConfigEntry) SetHash(h uint64) {
+	e.Hash = h
+}
+
+func (e *GRPCRouteConfigEntry) GetHash() uint64 {
+	return e.Hash
+}
+
+func (e *GRPCRouteConfigEntry) GetKind() string                        { return GRPCRoute }
+func (e *GRPCRouteConfigEntry) GetName() string                        { return e.Name }
+func (e *GRPCRouteConfigEntry) GetMeta() map[string]string             { return e.Meta }
+func (e *GRPCRouteConfigEntry) GetEnterpriseMeta() *acl.EnterpriseMeta { return &e.EnterpriseMeta }
+func (e *GRPCRouteConfigEntry) GetRaftIndex() *RaftIndex               { return &e.RaftIndex }
+
+var _ ControlledConfigEntry = (*GRPCRouteConfigEntry)(nil)
+
+func (e *GRPCRouteConfigEntry) GetStatus() Status       { return e.Status }
+func (e *GRPCRouteConfigEntry) SetStatus(status Status) { e.Status = status }
+func (e *GRPCRouteConfigEntry) DefaultStatus() Status   { return Status{} }
+
+var _ BoundRoute = (*GRPCRouteConfigEntry)(nil)
+
+func (e *GRPCRouteConfigEntry) GetParents() []ResourceReference         { return e.Parents }
+func (e *GRPCRouteConfigEntry) GetProtocol() APIGatewayListenerProtocol { return ListenerProtocolGRPC }
+
+func (e *GRPCRouteConfigEntry) GetServiceNames() []ServiceName {
+	services := []ServiceName{}
+	for _, service := range e.GetServices() {
+		services = append(services, NewServiceName(service.Name, &service.EnterpriseMeta))
+	}
+	return services
+}
+
+func (e *GRPCRouteConfigEntry) GetServices() []HTTPService {
+	targets := []HTTPService{}
+	for _, rule := range e.Rules {
+		targets = append(targets, rule.Services...)
+	}
+	return targets
+}
+
+func (e *GRPCRouteConfigEntry) Normalize() error {
+	for i, parent := range e.Parents {
+		if parent.Kind == "" {
+			parent.Kind = APIGateway
+		}
+		parent.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
+		parent.EnterpriseMeta.Normalize()
+		e.Parents[i] = parent
+	}
+
+	for i, rule := range e.Rules {
+		for j, match := range rule.Matches {
+			if match.Method.Match == "" {
+				match.Method.Match = GRPCMethodMatchExact
+			}
+			rule.Matches[j] = match
+		}
+
+		for j, service := range rule.Services {
+			service.EnterpriseMeta.Merge(e.GetEnterpriseMeta())
+			service.EnterpriseMeta.Normalize()
+			if service.Weight <= 0 {
+				service.Weight = 1
+			}
+			rule.Services[j] = service
+		}
+		e.Rules[i] = rule
+	}
+
+	h, err := HashConfigEntry(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = h
+
+	return nil
+}
+
+func (e *GRPCRouteConfigEntry) Validate() error {
+	for _, host := range e.Hostnames {
+		// validate that each host referenced in a valid dns name and has
+		// no wildcards in it
+		if _, ok := dns.IsDomainName(host); !ok {
+			return fmt.Errorf("host %q must be a valid DNS hostname", host)
+		}
+
+		if strings.ContainsRune(host, '*') {
+			return fmt.Errorf("host %q must not be a wildcard", host)
+		}
+	}
+
+	validParentKinds := map[string]bool{
+		APIGateway: true,
+	}
+
+	uniques := make(map[ResourceReference]struct{}, len(e.Parents))
+
+	for _, parent := range e.Parents {
+		if !validParentKinds[parent.Kind] {
+			return fmt.Errorf("unsupported parent kind: %q, must be 'api-gateway'", parent.Kind)
+		}
+
+		if _, ok := uniques[parent]; ok {
+			return errors.New("route parents must be unique")
+		}
+		uniques[parent] = struct{}{}
+	}
+
+	if err := validateConfigEntryMeta(e.Meta); err != nil {
+		return err
+	}
+
+	for i, rule := range e.Rules {
+		if err := validateGRPCRule(rule); err != nil {
+			return fmt.Errorf("Rule[%d], %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGRPCRule(rule GRPCRouteRule) error {
+	if err := validateGRPCFilters(rule.Filters); err != nil {
+		return err
+	}
+
+	for i, match := range rule.Matches {
+		if err := validateGRPCMatch(match); err != nil {
+			return fmt.Errorf("Match[%d], %w", i, err)
+		}
+	}
+
+	for i, service := range rule.Services {
+		if err := validateGRPCFilters(service.Filters); err != nil {
+			return fmt.Errorf("Service[%d], %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGRPCFilters(filter HTTPFilters) error {
+	// gRPC methods are addressed by their path, so rewriting it would
+	// route the request to a different method.
+	if filter.URLRewrite != nil {
+		return fmt.Errorf("HTTPFilters, URLRewrite is not supported on gRPC routes")
+	}
+	if filter.RequestRedirect != nil || filter.DirectResponse != nil {
+		return fmt.Errorf("HTTPFilters, RequestRedirect and DirectResponse are not supported on gRPC routes")
+	}
+	return validateFilters(filter)
+}
+
+func validateGRPCMatch(match GRPCMatch) error {
+	for i, header := range match.Headers {
+		if err := validateHTTPHeaderMatch(header); err != nil {
+			return fmt.Errorf("Headers[%d], %w", i, err)
+		}
+	}
+
+	if err := validateGRPCMethodMatch(match.Method); err != nil {
+		return fmt.Errorf("Method, %w", err)
+	}
+
+	return nil
+}
+
+func validateGRPCMethodMatch(method GRPCMethodMatch) error {
+	switch method.Match {
+	case GRPCMethodMatchExact:
+		if strings.ContainsRune(method.Service, '/') || strings.ContainsRune(method.Method, '/') {
+			return fmt.Errorf("exact type match must not contain '/'")
+		}
+		return nil
+	case GRPCMethodMatchRegularExpression:
+		return nil
+	default:
+		return fmt.Errorf("match type should be one of exact, or regex")
+	}
+}
+
+func (e *GRPCRouteConfigEntry) CanRead(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshReadAllowed(&authzContext)
+}
+
+func (e *GRPCRouteConfigEntry) CanWrite(authz acl.Authorizer) error {
+	var authzContext acl.AuthorizerContext
+	e.FillAuthzContext(&authzContext)
+	return authz.ToAllowAuthorizer().MeshWriteAllowed(&authzContext)
+}
+
+func (e *GRPCRouteConfigEntry) FilteredHostnames(listenerHostname string) []string {
+	return filteredHostnames(e.Hostnames, listenerHostname)
+}
+
+// ToHTTPRoute converts the gRPC route into the equivalent HTTP route. A gRPC
+// call is an HTTP/2 request to the path "/<service>/<method>", so each method
+// match is translated into a path match on the request.
+func (e *GRPCRouteConfigEntry) ToHTTPRoute() *HTTPRouteConfigEntry {
+	route := &HTTPRouteConfigEntry{
+		Kind:           HTTPRoute,
+		Name:           e.Name,
+		Parents:        e.Parents,
+		Hostnames:      e.Hostnames,
+		Meta:           e.Meta,
+		Rules:          make([]HTTPRouteRule, 0, len(e.Rules)),
+		EnterpriseMeta: e.EnterpriseMeta,
+	}
+
+	for _, rule := range e.Rules {
+		httpRule := HTTPRouteRule{
+			Filters:         rule.Filters,
+			ResponseFilters: rule.ResponseFilters,
+			Services:        rule.Services,
+		}
+		for _, match := range rule.Matches {
+			httpRule.Matches = append(httpRule.Matches, HTTPMatch{
+				Headers: match.Headers,
+				Path:    match.Method.toPathMatch(),
+			})
+		}
+		route.Rules = append(route.Rules, httpRule)
+	}
+
+	return route
+}
+
+// GRPCRouteRule specifies the routing rules used to determine what upstream
+// service a gRPC request is routed to.
+type GRPCRouteRule struct {
+	// Filters is a list of HTTP-based filters used to modify a request prior
+	// to routing it to the upstream service
+	Filters HTTPFilters
+	// ResponseFilters is a list of HTTP-based filters used to modify a response
+	// returned by the upstream service
+	ResponseFilters HTTPResponseFilters
+	// Matches specified the matching criteria used in the routing table. If a
+	// request matches the given GRPCMatch configuration, then traffic is routed
+	// to services specified in the Services field.
+	Matches []GRPCMatch
+	// Services is a list of services to route to if the request matches
+	// the rules specified in the Matches field.
+	Services []HTTPService
+}
+
+// GRPCMatch specifies the criteria that should be
+// used in determining whether or not a gRPC request should
+// be routed to a given set of services.
+type GRPCMatch struct {
+	Method  GRPCMethodMatch
+	Headers []HTTPHeaderMatch
+}
+
+// GRPCMethodMatchType specifies how gRPC method matching criteria
+// should be applied to a request.
+type GRPCMethodMatchType string
+
+const (
+	GRPCMethodMatchExact             GRPCMethodMatchType = "exact"
+	GRPCMethodMatchRegularExpression GRPCMethodMatchType = "regex"
+)
+
+// GRPCMethodMatch specifies how a match should be done on the
+// service and method of a gRPC request. An empty Service or Method
+// matches any value.
+type GRPCMethodMatch struct {
+	Match   GRPCMethodMatchType
+	Service string
+	Method  string
+}
+
+func (m GRPCMethodMatch) toPathMatch() HTTPPathMatch {
+	if m.Match == GRPCMethodMatchRegularExpression {
+		service, method := m.Service, m.Method
+		if service == "" {
+			service = "[^/]+"
+		}
+		if method == "" {
+			method = "[^/]+"
+		}
+		return HTTPPathMatch{
+			Match: HTTPPathMatchRegularExpression,
+			Value: "/" + service + "/" + method,
+		}
+	}
+
+	switch {
+	case m.Service != "" && m.Method != "":
+		return HTTPPathMatch{Match: HTTPPathMatchExact, Value: "/" + m.Service + "/" + m.Method}
+	case m.Service != "":
+		return HTTPPathMatch{Match: HTTPPathMatchPrefix, Value: "/" + m.Service + "/"}
+	case m.Method != "":
+		return HTTPPathMatch{Match: HTTPPathMatchRegularExpression, Value: "/[^/]+/" + regexp.QuoteMeta(m.Method)}
+	default:
+		return HTTPPathMatch{Match: HTTPPathMatchPrefix, Value: "/"}
+	}
+}
@@ -137,6 +137,95 @@ func TestTCPRoute(t *testing.T) {
 	testConfigEntryNormalizeAndValidate(t, cases)
 }
 
+func TestTLSRoute(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]configEntryTestcase{
+		"valid": {
+			entry: &TLSRouteConfigEntry{
+				Kind:      TLSRoute,
+				Name:      "route-one",
+				Hostnames: []string{"foo.example.com", "*.bar.example.com"},
+				Services: []TCPService{{
+					Name: "foo",
+				}},
+			},
+		},
+		"multiple services": {
+			entry: &TLSRouteConfigEntry{
+				Kind: TLSRoute,
+				Name: "route-one",
+				Services: []TCPService{{
+					Name: "foo",
+				}, {
+					Name: "bar",
+				}},
+			},
+			validateErr: "tls-route currently only supports one service",
+		},
+		"invalid hostname": {
+			entry: &TLSRouteConfigEntry{
+				Kind:      TLSRoute,
+				Name:      "route-one",
+				Hostnames: []string{"foo.*.example.com"},
+			},
+			validateErr: "a wildcard specifier is only allowed as the left-most label",
+		},
+		"normalize parent kind": {
+			entry: &TLSRouteConfigEntry{
+				Kind: TLSRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Name: "gateway",
+				}},
+				Services: []TCPService{{
+					Name: "foo",
+				}},
+			},
+			normalizeOnly: true,
+			check: func(t *testing.T, entry ConfigEntry) {
+				expectedParent := ResourceReference{
+					Kind:           APIGateway,
+					Name:           "gateway",
+					EnterpriseMeta: *acl.DefaultEnterpriseMeta(),
+				}
+				route := entry.(*TLSRouteConfigEntry)
+				require.Len(t, route.Parents, 1)
+				require.Equal(t, expectedParent, route.Parents[0])
+			},
+		},
+		"invalid parent kind": {
+			entry: &TLSRouteConfigEntry{
+				Kind: TLSRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Kind: "route",
+					Name: "gateway",
+				}},
+			},
+			validateErr: "unsupported parent kind",
+		},
+		"duplicate parents": {
+			entry: &TLSRouteConfigEntry{
+				Kind: TLSRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{
+					{
+						Kind: "api-gateway",
+						Name: "gateway",
+					},
+					{
+						Kind: "api-gateway",
+						Name: "gateway",
+					},
+				},
+			},
+			validateErr: "route parents must be unique",
+		},
+	}
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
 func TestHTTPRoute(t *testing.T) {
 	t.Parallel()
 
@@ -434,10 +523,438 @@ func TestHTTPRoute(t *testing.T) {
 				require.Equal(t, expectedParents[1], route.Parents[1])
 			},
 		},
+		"rule with cors policy": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-cors",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []HTTPRouteRule{{
+					CORS: &CORSPolicy{
+						AllowOrigins:       []string{"https://example.com"},
+						AllowOriginRegexes: []string{`https://.*\.example\.com`},
+						AllowMethods:       []string{"GET", "POST"},
+						AllowCredentials:   true,
+					},
+				}},
+			},
+		},
+		"rule with invalid cors origin regex": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-cors",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []HTTPRouteRule{{
+					CORS: &CORSPolicy{
+						AllowOriginRegexes: []string{"("},
+					},
+				}},
+			},
+			validateErr: "Rule[0], CORS AllowOriginRegexes[0] is not a valid regular expression",
+		},
+		"rule with https redirect": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-redirect",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RequestRedirect: &RequestRedirectFilter{
+							Scheme:     "https",
+							Port:       443,
+							StatusCode: 301,
+						},
+					},
+				}},
+			},
+		},
+		"rule with direct response": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-redirect",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						DirectResponse: &DirectResponseFilter{
+							StatusCode: 503,
+							Body:       "down for maintenance",
+						},
+					},
+				}},
+			},
+		},
+		"rule with invalid redirect scheme": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-redirect",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RequestRedirect: &RequestRedirectFilter{
+							Scheme: "ftp",
+						},
+					},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, RequestRedirect, Scheme must be one of 'http' or 'https', got \"ftp\"",
+		},
+		"rule with empty redirect": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-redirect",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RequestRedirect: &RequestRedirectFilter{
+							StatusCode: 301,
+						},
+					},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, RequestRedirect, must set at least one of Scheme, Hostname, Port or Path",
+		},
+		"rule with invalid redirect status code": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-redirect",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RequestRedirect: &RequestRedirectFilter{
+							Path:       "/new",
+							StatusCode: 200,
+						},
+					},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, RequestRedirect, StatusCode must be one of 301, 302, 303, 307 or 308, got 200",
+		},
+		"rule with invalid direct response status code": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-redirect",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						DirectResponse: &DirectResponseFilter{
+							StatusCode: 99,
+						},
+					},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, DirectResponse, StatusCode must be between 200 and 599, got 99",
+		},
+		"rule with redirect and direct response": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-redirect",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RequestRedirect: &RequestRedirectFilter{
+							Scheme: "https",
+						},
+						DirectResponse: &DirectResponseFilter{
+							StatusCode: 503,
+						},
+					},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, cannot specify both RequestRedirect and DirectResponse",
+		},
+		"rule with redirect and services": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-redirect",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []HTTPRouteRule{{
+					Filters: HTTPFilters{
+						RequestRedirect: &RequestRedirectFilter{
+							Scheme: "https",
+						},
+					},
+					Services: []HTTPService{{
+						Name: "service",
+					}},
+				}},
+			},
+			validateErr: "Rule[0], HTTPFilters, Services cannot be specified with RequestRedirect or DirectResponse",
+		},
+		"service with direct response": {
+			entry: &HTTPRouteConfigEntry{
+				Kind: HTTPRoute,
+				Name: "route-redirect",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []HTTPRouteRule{{
+					Services: []HTTPService{{
+						Name: "service",
+						Filters: HTTPFilters{
+							DirectResponse: &DirectResponseFilter{
+								StatusCode: 503,
+							},
+						},
+					}},
+				}},
+			},
+			validateErr: "Rule[0], Service[0], HTTPFilters, DirectResponse is only supported on rule filters",
+		},
+	}
+	testConfigEntryNormalizeAndValidate(t, cases)
+}
+
+func TestGRPCRoute(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]configEntryTestcase{
+		"normalize parent kind and match type": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-one",
+				Parents: []ResourceReference{{
+					Name: "gateway",
+				}},
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{Service: "helloworld.Greeter"},
+					}},
+					Services: []HTTPService{{
+						Name: "greeter",
+					}},
+				}},
+			},
+			normalizeOnly: true,
+			check: func(t *testing.T, entry ConfigEntry) {
+				expectedParent := ResourceReference{
+					Kind:           APIGateway,
+					Name:           "gateway",
+					EnterpriseMeta: *acl.DefaultEnterpriseMeta(),
+				}
+				route := entry.(*GRPCRouteConfigEntry)
+				require.Len(t, route.Parents, 1)
+				require.Equal(t, expectedParent, route.Parents[0])
+				require.Equal(t, GRPCMethodMatchExact, route.Rules[0].Matches[0].Method.Match)
+				require.Equal(t, 1, route.Rules[0].Services[0].Weight)
+			},
+		},
+		"invalid parent kind": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Kind: "route",
+					Name: "gateway",
+				}},
+			},
+			validateErr: "unsupported parent kind",
+		},
+		"wildcard hostnames": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Hostnames: []string{"*"},
+			},
+			validateErr: "host \"*\" must not be a wildcard",
+		},
+		"exact method match with slash": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{
+							Match:   GRPCMethodMatchExact,
+							Service: "helloworld.Greeter/SayHello",
+						},
+					}},
+				}},
+			},
+			validateErr: "Rule[0], Match[0], Method, exact type match must not contain '/'",
+		},
+		"invalid method match type": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{
+							Match:   "prefix",
+							Service: "helloworld.Greeter",
+						},
+					}},
+				}},
+			},
+			validateErr: "Rule[0], Match[0], Method, match type should be one of exact, or regex",
+		},
+		"invalid header match": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Headers: []HTTPHeaderMatch{{
+							Match: HTTPHeaderMatchExact,
+						}},
+					}},
+				}},
+			},
+			validateErr: "Rule[0], Match[0], Headers[0], missing required Name field",
+		},
+		"url rewrite not supported": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Rules: []GRPCRouteRule{{
+					Services: []HTTPService{{
+						Name: "greeter",
+						Filters: HTTPFilters{
+							URLRewrite: &URLRewrite{Path: "/v2"},
+						},
+					}},
+				}},
+			},
+			validateErr: "Rule[0], Service[0], HTTPFilters, URLRewrite is not supported on gRPC routes",
+		},
+		"valid route": {
+			entry: &GRPCRouteConfigEntry{
+				Kind: GRPCRoute,
+				Name: "route-two",
+				Parents: []ResourceReference{{
+					Kind: APIGateway,
+					Name: "gateway",
+				}},
+				Hostnames: []string{"grpc.example.com"},
+				Rules: []GRPCRouteRule{{
+					Matches: []GRPCMatch{{
+						Method: GRPCMethodMatch{
+							Match:   GRPCMethodMatchRegularExpression,
+							Service: `helloworld\..*`,
+						},
+						Headers: []HTTPHeaderMatch{{
+							Match: HTTPHeaderMatchPresent,
+							Name:  "x-debug",
+						}},
+					}},
+					Filters: HTTPFilters{
+						Headers: []HTTPHeaderFilter{{
+							Set: map[string]string{"x-gateway": "true"},
+						}},
+					},
+					Services: []HTTPService{{
+						Name: "greeter",
+					}},
+				}},
+			},
+		},
 	}
 	testConfigEntryNormalizeAndValidate(t, cases)
 }
 
+func TestGRPCRoute_ToHTTPRoute(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		method   GRPCMethodMatch
+		expected HTTPPathMatch
+	}{
+		"service and method": {
+			method:   GRPCMethodMatch{Match: GRPCMethodMatchExact, Service: "helloworld.Greeter", Method: "SayHello"},
+			expected: HTTPPathMatch{Match: HTTPPathMatchExact, Value: "/helloworld.Greeter/SayHello"},
+		},
+		"service only": {
+			method:   GRPCMethodMatch{Match: GRPCMethodMatchExact, Service: "helloworld.Greeter"},
+			expected: HTTPPathMatch{Match: HTTPPathMatchPrefix, Value: "/helloworld.Greeter/"},
+		},
+		"method only": {
+			method:   GRPCMethodMatch{Match: GRPCMethodMatchExact, Method: "Say.Hello"},
+			expected: HTTPPathMatch{Match: HTTPPathMatchRegularExpression, Value: `/[^/]+/Say\.Hello`},
+		},
+		"neither": {
+			method:   GRPCMethodMatch{Match: GRPCMethodMatchExact},
+			expected: HTTPPathMatch{Match: HTTPPathMatchPrefix, Value: "/"},
+		},
+		"regex service": {
+			method:   GRPCMethodMatch{Match: GRPCMethodMatchRegularExpression, Service: `helloworld\..*`},
+			expected: HTTPPathMatch{Match: HTTPPathMatchRegularExpression, Value: `/helloworld\..*/[^/]+`},
+		},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			headers := []HTTPHeaderMatch{{Match: HTTPHeaderMatchExact, Name: "x-tenant", Value: "a"}}
+			services := []HTTPService{{Name: "greeter", Weight: 1}}
+			route := &GRPCRouteConfigEntry{
+				Kind:      GRPCRoute,
+				Name:      "route",
+				Hostnames: []string{"grpc.example.com"},
+				Rules: []GRPCRouteRule{{
+					Matches:  []GRPCMatch{{Method: tc.method, Headers: headers}},
+					Services: services,
+				}},
+			}
+
+			httpRoute := route.ToHTTPRoute()
+			require.Equal(t, HTTPRoute, httpRoute.Kind)
+			require.Equal(t, "route", httpRoute.Name)
+			require.Equal(t, []string{"grpc.example.com"}, httpRoute.Hostnames)
+			require.Equal(t, []HTTPRouteRule{{
+				Matches:  []HTTPMatch{{Headers: headers, Path: tc.expected}},
+				Services: services,
+			}}, httpRoute.Rules)
+		})
+	}
+}
+
 func TestHTTPMatch_DeepEqual(t *testing.T) {
 	type fields struct {
 		Headers []HTTPHeaderMatch
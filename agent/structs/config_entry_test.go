@@ -286,6 +286,131 @@ func TestDecodeConfigEntry_ServiceDefaults(t *testing.T) {
 				MaxInboundConnections: 14,
 			},
 		},
+		{
+			name: "service-defaults-with-FaultInjection",
+			snake: `
+				kind = "service-defaults"
+				name = "web"
+				protocol = "http"
+				fault_injection {
+					delay {
+						fixed_delay = "1500ms"
+						percent = 25
+					}
+					abort {
+						grpc_status = 14
+						percent = 5
+					}
+					headers = [
+						{
+							name = "x-chaos"
+							exact = "enabled"
+						}
+					]
+				}
+			`,
+			camel: `
+				Kind = "service-defaults"
+				Name = "web"
+				Protocol = "http"
+				FaultInjection {
+					Delay {
+						FixedDelay = "1500ms"
+						Percent = 25
+					}
+					Abort {
+						GRPCStatus = 14
+						Percent = 5
+					}
+					Headers = [
+						{
+							Name = "x-chaos"
+							Exact = "enabled"
+						}
+					]
+				}
+			`,
+			expect: &ServiceConfigEntry{
+				Kind:     "service-defaults",
+				Name:     "web",
+				Protocol: "http",
+				FaultInjection: &FaultInjection{
+					Delay: &FaultInjectionDelay{
+						FixedDelay: 1500 * time.Millisecond,
+						Percent:    25,
+					},
+					Abort: &FaultInjectionAbort{
+						GRPCStatus: 14,
+						Percent:    5,
+					},
+					Headers: []ServiceRouteHTTPMatchHeader{
+						{Name: "x-chaos", Exact: "enabled"},
+					},
+				},
+			},
+		},
+		{
+			name: "service-defaults-with-RateLimit",
+			snake: `
+				kind = "service-defaults"
+				name = "web"
+				protocol = "http"
+				rate_limit {
+					requests_per_second = 100
+					requests_max_burst = 200
+					routes = [
+						{
+							path_prefix = "/admin"
+							requests_per_second = 5
+						}
+					]
+					descriptors = [
+						{
+							header = "x-tenant"
+							value = "free"
+							requests_per_second = 10
+						}
+					]
+				}
+			`,
+			camel: `
+				Kind = "service-defaults"
+				Name = "web"
+				Protocol = "http"
+				RateLimit {
+					RequestsPerSecond = 100
+					RequestsMaxBurst = 200
+					Routes = [
+						{
+							PathPrefix = "/admin"
+							RequestsPerSecond = 5
+						}
+					]
+					Descriptors = [
+						{
+							Header = "x-tenant"
+							Value = "free"
+							RequestsPerSecond = 10
+						}
+					]
+				}
+			`,
+			expect: &ServiceConfigEntry{
+				Kind:     "service-defaults",
+				Name:     "web",
+				Protocol: "http",
+				RateLimit: &LocalRateLimit{
+					RequestsPerSecond: 100,
+					RequestsMaxBurst:  200,
+					Routes: []LocalRateLimitRoute{
+						{PathPrefix: "/admin", RequestsPerSecond: 5},
+					},
+					Descriptors: []LocalRateLimitDescriptor{
+						{Header: "x-tenant", Value: "free", RequestsPerSecond: 10},
+					},
+				},
+			},
+		},
 	} {
 		tc := tc
 
@@ -659,6 +784,13 @@ func TestDecodeConfigEntry(t *testing.T) {
 								}
 								remove = ["qux"]
 							}
+							mirror = [
+								{
+									service        = "radish"
+									service_subset = "beet"
+									percent        = 12.5
+								},
+							]
 						}
 					},
 					{
@@ -761,6 +893,13 @@ func TestDecodeConfigEntry(t *testing.T) {
 								}
 								Remove = ["qux"]
 							}
+							Mirror = [
+								{
+									Service       = "radish"
+									ServiceSubset = "beet"
+									Percent       = 12.5
+								},
+							]
 						}
 					},
 					{
@@ -855,6 +994,9 @@ func TestDecodeConfigEntry(t *testing.T) {
 								Set:    map[string]string{"bar": "baz"},
 								Remove: []string{"qux"},
 							},
+							Mirror: []ServiceRouteMirror{
+								{Service: "radish", ServiceSubset: "beet", Percent: 12.5},
+							},
 						},
 					},
 					{
@@ -2452,6 +2594,72 @@ func TestDecodeConfigEntry(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "imported-services",
+			snake: `
+				kind = "imported-services"
+				name = "flarm"
+				meta {
+					"foo" = "bar"
+				}
+				allow = [
+					{
+						name = "web"
+						tags = ["v2"]
+						service_meta {
+							env = "prod"
+						}
+					}
+				]
+				deny = [
+					{
+						name = "*"
+						namespace = "legacy"
+					}
+				]
+			`,
+			camel: `
+				Kind = "imported-services"
+				Name = "flarm"
+				Meta {
+					"foo" = "bar"
+				}
+				Allow = [
+					{
+						Name = "web"
+						Tags = ["v2"]
+						ServiceMeta {
+							env = "prod"
+						}
+					}
+				]
+				Deny = [
+					{
+						Name = "*"
+						Namespace = "legacy"
+					}
+				]
+			`,
+			expect: &ImportedServicesConfigEntry{
+				Name: "flarm",
+				Meta: map[string]string{
+					"foo": "bar",
+				},
+				Allow: []ImportedServiceFilter{
+					{
+						Name:        "web",
+						Tags:        []string{"v2"},
+						ServiceMeta: map[string]string{"env": "prod"},
+					},
+				},
+				Deny: []ImportedServiceFilter{
+					{
+						Name:      "*",
+						Namespace: "legacy",
+					},
+				},
+			},
+		},
 	} {
 		tc := tc
 
@@ -3233,6 +3441,157 @@ func TestServiceConfigEntry(t *testing.T) {
 			},
 			validateErr: `invalid value for protocol: blah`,
 		},
+		"validate: fault injection": {
+			entry: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "web",
+				Protocol: "http",
+				FaultInjection: &FaultInjection{
+					Abort: &FaultInjectionAbort{HTTPStatus: 503, Percent: 10},
+				},
+			},
+			expected: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "web",
+				Protocol: "http",
+				FaultInjection: &FaultInjection{
+					Abort: &FaultInjectionAbort{HTTPStatus: 503, Percent: 10},
+				},
+				EnterpriseMeta: *DefaultEnterpriseMetaInDefaultPartition(),
+			},
+		},
+		"validate: fault injection with tcp protocol": {
+			entry: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "web",
+				Protocol: "tcp",
+				FaultInjection: &FaultInjection{
+					Abort: &FaultInjectionAbort{HTTPStatus: 503},
+				},
+			},
+			validateErr: `FaultInjection requires an HTTP-based protocol, not "tcp"`,
+		},
+		"validate: invalid fault injection": {
+			entry: &ServiceConfigEntry{
+				Kind: ServiceDefaults,
+				Name: "web",
+				FaultInjection: &FaultInjection{
+					Delay: &FaultInjectionDelay{FixedDelay: -time.Second},
+				},
+			},
+			validateErr: `FaultInjection Delay FixedDelay must be greater than 0`,
+		},
+		"validate: rate limit": {
+			entry: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "web",
+				Protocol: "http",
+				RateLimit: &LocalRateLimit{
+					RequestsPerSecond: 100,
+					RequestsMaxBurst:  200,
+					Routes: []LocalRateLimitRoute{
+						{PathPrefix: "/admin", RequestsPerSecond: 5},
+					},
+					Descriptors: []LocalRateLimitDescriptor{
+						{Header: "x-tenant", Value: "acme", RequestsPerSecond: 10},
+					},
+				},
+			},
+			expected: &ServiceConfigEntry{
+				Kind:     ServiceDefaults,
+				Name:     "web",
+				Protocol: "http",
+				RateLimit: &LocalRateLimit{
+					RequestsPerSecond: 100,
+					RequestsMaxBurst:  200,
+					Routes: []LocalRateLimitRoute{
+						{PathPrefix: "/admin", RequestsPerSecond: 5},
+					},
+					Descriptors: []LocalRateLimitDescriptor{
+						{Header: "x-tenant", Value: "acme", RequestsPerSecond: 10},
+					},
+				},
+				EnterpriseMeta: *DefaultEnterpriseMetaInDefaultPartition(),
+			},
+		},
+		"validate: rate limit with tcp protocol": {
+			entry: &ServiceConfigEntry{
+				Kind:      ServiceDefaults,
+				Name:      "web",
+				Protocol:  "tcp",
+				RateLimit: &LocalRateLimit{RequestsPerSecond: 100},
+			},
+			validateErr: `RateLimit requires an HTTP-based protocol, not "tcp"`,
+		},
+		"validate: rate limit missing requests per second": {
+			entry: &ServiceConfigEntry{
+				Kind:      ServiceDefaults,
+				Name:      "web",
+				RateLimit: &LocalRateLimit{RequestsMaxBurst: 10},
+			},
+			validateErr: `RateLimit RequestsPerSecond must be greater than 0`,
+		},
+		"validate: rate limit burst below requests per second": {
+			entry: &ServiceConfigEntry{
+				Kind:      ServiceDefaults,
+				Name:      "web",
+				RateLimit: &LocalRateLimit{RequestsPerSecond: 10, RequestsMaxBurst: 5},
+			},
+			validateErr: `RateLimit RequestsMaxBurst must be equal to or greater than RequestsPerSecond`,
+		},
+		"validate: rate limit route with multiple paths": {
+			entry: &ServiceConfigEntry{
+				Kind: ServiceDefaults,
+				Name: "web",
+				RateLimit: &LocalRateLimit{
+					RequestsPerSecond: 10,
+					Routes: []LocalRateLimitRoute{
+						{PathExact: "/a", PathPrefix: "/b", RequestsPerSecond: 1},
+					},
+				},
+			},
+			validateErr: `RateLimit Routes[0] should contain exactly one of PathExact, PathPrefix, or PathRegex`,
+		},
+		"validate: rate limit route missing requests per second": {
+			entry: &ServiceConfigEntry{
+				Kind: ServiceDefaults,
+				Name: "web",
+				RateLimit: &LocalRateLimit{
+					RequestsPerSecond: 10,
+					Routes: []LocalRateLimitRoute{
+						{PathRegex: "/v[0-9]+/.*"},
+					},
+				},
+			},
+			validateErr: `RateLimit Routes[0] RequestsPerSecond must be greater than 0`,
+		},
+		"validate: rate limit descriptor missing value": {
+			entry: &ServiceConfigEntry{
+				Kind: ServiceDefaults,
+				Name: "web",
+				RateLimit: &LocalRateLimit{
+					RequestsPerSecond: 10,
+					Descriptors: []LocalRateLimitDescriptor{
+						{Header: "x-tenant", RequestsPerSecond: 1},
+					},
+				},
+			},
+			validateErr: `RateLimit Descriptors[0] missing required Value field`,
+		},
+		"validate: rate limit duplicate descriptors": {
+			entry: &ServiceConfigEntry{
+				Kind: ServiceDefaults,
+				Name: "web",
+				RateLimit: &LocalRateLimit{
+					RequestsPerSecond: 10,
+					Descriptors: []LocalRateLimitDescriptor{
+						{Header: "x-tenant", Value: "acme", RequestsPerSecond: 1},
+						{Header: "X-Tenant", Value: "acme", RequestsPerSecond: 2},
+					},
+				},
+			},
+			validateErr: `RateLimit Descriptors[1] duplicates the limit for header "X-Tenant" with value "acme"`,
+		},
 	}
 	testConfigEntryNormalizeAndValidate(t, cases)
 }
@@ -3636,6 +3995,17 @@ func TestProxyConfigEntry(t *testing.T) {
 			},
 			validateErr: "cannot specify both access log JSONFormat and TextFormat",
 		},
+		"proxy config has invalid access log config - intention denials with custom format": {
+			entry: &ProxyConfigEntry{
+				Name: "global",
+				AccessLogs: AccessLogsConfig{
+					Enabled:                 true,
+					TextFormat:              "[%START_TIME%]",
+					IncludeIntentionDenials: true,
+				},
+			},
+			validateErr: "IncludeIntentionDenials only applies to the default access log format",
+		},
 		"proxy config has invalid access log config - file path with wrong type": {
 			entry: &ProxyConfigEntry{
 				Name: "global",
@@ -178,6 +178,10 @@ type AccessLogsConfig struct {
 	// Defining Both is invalid.
 	JSONFormat string `json:",omitempty" alias:"json_format"`
 	TextFormat string `json:",omitempty" alias:"text_format"`
+
+	// IncludeIntentionDenials adds the fields needed to report requests and
+	// connections denied by intentions to the default JSON format.
+	IncludeIntentionDenials bool `json:",omitempty" alias:"include_intention_denials"`
 }
 
 func (c *AccessLogsConfig) IsZero() bool {
@@ -193,12 +197,13 @@ func (c *AccessLogsConfig) ToAPI() *api.AccessLogsConfig {
 		return nil
 	}
 	return &api.AccessLogsConfig{
-		Enabled:             c.Enabled,
-		DisableListenerLogs: c.DisableListenerLogs,
-		Type:                api.LogSinkType(c.Type),
-		Path:                c.Path,
-		JSONFormat:          c.JSONFormat,
-		TextFormat:          c.TextFormat,
+		Enabled:                 c.Enabled,
+		DisableListenerLogs:     c.DisableListenerLogs,
+		Type:                    api.LogSinkType(c.Type),
+		Path:                    c.Path,
+		JSONFormat:              c.JSONFormat,
+		TextFormat:              c.TextFormat,
+		IncludeIntentionDenials: c.IncludeIntentionDenials,
 	}
 }
 
@@ -218,6 +223,10 @@ func (c *AccessLogsConfig) Validate() error {
 		return errors.New("cannot specify both access log JSONFormat and TextFormat")
 	}
 
+	if c.IncludeIntentionDenials && (c.JSONFormat != "" || c.TextFormat != "") {
+		return errors.New("IncludeIntentionDenials only applies to the default access log format")
+	}
+
 	if c.Type != FileLogSinkType && c.Path != "" {
 		return errors.New("path is only valid for file type access logs")
 	}
@@ -101,6 +101,23 @@ type DiscoveryGraphNode struct {
 
 	// shared by Type==resolver || Type==splitter
 	LoadBalancer *LoadBalancer `json:",omitempty"`
+
+	// Sources lists the config entries that produced this node. It is only
+	// populated when the chain was compiled with explanations requested.
+	Sources []DiscoveryGraphNodeSource `json:",omitempty"`
+}
+
+// DiscoveryGraphNodeSource identifies a config entry that contributed to a
+// node in a compiled discovery chain.
+type DiscoveryGraphNodeSource struct {
+	Kind      string
+	Name      string
+	Namespace string `json:",omitempty"`
+	Partition string `json:",omitempty"`
+
+	// Default is true if no config entry exists and the node was built
+	// from the default values of the entry kind.
+	Default bool `json:",omitempty"`
 }
 
 func (s *DiscoveryGraphNode) IsRouter() bool {
@@ -121,11 +138,13 @@ func (s *DiscoveryGraphNode) MapKey() string {
 
 // compiled form of ServiceResolverConfigEntry
 type DiscoveryResolver struct {
-	Default        bool               `json:",omitempty"`
-	ConnectTimeout time.Duration      `json:",omitempty"`
-	RequestTimeout time.Duration      `json:",omitempty"`
-	Target         string             `json:",omitempty"`
-	Failover       *DiscoveryFailover `json:",omitempty"`
+	Default          bool                `json:",omitempty"`
+	ConnectTimeout   time.Duration       `json:",omitempty"`
+	RequestTimeout   time.Duration       `json:",omitempty"`
+	Target           string              `json:",omitempty"`
+	Failover         *DiscoveryFailover  `json:",omitempty"`
+	OutlierDetection *PassiveHealthCheck `json:",omitempty"`
+	HealthChecks     []ActiveHealthCheck `json:",omitempty"`
 }
 
 func (r *DiscoveryResolver) MarshalJSON() ([]byte, error) {
@@ -166,8 +185,9 @@ func (r *DiscoveryResolver) UnmarshalJSON(data []byte) error {
 
 // compiled form of ServiceRoute
 type DiscoveryRoute struct {
-	Definition *ServiceRoute `json:",omitempty"`
-	NextNode   string        `json:",omitempty"`
+	Definition *ServiceRoute      `json:",omitempty"`
+	NextNode   string             `json:",omitempty"`
+	Mirrors    []*DiscoveryMirror `json:",omitempty"`
 }
 
 // compiled form of ServiceSplit
@@ -179,8 +199,17 @@ type DiscoverySplit struct {
 	// Proxies should use this compiled weight. The Definition is provided above
 	// for any other significant configuration that the proxy might need to apply
 	// to that leg of the split.
-	Weight   float32 `json:",omitempty"`
-	NextNode string  `json:",omitempty"`
+	Weight   float32            `json:",omitempty"`
+	NextNode string             `json:",omitempty"`
+	Mirrors  []*DiscoveryMirror `json:",omitempty"`
+}
+
+// compiled form of ServiceRouteMirror
+type DiscoveryMirror struct {
+	// NextNode is the resolver node for the mirrored target.
+	NextNode string `json:",omitempty"`
+	// Percent is the portion of requests to mirror, between 0 and 100.
+	Percent float32 `json:",omitempty"`
 }
 
 // compiled form of ServiceResolverFailover
@@ -192,16 +221,27 @@ type DiscoveryFailover struct {
 
 // compiled form of ServiceResolverPrioritizeByLocality
 type DiscoveryPrioritizeByLocality struct {
-	Mode string `json:",omitempty"`
+	Mode           string `json:",omitempty"`
+	MinClusterSize uint32 `json:",omitempty"`
 }
 
 func (pbl *ServiceResolverPrioritizeByLocality) ToDiscovery() *DiscoveryPrioritizeByLocality {
 	if pbl == nil {
 		return nil
 	}
-	return &DiscoveryPrioritizeByLocality{
+	d := &DiscoveryPrioritizeByLocality{
 		Mode: pbl.Mode,
 	}
+	if pbl.Mode == PrioritizeByLocalityModeZoneAware {
+		d.MinClusterSize = pbl.MinClusterSize
+	}
+	return d
+}
+
+// IsZoneAware returns true if the endpoints of the target should be load
+// balanced using zone-aware routing.
+func (pbl *DiscoveryPrioritizeByLocality) IsZoneAware() bool {
+	return pbl != nil && pbl.Mode == PrioritizeByLocalityModeZoneAware
 }
 
 // DiscoveryTarget represents all of the inputs necessary to use a resolver
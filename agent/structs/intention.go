@@ -739,6 +739,36 @@ type IntentionDecisionSummary struct {
 	DefaultAllow   bool
 }
 
+// IntentionDecisionChange is a single source and destination pair whose
+// intention decision differs between the stored and proposed intentions.
+type IntentionDecisionChange struct {
+	SourceName      string
+	SourceNS        string `json:",omitempty"`
+	SourcePartition string `json:",omitempty"`
+	SourcePeer      string `json:",omitempty"`
+
+	DestinationName      string
+	DestinationNS        string `json:",omitempty"`
+	DestinationPartition string `json:",omitempty"`
+
+	// Before and After are the L4 decisions for the pair using the stored
+	// and the proposed intentions respectively.
+	Before IntentionDecisionSummary
+	After  IntentionDecisionSummary
+}
+
+// IntentionDryRunResponse is the result of evaluating a service-intentions
+// config entry against the catalog without applying it.
+type IntentionDryRunResponse struct {
+	// Evaluated is the number of source and destination pairs that were
+	// considered.
+	Evaluated int
+
+	// Changes lists the pairs whose decision would change, sorted by
+	// destination and then source.
+	Changes []IntentionDecisionChange
+}
+
 // IntentionQueryExact holds the parameters for performing a lookup of an
 // intention by its unique name instead of its ID.
 type IntentionQueryExact struct {
@@ -783,6 +813,125 @@ func (r *IntentionListRequest) RequestDatacenter() string {
 	return r.Datacenter
 }
 
+// IntentionDenyPolicyPrefix prefixes the names of the Envoy RBAC shadow
+// policies generated for deny intentions. Envoy reports the name of the policy
+// that matched a request as shadow_effective_policy_id in the RBAC filter's
+// dynamic metadata.
+const IntentionDenyPolicyPrefix = "consul-intentions-deny:"
+
+// IntentionDenyPolicyID returns the name of the RBAC shadow policy for the
+// deny intention with the given source.
+func IntentionDenyPolicyID(source ServiceName, peer string) string {
+	id := IntentionDenyPolicyPrefix + source.String()
+	if peer != "" {
+		id += "@" + peer
+	}
+	return id
+}
+
+// ParseIntentionDenyPolicyID is the inverse of IntentionDenyPolicyID. It
+// returns false if id was not generated for a deny intention.
+func ParseIntentionDenyPolicyID(id string) (ServiceName, string, bool) {
+	if !strings.HasPrefix(id, IntentionDenyPolicyPrefix) {
+		return ServiceName{}, "", false
+	}
+	source, peer, _ := strings.Cut(strings.TrimPrefix(id, IntentionDenyPolicyPrefix), "@")
+	if source == "" {
+		return ServiceName{}, "", false
+	}
+	return ServiceNameFromString(source), peer, true
+}
+
+// IntentionDenial is a single request or connection that a proxy denied
+// based on intentions.
+type IntentionDenial struct {
+	SourceName      string
+	SourceNS        string `json:",omitempty"`
+	SourcePartition string `json:",omitempty"`
+	SourcePeer      string `json:",omitempty"`
+
+	// SourceURI is the SPIFFE ID presented by the downstream. It is used to
+	// fill in the source fields when SourceName is empty, so that Envoy's
+	// %DOWNSTREAM_PEER_URI_SAN% can be reported as is.
+	SourceURI string `json:",omitempty"`
+
+	DestinationName      string
+	DestinationNS        string `json:",omitempty"`
+	DestinationPartition string `json:",omitempty"`
+
+	// PolicyID is the shadow_effective_policy_id Envoy recorded for the
+	// request. It is empty if the default intention policy denied it.
+	PolicyID string `json:",omitempty"`
+
+	// Timestamp is when the proxy denied the request. The time the denial
+	// was reported is used if it is zero.
+	Timestamp time.Time
+}
+
+// IntentionDenialsReportRequest is used to report denials observed by
+// proxies.
+type IntentionDenialsReportRequest struct {
+	Datacenter string
+	Denials    []IntentionDenial
+	WriteRequest
+}
+
+func (r *IntentionDenialsReportRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// IntentionDenialsRequest is used to list the aggregated denials.
+type IntentionDenialsRequest struct {
+	Datacenter         string
+	acl.EnterpriseMeta `hcl:",squash" mapstructure:",squash"`
+	QueryOptions
+}
+
+func (r *IntentionDenialsRequest) RequestDatacenter() string {
+	return r.Datacenter
+}
+
+// AllowStaleRead always returns false because denials are only held in the
+// memory of the leader.
+func (r *IntentionDenialsRequest) AllowStaleRead() bool {
+	return false
+}
+
+// IntentionDenialSummary aggregates the denials between a source and a
+// destination that were caused by the same intention.
+type IntentionDenialSummary struct {
+	SourceName      string
+	SourceNS        string `json:",omitempty"`
+	SourcePartition string `json:",omitempty"`
+	SourcePeer      string `json:",omitempty"`
+
+	DestinationName      string
+	DestinationNS        string `json:",omitempty"`
+	DestinationPartition string `json:",omitempty"`
+
+	// Intention is the source of the deny intention that matched, in the
+	// same format as the intention's SourceName, or empty if the default
+	// intention policy denied the requests.
+	Intention     string `json:",omitempty"`
+	IntentionPeer string `json:",omitempty"`
+
+	Count     uint64
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// DestinationServiceName returns the destination of the denied requests.
+func (s *IntentionDenialSummary) DestinationServiceName() ServiceName {
+	entMeta := NewEnterpriseMetaWithPartition(s.DestinationPartition, s.DestinationNS)
+	return NewServiceName(s.DestinationName, &entMeta)
+}
+
+// IndexedIntentionDenials is the response to listing denials.
+type IndexedIntentionDenials struct {
+	Denials []IntentionDenialSummary
+	QueryMeta
+}
+
 // SimplifiedIntentions contains expanded sameness groups.
 type SimplifiedIntentions Intentions
 
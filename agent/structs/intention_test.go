@@ -533,3 +533,24 @@ func TestIntention_String(t *testing.T) {
 func TestIntentionQueryRequest_CacheInfoKey(t *testing.T) {
 	assertCacheInfoKeyIsComplete(t, &IntentionQueryRequest{})
 }
+
+func TestIntentionDenyPolicyID(t *testing.T) {
+	local := IntentionDenyPolicyID(NewServiceName("web", nil), "")
+	require.Equal(t, IntentionDenyPolicyPrefix+"web", local)
+
+	src, peer, ok := ParseIntentionDenyPolicyID(local)
+	require.True(t, ok)
+	require.Equal(t, NewServiceName("web", nil), src)
+	require.Empty(t, peer)
+
+	peered := IntentionDenyPolicyID(NewServiceName("web", nil), "cluster-01")
+	src, peer, ok = ParseIntentionDenyPolicyID(peered)
+	require.True(t, ok)
+	require.Equal(t, NewServiceName("web", nil), src)
+	require.Equal(t, "cluster-01", peer)
+
+	_, _, ok = ParseIntentionDenyPolicyID("consul-intentions-layer4")
+	require.False(t, ok)
+	_, _, ok = ParseIntentionDenyPolicyID(IntentionDenyPolicyPrefix)
+	require.False(t, ok)
+}
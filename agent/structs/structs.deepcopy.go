@@ -1,4 +1,4 @@
-// Code generated by deep-copy -pointer-receiver -o ./structs.deepcopy.go -type APIGatewayListener -type BoundAPIGatewayListener -type CARoot -type CheckServiceNode -type CheckType -type CompiledDiscoveryChain -type ConnectProxyConfig -type DiscoveryFailover -type DiscoveryGraphNode -type DiscoveryResolver -type DiscoveryRoute -type DiscoverySplit -type ExposeConfig -type ExportedServicesConfigEntry -type FileSystemCertificateConfigEntry -type GatewayService -type GatewayServiceTLSConfig -type HTTPHeaderModifiers -type HTTPRouteConfigEntry -type HashPolicy -type HealthCheck -type IndexedCARoots -type IngressListener -type InlineCertificateConfigEntry -type Intention -type IntentionPermission -type LoadBalancer -type MeshConfigEntry -type MeshDirectionalTLSConfig -type MeshTLSConfig -type Node -type NodeService -type PeeringServiceMeta -type ServiceConfigEntry -type ServiceConfigResponse -type ServiceConnect -type ServiceDefinition -type ServiceResolverConfigEntry -type ServiceResolverFailover -type ServiceRoute -type ServiceRouteDestination -type ServiceRouteMatch -type ServiceSpecificRequest -type TCPRouteConfigEntry -type Upstream -type UpstreamConfiguration -type Status -type BoundAPIGatewayConfigEntry ./; DO NOT EDIT.
+// Code generated by deep-copy -pointer-receiver -o ./structs.deepcopy.go -type APIGatewayListener -type BoundAPIGatewayListener -type CARoot -type CheckServiceNode -type CheckType -type CompiledDiscoveryChain -type ConnectProxyConfig -type DiscoveryFailover -type DiscoveryGraphNode -type DiscoveryResolver -type DiscoveryRoute -type DiscoverySplit -type ExposeConfig -type ExportedServicesConfigEntry -type FileSystemCertificateConfigEntry -type GatewayService -type GatewayServiceTLSConfig -type GRPCRouteConfigEntry -type HTTPHeaderModifiers -type HTTPRouteConfigEntry -type HashPolicy -type HealthCheck -type IndexedCARoots -type IngressListener -type InlineCertificateConfigEntry -type Intention -type IntentionPermission -type LoadBalancer -type MeshConfigEntry -type MeshDirectionalTLSConfig -type MeshTLSConfig -type Node -type NodeService -type PeeringServiceMeta -type ServiceConfigEntry -type ServiceConfigResponse -type ServiceConnect -type ServiceDefinition -type ServiceResolverConfigEntry -type ServiceResolverFailover -type ServiceRoute -type ServiceRouteDestination -type ServiceRouteMatch -type ServiceSpecificRequest -type TCPRouteConfigEntry -type TLSRouteConfigEntry -type Upstream -type UpstreamConfiguration -type Status -type BoundAPIGatewayConfigEntry ./; DO NOT EDIT.
 
 package structs
 
@@ -249,6 +249,10 @@ func (o *DiscoveryGraphNode) DeepCopy() *DiscoveryGraphNode {
 	if o.LoadBalancer != nil {
 		cp.LoadBalancer = o.LoadBalancer.DeepCopy()
 	}
+	if o.Sources != nil {
+		cp.Sources = make([]DiscoveryGraphNodeSource, len(o.Sources))
+		copy(cp.Sources, o.Sources)
+	}
 	return &cp
 }
 
@@ -258,6 +262,36 @@ func (o *DiscoveryResolver) DeepCopy() *DiscoveryResolver {
 	if o.Failover != nil {
 		cp.Failover = o.Failover.DeepCopy()
 	}
+	if o.OutlierDetection != nil {
+		cp.OutlierDetection = new(PassiveHealthCheck)
+		*cp.OutlierDetection = *o.OutlierDetection
+		if o.OutlierDetection.EnforcingConsecutive5xx != nil {
+			cp.OutlierDetection.EnforcingConsecutive5xx = new(uint32)
+			*cp.OutlierDetection.EnforcingConsecutive5xx = *o.OutlierDetection.EnforcingConsecutive5xx
+		}
+		if o.OutlierDetection.MaxEjectionPercent != nil {
+			cp.OutlierDetection.MaxEjectionPercent = new(uint32)
+			*cp.OutlierDetection.MaxEjectionPercent = *o.OutlierDetection.MaxEjectionPercent
+		}
+		if o.OutlierDetection.BaseEjectionTime != nil {
+			cp.OutlierDetection.BaseEjectionTime = new(time.Duration)
+			*cp.OutlierDetection.BaseEjectionTime = *o.OutlierDetection.BaseEjectionTime
+		}
+	}
+	if o.HealthChecks != nil {
+		cp.HealthChecks = make([]ActiveHealthCheck, len(o.HealthChecks))
+		copy(cp.HealthChecks, o.HealthChecks)
+		for i2 := range o.HealthChecks {
+			if o.HealthChecks[i2].HTTP != nil {
+				cp.HealthChecks[i2].HTTP = new(ActiveHealthCheckHTTP)
+				*cp.HealthChecks[i2].HTTP = *o.HealthChecks[i2].HTTP
+			}
+			if o.HealthChecks[i2].GRPC != nil {
+				cp.HealthChecks[i2].GRPC = new(ActiveHealthCheckGRPC)
+				*cp.HealthChecks[i2].GRPC = *o.HealthChecks[i2].GRPC
+			}
+		}
+	}
 	return &cp
 }
 
@@ -267,6 +301,16 @@ func (o *DiscoveryRoute) DeepCopy() *DiscoveryRoute {
 	if o.Definition != nil {
 		cp.Definition = o.Definition.DeepCopy()
 	}
+	if o.Mirrors != nil {
+		cp.Mirrors = make([]*DiscoveryMirror, len(o.Mirrors))
+		copy(cp.Mirrors, o.Mirrors)
+		for i2 := range o.Mirrors {
+			if o.Mirrors[i2] != nil {
+				cp.Mirrors[i2] = new(DiscoveryMirror)
+				*cp.Mirrors[i2] = *o.Mirrors[i2]
+			}
+		}
+	}
 	return &cp
 }
 
@@ -282,6 +326,20 @@ func (o *DiscoverySplit) DeepCopy() *DiscoverySplit {
 		if o.Definition.ResponseHeaders != nil {
 			cp.Definition.ResponseHeaders = o.Definition.ResponseHeaders.DeepCopy()
 		}
+		if o.Definition.Mirror != nil {
+			cp.Definition.Mirror = make([]ServiceRouteMirror, len(o.Definition.Mirror))
+			copy(cp.Definition.Mirror, o.Definition.Mirror)
+		}
+	}
+	if o.Mirrors != nil {
+		cp.Mirrors = make([]*DiscoveryMirror, len(o.Mirrors))
+		copy(cp.Mirrors, o.Mirrors)
+		for i2 := range o.Mirrors {
+			if o.Mirrors[i2] != nil {
+				cp.Mirrors[i2] = new(DiscoveryMirror)
+				*cp.Mirrors[i2] = *o.Mirrors[i2]
+			}
+		}
 	}
 	return &cp
 }
@@ -337,6 +395,10 @@ func (o *GatewayService) DeepCopy() *GatewayService {
 		cp.Hosts = make([]string, len(o.Hosts))
 		copy(cp.Hosts, o.Hosts)
 	}
+	if o.HealthCheck != nil {
+		cp.HealthCheck = new(LinkedServiceHealthCheck)
+		*cp.HealthCheck = *o.HealthCheck
+	}
 	return &cp
 }
 
@@ -350,6 +412,202 @@ func (o *GatewayServiceTLSConfig) DeepCopy() *GatewayServiceTLSConfig {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *GRPCRouteConfigEntry
+func (o *GRPCRouteConfigEntry) DeepCopy() *GRPCRouteConfigEntry {
+	var cp GRPCRouteConfigEntry = *o
+	if o.Parents != nil {
+		cp.Parents = make([]ResourceReference, len(o.Parents))
+		copy(cp.Parents, o.Parents)
+	}
+	if o.Rules != nil {
+		cp.Rules = make([]GRPCRouteRule, len(o.Rules))
+		copy(cp.Rules, o.Rules)
+		for i2 := range o.Rules {
+			if o.Rules[i2].Filters.Headers != nil {
+				cp.Rules[i2].Filters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].Filters.Headers))
+				copy(cp.Rules[i2].Filters.Headers, o.Rules[i2].Filters.Headers)
+				for i5 := range o.Rules[i2].Filters.Headers {
+					if o.Rules[i2].Filters.Headers[i5].Add != nil {
+						cp.Rules[i2].Filters.Headers[i5].Add = make(map[string]string, len(o.Rules[i2].Filters.Headers[i5].Add))
+						for k7, v7 := range o.Rules[i2].Filters.Headers[i5].Add {
+							cp.Rules[i2].Filters.Headers[i5].Add[k7] = v7
+						}
+					}
+					if o.Rules[i2].Filters.Headers[i5].Remove != nil {
+						cp.Rules[i2].Filters.Headers[i5].Remove = make([]string, len(o.Rules[i2].Filters.Headers[i5].Remove))
+						copy(cp.Rules[i2].Filters.Headers[i5].Remove, o.Rules[i2].Filters.Headers[i5].Remove)
+					}
+					if o.Rules[i2].Filters.Headers[i5].Set != nil {
+						cp.Rules[i2].Filters.Headers[i5].Set = make(map[string]string, len(o.Rules[i2].Filters.Headers[i5].Set))
+						for k7, v7 := range o.Rules[i2].Filters.Headers[i5].Set {
+							cp.Rules[i2].Filters.Headers[i5].Set[k7] = v7
+						}
+					}
+				}
+			}
+			if o.Rules[i2].Filters.URLRewrite != nil {
+				cp.Rules[i2].Filters.URLRewrite = new(URLRewrite)
+				*cp.Rules[i2].Filters.URLRewrite = *o.Rules[i2].Filters.URLRewrite
+			}
+			if o.Rules[i2].Filters.RetryFilter != nil {
+				cp.Rules[i2].Filters.RetryFilter = new(RetryFilter)
+				*cp.Rules[i2].Filters.RetryFilter = *o.Rules[i2].Filters.RetryFilter
+				if o.Rules[i2].Filters.RetryFilter.RetryOn != nil {
+					cp.Rules[i2].Filters.RetryFilter.RetryOn = make([]string, len(o.Rules[i2].Filters.RetryFilter.RetryOn))
+					copy(cp.Rules[i2].Filters.RetryFilter.RetryOn, o.Rules[i2].Filters.RetryFilter.RetryOn)
+				}
+				if o.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes != nil {
+					cp.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes = make([]uint32, len(o.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes))
+					copy(cp.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes, o.Rules[i2].Filters.RetryFilter.RetryOnStatusCodes)
+				}
+			}
+			if o.Rules[i2].Filters.TimeoutFilter != nil {
+				cp.Rules[i2].Filters.TimeoutFilter = new(TimeoutFilter)
+				*cp.Rules[i2].Filters.TimeoutFilter = *o.Rules[i2].Filters.TimeoutFilter
+			}
+			if o.Rules[i2].Filters.JWT != nil {
+				cp.Rules[i2].Filters.JWT = o.Rules[i2].Filters.JWT.DeepCopy()
+			}
+			if o.Rules[i2].Filters.RequestRedirect != nil {
+				cp.Rules[i2].Filters.RequestRedirect = new(RequestRedirectFilter)
+				*cp.Rules[i2].Filters.RequestRedirect = *o.Rules[i2].Filters.RequestRedirect
+			}
+			if o.Rules[i2].Filters.DirectResponse != nil {
+				cp.Rules[i2].Filters.DirectResponse = new(DirectResponseFilter)
+				*cp.Rules[i2].Filters.DirectResponse = *o.Rules[i2].Filters.DirectResponse
+			}
+			if o.Rules[i2].ResponseFilters.Headers != nil {
+				cp.Rules[i2].ResponseFilters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].ResponseFilters.Headers))
+				copy(cp.Rules[i2].ResponseFilters.Headers, o.Rules[i2].ResponseFilters.Headers)
+				for i5 := range o.Rules[i2].ResponseFilters.Headers {
+					if o.Rules[i2].ResponseFilters.Headers[i5].Add != nil {
+						cp.Rules[i2].ResponseFilters.Headers[i5].Add = make(map[string]string, len(o.Rules[i2].ResponseFilters.Headers[i5].Add))
+						for k7, v7 := range o.Rules[i2].ResponseFilters.Headers[i5].Add {
+							cp.Rules[i2].ResponseFilters.Headers[i5].Add[k7] = v7
+						}
+					}
+					if o.Rules[i2].ResponseFilters.Headers[i5].Remove != nil {
+						cp.Rules[i2].ResponseFilters.Headers[i5].Remove = make([]string, len(o.Rules[i2].ResponseFilters.Headers[i5].Remove))
+						copy(cp.Rules[i2].ResponseFilters.Headers[i5].Remove, o.Rules[i2].ResponseFilters.Headers[i5].Remove)
+					}
+					if o.Rules[i2].ResponseFilters.Headers[i5].Set != nil {
+						cp.Rules[i2].ResponseFilters.Headers[i5].Set = make(map[string]string, len(o.Rules[i2].ResponseFilters.Headers[i5].Set))
+						for k7, v7 := range o.Rules[i2].ResponseFilters.Headers[i5].Set {
+							cp.Rules[i2].ResponseFilters.Headers[i5].Set[k7] = v7
+						}
+					}
+				}
+			}
+			if o.Rules[i2].Matches != nil {
+				cp.Rules[i2].Matches = make([]GRPCMatch, len(o.Rules[i2].Matches))
+				copy(cp.Rules[i2].Matches, o.Rules[i2].Matches)
+				for i4 := range o.Rules[i2].Matches {
+					if o.Rules[i2].Matches[i4].Headers != nil {
+						cp.Rules[i2].Matches[i4].Headers = make([]HTTPHeaderMatch, len(o.Rules[i2].Matches[i4].Headers))
+						copy(cp.Rules[i2].Matches[i4].Headers, o.Rules[i2].Matches[i4].Headers)
+					}
+				}
+			}
+			if o.Rules[i2].Services != nil {
+				cp.Rules[i2].Services = make([]HTTPService, len(o.Rules[i2].Services))
+				copy(cp.Rules[i2].Services, o.Rules[i2].Services)
+				for i4 := range o.Rules[i2].Services {
+					if o.Rules[i2].Services[i4].Filters.Headers != nil {
+						cp.Rules[i2].Services[i4].Filters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].Services[i4].Filters.Headers))
+						copy(cp.Rules[i2].Services[i4].Filters.Headers, o.Rules[i2].Services[i4].Filters.Headers)
+						for i7 := range o.Rules[i2].Services[i4].Filters.Headers {
+							if o.Rules[i2].Services[i4].Filters.Headers[i7].Add != nil {
+								cp.Rules[i2].Services[i4].Filters.Headers[i7].Add = make(map[string]string, len(o.Rules[i2].Services[i4].Filters.Headers[i7].Add))
+								for k9, v9 := range o.Rules[i2].Services[i4].Filters.Headers[i7].Add {
+									cp.Rules[i2].Services[i4].Filters.Headers[i7].Add[k9] = v9
+								}
+							}
+							if o.Rules[i2].Services[i4].Filters.Headers[i7].Remove != nil {
+								cp.Rules[i2].Services[i4].Filters.Headers[i7].Remove = make([]string, len(o.Rules[i2].Services[i4].Filters.Headers[i7].Remove))
+								copy(cp.Rules[i2].Services[i4].Filters.Headers[i7].Remove, o.Rules[i2].Services[i4].Filters.Headers[i7].Remove)
+							}
+							if o.Rules[i2].Services[i4].Filters.Headers[i7].Set != nil {
+								cp.Rules[i2].Services[i4].Filters.Headers[i7].Set = make(map[string]string, len(o.Rules[i2].Services[i4].Filters.Headers[i7].Set))
+								for k9, v9 := range o.Rules[i2].Services[i4].Filters.Headers[i7].Set {
+									cp.Rules[i2].Services[i4].Filters.Headers[i7].Set[k9] = v9
+								}
+							}
+						}
+					}
+					if o.Rules[i2].Services[i4].Filters.URLRewrite != nil {
+						cp.Rules[i2].Services[i4].Filters.URLRewrite = new(URLRewrite)
+						*cp.Rules[i2].Services[i4].Filters.URLRewrite = *o.Rules[i2].Services[i4].Filters.URLRewrite
+					}
+					if o.Rules[i2].Services[i4].Filters.RetryFilter != nil {
+						cp.Rules[i2].Services[i4].Filters.RetryFilter = new(RetryFilter)
+						*cp.Rules[i2].Services[i4].Filters.RetryFilter = *o.Rules[i2].Services[i4].Filters.RetryFilter
+						if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn != nil {
+							cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn = make([]string, len(o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn))
+							copy(cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn, o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOn)
+						}
+						if o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes != nil {
+							cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes = make([]uint32, len(o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes))
+							copy(cp.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes, o.Rules[i2].Services[i4].Filters.RetryFilter.RetryOnStatusCodes)
+						}
+					}
+					if o.Rules[i2].Services[i4].Filters.TimeoutFilter != nil {
+						cp.Rules[i2].Services[i4].Filters.TimeoutFilter = new(TimeoutFilter)
+						*cp.Rules[i2].Services[i4].Filters.TimeoutFilter = *o.Rules[i2].Services[i4].Filters.TimeoutFilter
+					}
+					if o.Rules[i2].Services[i4].Filters.JWT != nil {
+						cp.Rules[i2].Services[i4].Filters.JWT = o.Rules[i2].Services[i4].Filters.JWT.DeepCopy()
+					}
+					if o.Rules[i2].Services[i4].Filters.RequestRedirect != nil {
+						cp.Rules[i2].Services[i4].Filters.RequestRedirect = new(RequestRedirectFilter)
+						*cp.Rules[i2].Services[i4].Filters.RequestRedirect = *o.Rules[i2].Services[i4].Filters.RequestRedirect
+					}
+					if o.Rules[i2].Services[i4].Filters.DirectResponse != nil {
+						cp.Rules[i2].Services[i4].Filters.DirectResponse = new(DirectResponseFilter)
+						*cp.Rules[i2].Services[i4].Filters.DirectResponse = *o.Rules[i2].Services[i4].Filters.DirectResponse
+					}
+					if o.Rules[i2].Services[i4].ResponseFilters.Headers != nil {
+						cp.Rules[i2].Services[i4].ResponseFilters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].Services[i4].ResponseFilters.Headers))
+						copy(cp.Rules[i2].Services[i4].ResponseFilters.Headers, o.Rules[i2].Services[i4].ResponseFilters.Headers)
+						for i7 := range o.Rules[i2].Services[i4].ResponseFilters.Headers {
+							if o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add != nil {
+								cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add = make(map[string]string, len(o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add))
+								for k9, v9 := range o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add {
+									cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Add[k9] = v9
+								}
+							}
+							if o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove != nil {
+								cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove = make([]string, len(o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove))
+								copy(cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove, o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Remove)
+							}
+							if o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set != nil {
+								cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set = make(map[string]string, len(o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set))
+								for k9, v9 := range o.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set {
+									cp.Rules[i2].Services[i4].ResponseFilters.Headers[i7].Set[k9] = v9
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	if o.Hostnames != nil {
+		cp.Hostnames = make([]string, len(o.Hostnames))
+		copy(cp.Hostnames, o.Hostnames)
+	}
+	if o.Meta != nil {
+		cp.Meta = make(map[string]string, len(o.Meta))
+		for k2, v2 := range o.Meta {
+			cp.Meta[k2] = v2
+		}
+	}
+	{
+		retV := o.Status.DeepCopy()
+		cp.Status = *retV
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *HTTPHeaderModifiers
 func (o *HTTPHeaderModifiers) DeepCopy() *HTTPHeaderModifiers {
 	var cp HTTPHeaderModifiers = *o
@@ -428,6 +686,14 @@ func (o *HTTPRouteConfigEntry) DeepCopy() *HTTPRouteConfigEntry {
 			if o.Rules[i2].Filters.JWT != nil {
 				cp.Rules[i2].Filters.JWT = o.Rules[i2].Filters.JWT.DeepCopy()
 			}
+			if o.Rules[i2].Filters.RequestRedirect != nil {
+				cp.Rules[i2].Filters.RequestRedirect = new(RequestRedirectFilter)
+				*cp.Rules[i2].Filters.RequestRedirect = *o.Rules[i2].Filters.RequestRedirect
+			}
+			if o.Rules[i2].Filters.DirectResponse != nil {
+				cp.Rules[i2].Filters.DirectResponse = new(DirectResponseFilter)
+				*cp.Rules[i2].Filters.DirectResponse = *o.Rules[i2].Filters.DirectResponse
+			}
 			if o.Rules[i2].ResponseFilters.Headers != nil {
 				cp.Rules[i2].ResponseFilters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].ResponseFilters.Headers))
 				copy(cp.Rules[i2].ResponseFilters.Headers, o.Rules[i2].ResponseFilters.Headers)
@@ -513,6 +779,14 @@ func (o *HTTPRouteConfigEntry) DeepCopy() *HTTPRouteConfigEntry {
 					if o.Rules[i2].Services[i4].Filters.JWT != nil {
 						cp.Rules[i2].Services[i4].Filters.JWT = o.Rules[i2].Services[i4].Filters.JWT.DeepCopy()
 					}
+					if o.Rules[i2].Services[i4].Filters.RequestRedirect != nil {
+						cp.Rules[i2].Services[i4].Filters.RequestRedirect = new(RequestRedirectFilter)
+						*cp.Rules[i2].Services[i4].Filters.RequestRedirect = *o.Rules[i2].Services[i4].Filters.RequestRedirect
+					}
+					if o.Rules[i2].Services[i4].Filters.DirectResponse != nil {
+						cp.Rules[i2].Services[i4].Filters.DirectResponse = new(DirectResponseFilter)
+						*cp.Rules[i2].Services[i4].Filters.DirectResponse = *o.Rules[i2].Services[i4].Filters.DirectResponse
+					}
 					if o.Rules[i2].Services[i4].ResponseFilters.Headers != nil {
 						cp.Rules[i2].Services[i4].ResponseFilters.Headers = make([]HTTPHeaderFilter, len(o.Rules[i2].Services[i4].ResponseFilters.Headers))
 						copy(cp.Rules[i2].Services[i4].ResponseFilters.Headers, o.Rules[i2].Services[i4].ResponseFilters.Headers)
@@ -537,6 +811,30 @@ func (o *HTTPRouteConfigEntry) DeepCopy() *HTTPRouteConfigEntry {
 					}
 				}
 			}
+			if o.Rules[i2].CORS != nil {
+				cp.Rules[i2].CORS = new(CORSPolicy)
+				*cp.Rules[i2].CORS = *o.Rules[i2].CORS
+				if o.Rules[i2].CORS.AllowOrigins != nil {
+					cp.Rules[i2].CORS.AllowOrigins = make([]string, len(o.Rules[i2].CORS.AllowOrigins))
+					copy(cp.Rules[i2].CORS.AllowOrigins, o.Rules[i2].CORS.AllowOrigins)
+				}
+				if o.Rules[i2].CORS.AllowOriginRegexes != nil {
+					cp.Rules[i2].CORS.AllowOriginRegexes = make([]string, len(o.Rules[i2].CORS.AllowOriginRegexes))
+					copy(cp.Rules[i2].CORS.AllowOriginRegexes, o.Rules[i2].CORS.AllowOriginRegexes)
+				}
+				if o.Rules[i2].CORS.AllowMethods != nil {
+					cp.Rules[i2].CORS.AllowMethods = make([]string, len(o.Rules[i2].CORS.AllowMethods))
+					copy(cp.Rules[i2].CORS.AllowMethods, o.Rules[i2].CORS.AllowMethods)
+				}
+				if o.Rules[i2].CORS.AllowHeaders != nil {
+					cp.Rules[i2].CORS.AllowHeaders = make([]string, len(o.Rules[i2].CORS.AllowHeaders))
+					copy(cp.Rules[i2].CORS.AllowHeaders, o.Rules[i2].CORS.AllowHeaders)
+				}
+				if o.Rules[i2].CORS.ExposeHeaders != nil {
+					cp.Rules[i2].CORS.ExposeHeaders = make([]string, len(o.Rules[i2].CORS.ExposeHeaders))
+					copy(cp.Rules[i2].CORS.ExposeHeaders, o.Rules[i2].CORS.ExposeHeaders)
+				}
+			}
 		}
 	}
 	if o.Hostnames != nil {
@@ -654,6 +952,42 @@ func (o *IngressListener) DeepCopy() *IngressListener {
 					*cp.Services[i2].PassiveHealthCheck.BaseEjectionTime = *o.Services[i2].PassiveHealthCheck.BaseEjectionTime
 				}
 			}
+			if o.Services[i2].CORS != nil {
+				cp.Services[i2].CORS = new(CORSPolicy)
+				*cp.Services[i2].CORS = *o.Services[i2].CORS
+				if o.Services[i2].CORS.AllowOrigins != nil {
+					cp.Services[i2].CORS.AllowOrigins = make([]string, len(o.Services[i2].CORS.AllowOrigins))
+					copy(cp.Services[i2].CORS.AllowOrigins, o.Services[i2].CORS.AllowOrigins)
+				}
+				if o.Services[i2].CORS.AllowOriginRegexes != nil {
+					cp.Services[i2].CORS.AllowOriginRegexes = make([]string, len(o.Services[i2].CORS.AllowOriginRegexes))
+					copy(cp.Services[i2].CORS.AllowOriginRegexes, o.Services[i2].CORS.AllowOriginRegexes)
+				}
+				if o.Services[i2].CORS.AllowMethods != nil {
+					cp.Services[i2].CORS.AllowMethods = make([]string, len(o.Services[i2].CORS.AllowMethods))
+					copy(cp.Services[i2].CORS.AllowMethods, o.Services[i2].CORS.AllowMethods)
+				}
+				if o.Services[i2].CORS.AllowHeaders != nil {
+					cp.Services[i2].CORS.AllowHeaders = make([]string, len(o.Services[i2].CORS.AllowHeaders))
+					copy(cp.Services[i2].CORS.AllowHeaders, o.Services[i2].CORS.AllowHeaders)
+				}
+				if o.Services[i2].CORS.ExposeHeaders != nil {
+					cp.Services[i2].CORS.ExposeHeaders = make([]string, len(o.Services[i2].CORS.ExposeHeaders))
+					copy(cp.Services[i2].CORS.ExposeHeaders, o.Services[i2].CORS.ExposeHeaders)
+				}
+			}
+			if o.Services[i2].RateLimit != nil {
+				cp.Services[i2].RateLimit = new(LocalRateLimit)
+				*cp.Services[i2].RateLimit = *o.Services[i2].RateLimit
+				if o.Services[i2].RateLimit.Routes != nil {
+					cp.Services[i2].RateLimit.Routes = make([]LocalRateLimitRoute, len(o.Services[i2].RateLimit.Routes))
+					copy(cp.Services[i2].RateLimit.Routes, o.Services[i2].RateLimit.Routes)
+				}
+				if o.Services[i2].RateLimit.Descriptors != nil {
+					cp.Services[i2].RateLimit.Descriptors = make([]LocalRateLimitDescriptor, len(o.Services[i2].RateLimit.Descriptors))
+					copy(cp.Services[i2].RateLimit.Descriptors, o.Services[i2].RateLimit.Descriptors)
+				}
+			}
 			if o.Services[i2].Meta != nil {
 				cp.Services[i2].Meta = make(map[string]string, len(o.Services[i2].Meta))
 				for k4, v4 := range o.Services[i2].Meta {
@@ -662,6 +996,18 @@ func (o *IngressListener) DeepCopy() *IngressListener {
 			}
 		}
 	}
+	if o.RateLimit != nil {
+		cp.RateLimit = new(LocalRateLimit)
+		*cp.RateLimit = *o.RateLimit
+		if o.RateLimit.Routes != nil {
+			cp.RateLimit.Routes = make([]LocalRateLimitRoute, len(o.RateLimit.Routes))
+			copy(cp.RateLimit.Routes, o.RateLimit.Routes)
+		}
+		if o.RateLimit.Descriptors != nil {
+			cp.RateLimit.Descriptors = make([]LocalRateLimitDescriptor, len(o.RateLimit.Descriptors))
+			copy(cp.RateLimit.Descriptors, o.RateLimit.Descriptors)
+		}
+	}
 	return &cp
 }
 
@@ -945,6 +1291,34 @@ func (o *ServiceConfigEntry) DeepCopy() *ServiceConfigEntry {
 			copy(cp.RateLimits.InstanceLevel.Routes, o.RateLimits.InstanceLevel.Routes)
 		}
 	}
+	if o.FaultInjection != nil {
+		cp.FaultInjection = new(FaultInjection)
+		*cp.FaultInjection = *o.FaultInjection
+		if o.FaultInjection.Delay != nil {
+			cp.FaultInjection.Delay = new(FaultInjectionDelay)
+			*cp.FaultInjection.Delay = *o.FaultInjection.Delay
+		}
+		if o.FaultInjection.Abort != nil {
+			cp.FaultInjection.Abort = new(FaultInjectionAbort)
+			*cp.FaultInjection.Abort = *o.FaultInjection.Abort
+		}
+		if o.FaultInjection.Headers != nil {
+			cp.FaultInjection.Headers = make([]ServiceRouteHTTPMatchHeader, len(o.FaultInjection.Headers))
+			copy(cp.FaultInjection.Headers, o.FaultInjection.Headers)
+		}
+	}
+	if o.RateLimit != nil {
+		cp.RateLimit = new(LocalRateLimit)
+		*cp.RateLimit = *o.RateLimit
+		if o.RateLimit.Routes != nil {
+			cp.RateLimit.Routes = make([]LocalRateLimitRoute, len(o.RateLimit.Routes))
+			copy(cp.RateLimit.Routes, o.RateLimit.Routes)
+		}
+		if o.RateLimit.Descriptors != nil {
+			cp.RateLimit.Descriptors = make([]LocalRateLimitDescriptor, len(o.RateLimit.Descriptors))
+			copy(cp.RateLimit.Descriptors, o.RateLimit.Descriptors)
+		}
+	}
 	if o.EnvoyExtensions != nil {
 		cp.EnvoyExtensions = make([]EnvoyExtension, len(o.EnvoyExtensions))
 		copy(cp.EnvoyExtensions, o.EnvoyExtensions)
@@ -1112,6 +1486,36 @@ func (o *ServiceResolverConfigEntry) DeepCopy() *ServiceResolverConfigEntry {
 	if o.LoadBalancer != nil {
 		cp.LoadBalancer = o.LoadBalancer.DeepCopy()
 	}
+	if o.OutlierDetection != nil {
+		cp.OutlierDetection = new(PassiveHealthCheck)
+		*cp.OutlierDetection = *o.OutlierDetection
+		if o.OutlierDetection.EnforcingConsecutive5xx != nil {
+			cp.OutlierDetection.EnforcingConsecutive5xx = new(uint32)
+			*cp.OutlierDetection.EnforcingConsecutive5xx = *o.OutlierDetection.EnforcingConsecutive5xx
+		}
+		if o.OutlierDetection.MaxEjectionPercent != nil {
+			cp.OutlierDetection.MaxEjectionPercent = new(uint32)
+			*cp.OutlierDetection.MaxEjectionPercent = *o.OutlierDetection.MaxEjectionPercent
+		}
+		if o.OutlierDetection.BaseEjectionTime != nil {
+			cp.OutlierDetection.BaseEjectionTime = new(time.Duration)
+			*cp.OutlierDetection.BaseEjectionTime = *o.OutlierDetection.BaseEjectionTime
+		}
+	}
+	if o.HealthChecks != nil {
+		cp.HealthChecks = make([]ActiveHealthCheck, len(o.HealthChecks))
+		copy(cp.HealthChecks, o.HealthChecks)
+		for i2 := range o.HealthChecks {
+			if o.HealthChecks[i2].HTTP != nil {
+				cp.HealthChecks[i2].HTTP = new(ActiveHealthCheckHTTP)
+				*cp.HealthChecks[i2].HTTP = *o.HealthChecks[i2].HTTP
+			}
+			if o.HealthChecks[i2].GRPC != nil {
+				cp.HealthChecks[i2].GRPC = new(ActiveHealthCheckGRPC)
+				*cp.HealthChecks[i2].GRPC = *o.HealthChecks[i2].GRPC
+			}
+		}
+	}
 	if o.Meta != nil {
 		cp.Meta = make(map[string]string, len(o.Meta))
 		for k2, v2 := range o.Meta {
@@ -1172,6 +1576,70 @@ func (o *ServiceRouteDestination) DeepCopy() *ServiceRouteDestination {
 	if o.ResponseHeaders != nil {
 		cp.ResponseHeaders = o.ResponseHeaders.DeepCopy()
 	}
+	if o.Mirror != nil {
+		cp.Mirror = make([]ServiceRouteMirror, len(o.Mirror))
+		copy(cp.Mirror, o.Mirror)
+	}
+	if o.FaultInjection != nil {
+		cp.FaultInjection = new(FaultInjection)
+		*cp.FaultInjection = *o.FaultInjection
+		if o.FaultInjection.Delay != nil {
+			cp.FaultInjection.Delay = new(FaultInjectionDelay)
+			*cp.FaultInjection.Delay = *o.FaultInjection.Delay
+		}
+		if o.FaultInjection.Abort != nil {
+			cp.FaultInjection.Abort = new(FaultInjectionAbort)
+			*cp.FaultInjection.Abort = *o.FaultInjection.Abort
+		}
+		if o.FaultInjection.Headers != nil {
+			cp.FaultInjection.Headers = make([]ServiceRouteHTTPMatchHeader, len(o.FaultInjection.Headers))
+			copy(cp.FaultInjection.Headers, o.FaultInjection.Headers)
+		}
+	}
+	if o.RateLimit != nil {
+		cp.RateLimit = new(LocalRateLimit)
+		*cp.RateLimit = *o.RateLimit
+		if o.RateLimit.Routes != nil {
+			cp.RateLimit.Routes = make([]LocalRateLimitRoute, len(o.RateLimit.Routes))
+			copy(cp.RateLimit.Routes, o.RateLimit.Routes)
+		}
+		if o.RateLimit.Descriptors != nil {
+			cp.RateLimit.Descriptors = make([]LocalRateLimitDescriptor, len(o.RateLimit.Descriptors))
+			copy(cp.RateLimit.Descriptors, o.RateLimit.Descriptors)
+		}
+	}
+	if o.CORS != nil {
+		cp.CORS = new(CORSPolicy)
+		*cp.CORS = *o.CORS
+		if o.CORS.AllowOrigins != nil {
+			cp.CORS.AllowOrigins = make([]string, len(o.CORS.AllowOrigins))
+			copy(cp.CORS.AllowOrigins, o.CORS.AllowOrigins)
+		}
+		if o.CORS.AllowOriginRegexes != nil {
+			cp.CORS.AllowOriginRegexes = make([]string, len(o.CORS.AllowOriginRegexes))
+			copy(cp.CORS.AllowOriginRegexes, o.CORS.AllowOriginRegexes)
+		}
+		if o.CORS.AllowMethods != nil {
+			cp.CORS.AllowMethods = make([]string, len(o.CORS.AllowMethods))
+			copy(cp.CORS.AllowMethods, o.CORS.AllowMethods)
+		}
+		if o.CORS.AllowHeaders != nil {
+			cp.CORS.AllowHeaders = make([]string, len(o.CORS.AllowHeaders))
+			copy(cp.CORS.AllowHeaders, o.CORS.AllowHeaders)
+		}
+		if o.CORS.ExposeHeaders != nil {
+			cp.CORS.ExposeHeaders = make([]string, len(o.CORS.ExposeHeaders))
+			copy(cp.CORS.ExposeHeaders, o.CORS.ExposeHeaders)
+		}
+	}
+	if o.RequestRedirect != nil {
+		cp.RequestRedirect = new(RequestRedirectFilter)
+		*cp.RequestRedirect = *o.RequestRedirect
+	}
+	if o.DirectResponse != nil {
+		cp.DirectResponse = new(DirectResponseFilter)
+		*cp.DirectResponse = *o.DirectResponse
+	}
 	return &cp
 }
 
@@ -1193,6 +1661,20 @@ func (o *ServiceRouteMatch) DeepCopy() *ServiceRouteMatch {
 			cp.HTTP.Methods = make([]string, len(o.HTTP.Methods))
 			copy(cp.HTTP.Methods, o.HTTP.Methods)
 		}
+		if o.HTTP.JWT != nil {
+			cp.HTTP.JWT = new(ServiceRouteHTTPMatchJWT)
+			*cp.HTTP.JWT = *o.HTTP.JWT
+			if o.HTTP.JWT.Claims != nil {
+				cp.HTTP.JWT.Claims = make([]ServiceRouteHTTPMatchJWTClaim, len(o.HTTP.JWT.Claims))
+				copy(cp.HTTP.JWT.Claims, o.HTTP.JWT.Claims)
+				for i5 := range o.HTTP.JWT.Claims {
+					if o.HTTP.JWT.Claims[i5].Path != nil {
+						cp.HTTP.JWT.Claims[i5].Path = make([]string, len(o.HTTP.JWT.Claims[i5].Path))
+						copy(cp.HTTP.JWT.Claims[i5].Path, o.HTTP.JWT.Claims[i5].Path)
+					}
+				}
+			}
+		}
 	}
 	return &cp
 }
@@ -1237,6 +1719,34 @@ func (o *TCPRouteConfigEntry) DeepCopy() *TCPRouteConfigEntry {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *TLSRouteConfigEntry
+func (o *TLSRouteConfigEntry) DeepCopy() *TLSRouteConfigEntry {
+	var cp TLSRouteConfigEntry = *o
+	if o.Parents != nil {
+		cp.Parents = make([]ResourceReference, len(o.Parents))
+		copy(cp.Parents, o.Parents)
+	}
+	if o.Hostnames != nil {
+		cp.Hostnames = make([]string, len(o.Hostnames))
+		copy(cp.Hostnames, o.Hostnames)
+	}
+	if o.Services != nil {
+		cp.Services = make([]TCPService, len(o.Services))
+		copy(cp.Services, o.Services)
+	}
+	if o.Meta != nil {
+		cp.Meta = make(map[string]string, len(o.Meta))
+		for k2, v2 := range o.Meta {
+			cp.Meta[k2] = v2
+		}
+	}
+	{
+		retV := o.Status.DeepCopy()
+		cp.Status = *retV
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *Upstream
 func (o *Upstream) DeepCopy() *Upstream {
 	var cp Upstream = *o
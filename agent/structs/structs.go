@@ -3167,6 +3167,13 @@ func (l *Locality) GetRegion() string {
 	return l.Region
 }
 
+func (l *Locality) GetZone() string {
+	if l == nil {
+		return ""
+	}
+	return l.Zone
+}
+
 func (l *Locality) Validate() error {
 	if l == nil {
 		return nil
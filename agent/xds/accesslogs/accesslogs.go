@@ -48,6 +48,16 @@ const (
 `
 )
 
+// intentionDenialFields are added to the default JSON format when
+// IncludeIntentionDenials is set. They carry what is needed to report the
+// requests and connections denied by intentions: the downstream's SPIFFE ID
+// and the RBAC shadow policy that matched.
+var intentionDenialFields = map[string]string{
+	"downstream_peer_uri_san":       "%DOWNSTREAM_PEER_URI_SAN%",
+	"intention_http_deny_policy":    "%DYNAMIC_METADATA(envoy.filters.http.rbac:shadow_effective_policy_id)%",
+	"intention_network_deny_policy": "%DYNAMIC_METADATA(envoy.filters.network.rbac:shadow_effective_policy_id)%",
+}
+
 // MakeAccessLogs returns a fully-hydrated slice of Envoy Access log configurations based
 // on the proxy-defaults settings. Currently only one access logger is supported.
 // Listeners (as opposed to listener filters) can trigger an access log filter with the boolean.
@@ -124,6 +134,11 @@ func getLogFormat(logs *structs.AccessLogsConfig) (*envoy_core_v3.SubstitutionFo
 		if err := jsonFormat.UnmarshalJSON([]byte(format)); err != nil {
 			return nil, fmt.Errorf("could not unmarshal JSON format string: %w", err)
 		}
+		if format == defaultJSONFormat && logs.IncludeIntentionDenials {
+			for k, v := range intentionDenialFields {
+				jsonFormat.Fields[k] = structpb.NewStringValue(v)
+			}
+		}
 
 		return &envoy_core_v3.SubstitutionFormatString{
 			Format: &envoy_core_v3.SubstitutionFormatString_JsonFormat{
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"math"
+
+	envoy_buffer_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const bufferEnvoyFilter = "envoy.filters.http.buffer"
+
+// makeBufferFilter builds the Envoy buffer filter that rejects requests with
+// a body larger than maxBytes with a 413 status. A zero maxBytes yields a
+// disabled filter that only takes effect on virtual hosts and routes that
+// configure their own limit.
+func makeBufferFilter(maxBytes uint32) (*envoy_http_v3.HttpFilter, error) {
+	if maxBytes != 0 {
+		return makeEnvoyHTTPFilter(bufferEnvoyFilter, makeBufferConfig(maxBytes))
+	}
+
+	// Envoy requires a limit even when the filter is disabled.
+	filter, err := makeEnvoyHTTPFilter(bufferEnvoyFilter, makeBufferConfig(math.MaxUint32))
+	if err != nil {
+		return nil, err
+	}
+	filter.Disabled = true
+	return filter, nil
+}
+
+// makeBufferPerRouteConfig builds the typed per-filter config that gives a
+// single virtual host or route its own request body size limit.
+func makeBufferPerRouteConfig(maxBytes uint32) (*anypb.Any, error) {
+	return anypb.New(&envoy_buffer_v3.BufferPerRoute{
+		Override: &envoy_buffer_v3.BufferPerRoute_Buffer{
+			Buffer: makeBufferConfig(maxBytes),
+		},
+	})
+}
+
+func makeBufferConfig(maxBytes uint32) *envoy_buffer_v3.Buffer {
+	return &envoy_buffer_v3.Buffer{
+		MaxRequestBytes: wrapperspb.UInt32(maxBytes),
+	}
+}
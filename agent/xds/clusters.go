@@ -1077,9 +1077,11 @@ func (s *ResourceGenerator) configIngressUpstreamCluster(c *envoy_cluster_v3.Clu
 	if svc != nil {
 		override = svc.PassiveHealthCheck
 	}
-	outlierDetection := config.ToOutlierDetection(cfgSnap.IngressGateway.Defaults.PassiveHealthCheck, override, false)
-
-	c.OutlierDetection = outlierDetection
+	// c.OutlierDetection was built from the target's service-resolver, so the
+	// gateway defaults and then the per-service settings are layered on top of
+	// it rather than replacing it.
+	outlierDetection := config.OverrideOutlierDetection(c.OutlierDetection, cfgSnap.IngressGateway.Defaults.PassiveHealthCheck, false)
+	c.OutlierDetection = config.OverrideOutlierDetection(outlierDetection, override, false)
 }
 
 func (s *ResourceGenerator) makeAppCluster(cfgSnap *proxycfg.ConfigSnapshot, name, pathProtocol string, port int) (*envoy_cluster_v3.Cluster, error) {
@@ -1538,7 +1540,7 @@ func (s *ResourceGenerator) makeUpstreamClustersForDiscoveryChain(
 				CircuitBreakers: &envoy_cluster_v3.CircuitBreakers{
 					Thresholds: makeThresholdsIfNeeded(upstreamConfig.Limits),
 				},
-				OutlierDetection: config.ToOutlierDetection(upstreamConfig.PassiveHealthCheck, nil, true),
+				OutlierDetection: config.ToOutlierDetection(node.Resolver.OutlierDetection, upstreamConfig.PassiveHealthCheck, true),
 			}
 
 			var lb *structs.LoadBalancer
@@ -1572,6 +1574,20 @@ func (s *ResourceGenerator) makeUpstreamClustersForDiscoveryChain(
 				c.TransportSocket = transportSocket
 			}
 
+			if groupedTarget.Targets[0].PrioritizeByLocality.IsZoneAware() {
+				c.CommonLbConfig.LocalityConfigSpecifier = makeZoneAwareLbConfig(groupedTarget.Targets[0].PrioritizeByLocality)
+			}
+
+			// Active health checks are only sent directly to instances. Targets
+			// behind a mesh gateway or in a peer cluster are skipped since the
+			// checks would be answered by the gateway instead.
+			if target := chain.Targets[groupedTarget.Targets[0].TargetID]; target != nil &&
+				target.Peer == "" &&
+				target.Datacenter == cfgSnap.Datacenter &&
+				target.Partition == cfgSnap.ProxyID.PartitionOrDefault() {
+				c.HealthChecks = s.makeActiveHealthChecks(node.Resolver.HealthChecks, upstreamConfig.Protocol, uid)
+			}
+
 			out = append(out, c)
 		}
 	}
@@ -2048,6 +2064,52 @@ func injectLBToCluster(ec *structs.LoadBalancer, c *envoy_cluster_v3.Cluster) er
 	return nil
 }
 
+// makeActiveHealthChecks converts the active health checks of a
+// service-resolver into their Envoy representation.
+func (s *ResourceGenerator) makeActiveHealthChecks(checks []structs.ActiveHealthCheck, protocol string, uid proxycfg.UpstreamID) []*envoy_core_v3.HealthCheck {
+	isHTTP2 := protocol == "http2" || protocol == "grpc"
+
+	var out []*envoy_core_v3.HealthCheck
+	for _, hc := range checks {
+		hc = hc.WithDefaults()
+		check := &envoy_core_v3.HealthCheck{
+			Interval:           durationpb.New(hc.Interval),
+			Timeout:            durationpb.New(hc.Timeout),
+			UnhealthyThreshold: response.MakeUint32Value(int(hc.UnhealthyThreshold)),
+			HealthyThreshold:   response.MakeUint32Value(int(hc.HealthyThreshold)),
+		}
+
+		switch {
+		case hc.HTTP != nil:
+			httpCheck := &envoy_core_v3.HealthCheck_HttpHealthCheck{
+				Path: hc.HTTP.Path,
+				Host: hc.HTTP.Host,
+			}
+			if isHTTP2 {
+				httpCheck.CodecClientType = envoy_type_v3.CodecClientType_HTTP2
+			}
+			check.HealthChecker = &envoy_core_v3.HealthCheck_HttpHealthCheck_{HttpHealthCheck: httpCheck}
+		case hc.GRPC != nil:
+			if !isHTTP2 {
+				s.Logger.Warn("ignoring gRPC health check for upstream that does not use the grpc or http2 protocol",
+					"upstream", uid, "protocol", protocol)
+				continue
+			}
+			check.HealthChecker = &envoy_core_v3.HealthCheck_GrpcHealthCheck_{
+				GrpcHealthCheck: &envoy_core_v3.HealthCheck_GrpcHealthCheck{
+					ServiceName: hc.GRPC.ServiceName,
+					Authority:   hc.GRPC.Authority,
+				},
+			}
+		default:
+			continue
+		}
+
+		out = append(out, check)
+	}
+	return out
+}
+
 func (s *ResourceGenerator) setHttp2ProtocolOptions(c *envoy_cluster_v3.Cluster) error {
 	cfg := &envoy_upstreams_v3.HttpProtocolOptions{
 		UpstreamProtocolOptions: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
@@ -22,6 +22,7 @@ func parseConfig[T any](m map[string]any, cfg *T) error {
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
 			decode.HookWeakDecodeFromSlice,
 			decode.HookTranslateKeys,
+			mapstructure.StringToTimeDurationHookFunc(),
 		),
 		Result:           cfg,
 		WeaklyTypedInput: true,
@@ -122,6 +123,14 @@ type ProxyConfig struct {
 	// BalanceInboundConnections indicates how the proxy should attempt to distribute
 	// connections across worker threads. Only used by envoy proxies.
 	BalanceInboundConnections string `json:",omitempty" alias:"balance_inbound_connections"`
+
+	// FaultInjection configures delays and aborts injected into requests to
+	// the local application. Only used for HTTP-based protocols.
+	FaultInjection *structs.FaultInjection `mapstructure:"fault_injection"`
+
+	// RateLimit configures the rate of requests the proxy allows to the
+	// local application. Only used for HTTP-based protocols.
+	RateLimit *structs.LocalRateLimit `mapstructure:"rate_limit"`
 }
 
 // ParseProxyConfig returns the ProxyConfig parsed from the an opaque map. If an
@@ -196,7 +205,8 @@ func ParseGatewayConfig(m map[string]interface{}) (GatewayConfig, error) {
 // Return an envoy.OutlierDetection populated by the values from structs.PassiveHealthCheck.
 // If all values are zero a default empty OutlierDetection will be returned to
 // enable outlier detection with default values.
-//   - If override is not nil, it will overwrite the values from p, e.g., ingress gateway defaults
+//   - If override is not nil, it will overwrite the values from p, e.g., an upstream's
+//     passive_health_check layered on its service-resolver's outlier detection
 //   - allowZero is added to handle the legacy case where connect-proxy and mesh gateway can set 0
 //     for EnforcingConsecutive5xx. Due to the definition of proto of PassiveHealthCheck, ingress
 //     gateway's EnforcingConsecutive5xx must be > 0.
@@ -228,11 +238,21 @@ func ToOutlierDetection(p *structs.PassiveHealthCheck, override *structs.Passive
 		}
 	}
 
+	return OverrideOutlierDetection(od, override, allowZero)
+}
+
+// OverrideOutlierDetection overwrites the fields of od that are set in
+// override and returns od. It lets callers layer further configuration, such
+// as ingress gateway defaults and per-service settings, on top of an outlier
+// detector built from a service-resolver.
+func OverrideOutlierDetection(od *envoy_cluster_v3.OutlierDetection, override *structs.PassiveHealthCheck, allowZero bool) *envoy_cluster_v3.OutlierDetection {
+	if od == nil {
+		od = &envoy_cluster_v3.OutlierDetection{}
+	}
 	if override == nil {
 		return od
 	}
 
-	// override the default outlier detection value
 	if override.Interval != 0 {
 		od.Interval = durationpb.New(override.Interval)
 	}
@@ -241,12 +261,12 @@ func ToOutlierDetection(p *structs.PassiveHealthCheck, override *structs.Passive
 	}
 
 	if override.EnforcingConsecutive5xx != nil {
-		// NOTE: EnforcingConsecutive5xx must be great than 0 for ingress-gateway
+		// NOTE: EnforcingConsecutive5xx must be greater than 0 for ingress-gateway
 		if *override.EnforcingConsecutive5xx != 0 {
 			od.EnforcingConsecutive_5Xx = &wrapperspb.UInt32Value{Value: *override.EnforcingConsecutive5xx}
+		} else if allowZero {
+			od.EnforcingConsecutive_5Xx = &wrapperspb.UInt32Value{Value: *override.EnforcingConsecutive5xx}
 		}
-		// Because only ingress gateways have overrides and they cannot have a value of 0, there is no allowZero
-		// override case to handle
 	}
 
 	if override.MaxEjectionPercent != nil {
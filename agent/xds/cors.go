@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"strconv"
+	"strings"
+
+	envoy_cors_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/agent/xds/response"
+)
+
+const corsEnvoyFilter = "envoy.filters.http.cors"
+
+// makeCORSFilter builds the Envoy CORS filter for an HTTP connection manager.
+// The filter itself carries no policy; it only takes effect on virtual hosts
+// and routes that configure one.
+func makeCORSFilter() (*envoy_http_v3.HttpFilter, error) {
+	return makeEnvoyHTTPFilter(corsEnvoyFilter, &envoy_cors_v3.Cors{})
+}
+
+// makeCORSPerRouteConfig builds the typed per-filter config that applies a
+// CORS policy to a single virtual host or route.
+func makeCORSPerRouteConfig(cors *structs.CORSPolicy) (*anypb.Any, error) {
+	policy := &envoy_cors_v3.CorsPolicy{
+		AllowMethods:  strings.Join(cors.AllowMethods, ","),
+		AllowHeaders:  strings.Join(cors.AllowHeaders, ","),
+		ExposeHeaders: strings.Join(cors.ExposeHeaders, ","),
+	}
+
+	for _, origin := range cors.AllowOrigins {
+		policy.AllowOriginStringMatch = append(policy.AllowOriginStringMatch, &envoy_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{
+				Exact: origin,
+			},
+		})
+	}
+	for _, re := range cors.AllowOriginRegexes {
+		policy.AllowOriginStringMatch = append(policy.AllowOriginStringMatch, &envoy_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_matcher_v3.StringMatcher_SafeRegex{
+				SafeRegex: response.MakeEnvoyRegexMatch(re),
+			},
+		})
+	}
+
+	if cors.MaxAge > 0 {
+		policy.MaxAge = strconv.FormatInt(int64(cors.MaxAge.Seconds()), 10)
+	}
+	if cors.AllowCredentials {
+		policy.AllowCredentials = response.MakeBoolValue(true)
+	}
+
+	return anypb.New(policy)
+}
+
+// httpRouteUsesCORS returns true if any rule of the http-route has a CORS
+// policy.
+func httpRouteUsesCORS(route *structs.HTTPRouteConfigEntry) bool {
+	for _, rule := range route.Rules {
+		if rule.CORS != nil {
+			return true
+		}
+	}
+	return false
+}
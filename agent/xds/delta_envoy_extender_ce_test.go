@@ -720,6 +720,56 @@ end`,
 				}, nil)
 			},
 		},
+		{
+			// Insert a gRPC-JSON transcoder filter before the router filter of the inbound listener.
+			name: "grpc-json-transcoder-inbound-inline-descriptor",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config = map[string]any{"protocol": "grpc"}
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name: api.BuiltinGRPCJSONTranscoderExtension,
+							Arguments: map[string]any{
+								"Config": map[string]any{
+									"ProtoDescriptor": map[string]any{
+										"Inline": makeHealthProtoDescriptorSet(t),
+									},
+									"Services": []string{"grpc.health.v1.Health"},
+									"PrintOptions": map[string]any{
+										"AddWhitespace":              true,
+										"AlwaysPrintPrimitiveFields": true,
+									},
+									"ConvertGrpcStatus": true,
+								},
+							},
+						},
+					}
+				}, nil)
+			},
+		},
+		{
+			// Insert a gRPC-JSON transcoder filter into the HTTP listeners of an ingress gateway.
+			name: "grpc-json-transcoder-ingress-gateway-file-descriptor",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "grpc", "default", func(ns *structs.NodeService) {
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name: api.BuiltinGRPCJSONTranscoderExtension,
+							Arguments: map[string]any{
+								"ProxyType": "ingress-gateway",
+								"Config": map[string]any{
+									"ProtoDescriptor": map[string]any{
+										"Filename": "/etc/envoy/proto/health.pb",
+									},
+									"Services":    []string{"grpc.health.v1.Health"},
+									"AutoMapping": true,
+								},
+							},
+						},
+					}
+				}, nil, nil)
+			},
+		},
 		{
 			// Insert an OpenTelemetry access logging extension
 			name: "otel-access-logging-http",
@@ -766,6 +816,37 @@ end`,
 				}, nil)
 			},
 		},
+		{
+			// Insert an OpenTelemetry tracing extension targeting a local collector
+			name: "otel-tracing-http",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config = map[string]any{"protocol": "http"}
+					ns.Proxy.EnvoyExtensions = []structs.EnvoyExtension{
+						{
+							Name: "builtin/otel-tracing",
+							Arguments: map[string]any{
+								"Config": map[string]any{
+									"GrpcService": map[string]any{
+										"Target": map[string]any{
+											"URI":     "localhost:4317",
+											"Timeout": "2s",
+										},
+									},
+									"SamplingRate":     10,
+									"MaxPathTagLength": 128,
+									"SpanTags": map[string]string{
+										"deployment.environment": "staging",
+										"team":                   "payments",
+									},
+								},
+							},
+							Required: true,
+						},
+					}
+				}, nil)
+			},
+		},
 		{
 			name: "tproxy-and-permissive-mtls-and-envoy-extension",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -4,9 +4,17 @@
 package xds
 
 import (
+	"encoding/base64"
 	"fmt"
 	"strings"
 
+	testinf "github.com/mitchellh/go-testing-interface"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+
 	"github.com/hashicorp/consul/agent/structs"
 	"github.com/hashicorp/consul/api"
 )
@@ -162,3 +170,16 @@ func makeWasmEnvoyExtension(proto, listener, locale string) []structs.EnvoyExten
 		},
 	}
 }
+
+// makeHealthProtoDescriptorSet returns the base64 encoded descriptor set of the
+// gRPC health checking service, for use as an inline gRPC-JSON transcoder descriptor.
+func makeHealthProtoDescriptorSet(t testinf.T) string {
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(healthpb.File_grpc_health_v1_health_proto),
+		},
+	}
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(fds)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(raw)
+}
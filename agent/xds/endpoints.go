@@ -180,6 +180,22 @@ func (s *ResourceGenerator) endpointsFromSnapshotConnectProxy(cfgSnap *proxycfg.
 		return true
 	})
 
+	// The bootstrap config only defines the local service cluster when the
+	// proxy has a locality, as Envoy only needs it for zone-aware routing.
+	if cfgSnap.ServiceLocality != nil {
+		la := makeLoadAssignment(
+			s.Logger,
+			cfgSnap,
+			LocalServiceClusterName,
+			&structs.DiscoveryPrioritizeByLocality{Mode: structs.PrioritizeByLocalityModeZoneAware},
+			[]loadAssignmentEndpointGroup{
+				{Endpoints: cfgSnap.ConnectProxy.LocalServiceInstances},
+			},
+			proxycfg.GatewayKey{ /*empty so it never matches*/ },
+		)
+		resources = append(resources, la)
+	}
+
 	return resources, nil
 }
 
@@ -885,6 +901,20 @@ func makeLoadAssignment(logger hclog.Logger, cfgSnap *proxycfg.ConfigSnapshot, c
 	var priority uint32
 
 	for _, endpointGroup := range endpointGroups {
+		if policy.IsZoneAware() {
+			// Envoy picks the zone to route to itself, so the endpoints are
+			// only grouped by locality within their priority.
+			for _, l := range endpointsByLocality(endpointGroup.Endpoints) {
+				cla.Endpoints = append(cla.Endpoints, &envoy_endpoint_v3.LocalityLbEndpoints{
+					Locality:    makeEnvoyLocality(l.Locality),
+					Priority:    priority,
+					LbEndpoints: makeLbEndpoints(endpointGroup, l.Endpoints, localKey),
+				})
+			}
+			priority++
+			continue
+		}
+
 		endpointsByLocality, err := groupedEndpoints(logger, cfgSnap.ServiceLocality, policy, endpointGroup.Endpoints)
 
 		if err != nil {
@@ -896,32 +926,9 @@ func makeLoadAssignment(logger hclog.Logger, cfgSnap *proxycfg.ConfigSnapshot, c
 		}
 
 		for _, endpoints := range endpointsByLocality {
-			es := make([]*envoy_endpoint_v3.LbEndpoint, 0, len(endpointGroup.Endpoints))
-
-			for _, ep := range endpoints {
-				// TODO (mesh-gateway) - should we respect the translate_wan_addrs configuration here or just always use the wan for cross-dc?
-				_, addr, port := ep.BestAddress(!localKey.Matches(ep.Node.Datacenter, ep.Node.PartitionOrDefault()))
-				healthStatus, weight := calculateEndpointHealthAndWeight(ep, endpointGroup.OnlyPassing)
-
-				if endpointGroup.OverrideHealth != envoy_core_v3.HealthStatus_UNKNOWN {
-					healthStatus = endpointGroup.OverrideHealth
-				}
-
-				endpoint := &envoy_endpoint_v3.Endpoint{
-					Address: response.MakeAddress(addr, port),
-				}
-				es = append(es, &envoy_endpoint_v3.LbEndpoint{
-					HostIdentifier: &envoy_endpoint_v3.LbEndpoint_Endpoint{
-						Endpoint: endpoint,
-					},
-					HealthStatus:        healthStatus,
-					LoadBalancingWeight: response.MakeUint32Value(weight),
-				})
-			}
-
 			cla.Endpoints = append(cla.Endpoints, &envoy_endpoint_v3.LocalityLbEndpoints{
 				Priority:    priority,
-				LbEndpoints: es,
+				LbEndpoints: makeLbEndpoints(endpointGroup, endpoints, localKey),
 			})
 
 			priority++
@@ -939,6 +946,43 @@ func makeLoadAssignment(logger hclog.Logger, cfgSnap *proxycfg.ConfigSnapshot, c
 	return cla
 }
 
+func makeLbEndpoints(endpointGroup loadAssignmentEndpointGroup, endpoints structs.CheckServiceNodes, localKey proxycfg.GatewayKey) []*envoy_endpoint_v3.LbEndpoint {
+	es := make([]*envoy_endpoint_v3.LbEndpoint, 0, len(endpoints))
+
+	for _, ep := range endpoints {
+		// TODO (mesh-gateway) - should we respect the translate_wan_addrs configuration here or just always use the wan for cross-dc?
+		_, addr, port := ep.BestAddress(!localKey.Matches(ep.Node.Datacenter, ep.Node.PartitionOrDefault()))
+		healthStatus, weight := calculateEndpointHealthAndWeight(ep, endpointGroup.OnlyPassing)
+
+		if endpointGroup.OverrideHealth != envoy_core_v3.HealthStatus_UNKNOWN {
+			healthStatus = endpointGroup.OverrideHealth
+		}
+
+		endpoint := &envoy_endpoint_v3.Endpoint{
+			Address: response.MakeAddress(addr, port),
+		}
+		es = append(es, &envoy_endpoint_v3.LbEndpoint{
+			HostIdentifier: &envoy_endpoint_v3.LbEndpoint_Endpoint{
+				Endpoint: endpoint,
+			},
+			HealthStatus:        healthStatus,
+			LoadBalancingWeight: response.MakeUint32Value(weight),
+		})
+	}
+
+	return es
+}
+
+func makeEnvoyLocality(locality *structs.Locality) *envoy_core_v3.Locality {
+	if locality == nil {
+		return nil
+	}
+	return &envoy_core_v3.Locality{
+		Region: locality.Region,
+		Zone:   locality.Zone,
+	}
+}
+
 func makeLoadAssignmentEndpointGroup(
 	targets map[string]*structs.DiscoveryTarget,
 	targetHealth map[string]structs.CheckServiceNodes,
@@ -130,6 +130,26 @@ func GetRuntimeConfigurations(cfgSnap *proxycfg.ConfigSnapshot) map[api.Compound
 			}
 
 		}
+	case structs.ServiceKindIngressGateway:
+		kind = api.ServiceKindIngressGateway
+		// Ingress gateways only support extensions configured for the gateway itself, which patch the
+		// listeners that downstream clients connect to.
+		localSvc := api.CompoundServiceName{
+			Name:      cfgSnap.Service,
+			Namespace: cfgSnap.ProxyID.NamespaceOrDefault(),
+			Partition: cfgSnap.ProxyID.PartitionOrEmpty(),
+		}
+		extensionConfigurationsMap[localSvc] = []extensioncommon.RuntimeConfig{}
+		for _, ext := range convertEnvoyExtensions(cfgSnap.Proxy.EnvoyExtensions) {
+			extCfg := extensioncommon.RuntimeConfig{
+				EnvoyExtension:        ext,
+				ServiceName:           localSvc,
+				IsSourcedFromUpstream: false,
+				Kind:                  kind,
+				Protocol:              proxyConfigProtocol(cfgSnap.Proxy.Config),
+			}
+			extensionConfigurationsMap[localSvc] = append(extensionConfigurationsMap[localSvc], extCfg)
+		}
 	}
 
 	// If applicable, include extension configuration for remote upstreams of the local service.
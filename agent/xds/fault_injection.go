@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"math"
+
+	envoy_fault_common_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoy_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+const faultEnvoyFilter = "envoy.filters.http.fault"
+
+// makeFaultInjectionFilter builds the Envoy fault filter for an HTTP
+// connection manager. A nil fault injection config yields a filter that
+// injects nothing, which allows routes to configure faults individually.
+func makeFaultInjectionFilter(fi *structs.FaultInjection) (*envoy_http_v3.HttpFilter, error) {
+	return makeEnvoyHTTPFilter(faultEnvoyFilter, makeHTTPFault(fi))
+}
+
+// makeFaultInjectionPerRouteConfig builds the typed per-filter config that
+// overrides the fault filter's behavior for a single route.
+func makeFaultInjectionPerRouteConfig(fi *structs.FaultInjection) (*anypb.Any, error) {
+	return anypb.New(makeHTTPFault(fi))
+}
+
+func makeHTTPFault(fi *structs.FaultInjection) *envoy_fault_v3.HTTPFault {
+	fault := &envoy_fault_v3.HTTPFault{}
+	if fi == nil {
+		return fault
+	}
+
+	if fi.Delay != nil {
+		fault.Delay = &envoy_fault_common_v3.FaultDelay{
+			FaultDelaySecifier: &envoy_fault_common_v3.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(fi.Delay.FixedDelay),
+			},
+			Percentage: makeFaultPercentage(fi.Delay.Percent),
+		}
+	}
+
+	if fi.Abort != nil {
+		fault.Abort = &envoy_fault_v3.FaultAbort{
+			Percentage: makeFaultPercentage(fi.Abort.Percent),
+		}
+		if fi.Abort.GRPCStatus != 0 {
+			fault.Abort.ErrorType = &envoy_fault_v3.FaultAbort_GrpcStatus{GrpcStatus: fi.Abort.GRPCStatus}
+		} else {
+			fault.Abort.ErrorType = &envoy_fault_v3.FaultAbort_HttpStatus{HttpStatus: fi.Abort.HTTPStatus}
+		}
+	}
+
+	if len(fi.Headers) > 0 {
+		fault.Headers = makeHeaderMatchers(fi.Headers)
+	}
+
+	return fault
+}
+
+// makeFaultPercentage converts a percentage between 0 and 100 into an Envoy
+// fractional percent, treating 0 as 100. Like request mirroring, the value is
+// expressed in ten thousandths to avoid losing precision.
+func makeFaultPercentage(percent float32) *envoy_type_v3.FractionalPercent {
+	if percent == 0 {
+		percent = 100
+	}
+	return &envoy_type_v3.FractionalPercent{
+		Numerator:   uint32(math.Round(float64(percent * 100))),
+		Denominator: envoy_type_v3.FractionalPercent_TEN_THOUSAND,
+	}
+}
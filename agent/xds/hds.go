@@ -0,0 +1,484 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_health_v3 "github.com/envoyproxy/go-control-plane/envoy/service/health/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/hashicorp/go-hclog"
+
+	external "github.com/hashicorp/consul/agent/grpc-external"
+	"github.com/hashicorp/consul/agent/grpc-external/limiter"
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/agent/xds/response"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/logging"
+	"github.com/hashicorp/consul/types"
+)
+
+// HDSStream is a shorter way of referring to this thing...
+type HDSStream = envoy_health_v3.HealthDiscoveryService_StreamHealthCheckServer
+
+const (
+	// hdsCheckType is the type of the catalog checks that report the results
+	// of health checks performed by a terminating gateway.
+	hdsCheckType = "terminating-gateway"
+
+	// hdsReportInterval is how often Envoy sends the health of the endpoints
+	// it checks.
+	hdsReportInterval = 5 * time.Second
+
+	// hdsTLSMatch is the transport socket match used by health checks that
+	// connect to a linked service over TLS.
+	hdsTLSMatch = "tls"
+)
+
+// hdsTarget is a service instance that a terminating gateway health checks.
+type hdsTarget struct {
+	check *structs.HealthCheck
+}
+
+// hdsReport is the last status of a check reported to the catalog.
+type hdsReport struct {
+	check *structs.HealthCheck
+	at    time.Time
+}
+
+// StreamHealthCheck implements envoy_health_v3.HealthDiscoveryServiceServer.
+// Terminating gateways use it to actively health check the instances of
+// their linked services and the results are reported to the catalog as
+// checks on those instances.
+func (s *Server) StreamHealthCheck(stream HDSStream) error {
+	// a channel for receiving incoming requests
+	reqCh := make(chan *envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse)
+	reqStop := int32(0)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if atomic.LoadInt32(&reqStop) != 0 {
+				return
+			}
+			if err != nil {
+				s.Logger.Error("Error receiving new HealthCheckRequest; closing request channel", "error", err)
+				close(reqCh)
+				return
+			}
+			select {
+			case <-stream.Context().Done():
+			case reqCh <- req:
+			}
+		}
+	}()
+
+	err := s.processHealthCheck(stream, reqCh)
+	if err != nil {
+		s.Logger.Error("Error handling HDS stream", "error", err)
+	}
+
+	// prevents writing to a closed channel if send failed on blocked recv
+	atomic.StoreInt32(&reqStop, 1)
+
+	return err
+}
+
+// FetchHealthCheck implements envoy_health_v3.HealthDiscoveryServiceServer.
+// Only the streaming endpoint is supported.
+func (s *Server) FetchHealthCheck(context.Context, *envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse) (*envoy_health_v3.HealthCheckSpecifier, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented, use StreamHealthCheck instead")
+}
+
+func (s *Server) processHealthCheck(stream HDSStream, reqCh <-chan *envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse) error {
+	if s.HealthReporter == nil {
+		return status.Error(codes.Unimplemented, "health discovery is not supported by this server")
+	}
+
+	// Handle invalid ACL tokens up-front.
+	if _, err := s.authenticate(stream.Context()); err != nil {
+		return err
+	}
+
+	options, err := external.QueryOptionsFromContext(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.Internal, "error fetching options from context: %v", err)
+	}
+
+	var (
+		snapshot         *proxycfg.ConfigSnapshot
+		stateCh          <-chan *proxycfg.ConfigSnapshot
+		drainCh          limiter.SessionTerminatedChan
+		cfgSrcTerminated proxycfg.SrcTerminatedChan
+		watchCancel      func()
+		gatewayID        string
+
+		// specifier is the last set of health checks sent to Envoy.
+		specifier *envoy_health_v3.HealthCheckSpecifier
+
+		// targets is the service instances that are health checked indexed
+		// by cluster name and endpoint address.
+		targets map[string]map[string][]hdsTarget
+
+		// reported is the last status reported to the catalog for each check.
+		reported = make(map[types.CheckID]hdsReport)
+	)
+
+	logger := s.Logger.Named(logging.XDS).With("xdsVersion", "v3", "api", "hds")
+
+	// Checks of a gateway that is gone would never be updated again, so
+	// remove them once Envoy disconnects.
+	defer func() {
+		for _, report := range reported {
+			if err := s.HealthReporter.RemoveProxyHealthCheck(report.check, options.Token); err != nil {
+				logger.Warn("failed to remove health check", "check", report.check.CheckID, "error", err)
+			}
+		}
+	}()
+
+	var authTimer <-chan time.Time
+	extendAuthTimer := func() {
+		authTimer = time.After(s.AuthCheckFrequency)
+	}
+
+	for {
+		select {
+		case <-drainCh:
+			logger.Debug("draining stream to rebalance load")
+			return errOverwhelmed
+
+		case <-authTimer:
+			if err := s.authorize(stream.Context(), snapshot); err != nil {
+				return err
+			}
+			extendAuthTimer()
+
+		case req, ok := <-reqCh:
+			if !ok {
+				return nil
+			}
+
+			if hcr := req.GetHealthCheckRequest(); hcr != nil {
+				if stateCh != nil {
+					continue
+				}
+				node := hcr.GetNode()
+				if node == nil {
+					return status.Errorf(codes.InvalidArgument, "node is required for health discovery")
+				}
+
+				nodeName := node.GetMetadata().GetFields()["node_name"].GetStringValue()
+				if nodeName == "" {
+					nodeName = s.NodeName
+				}
+
+				proxyID := structs.NewServiceID(node.Id, parseEnterpriseMeta(node))
+				gatewayID = nodeName + "/" + proxyID.ID
+
+				stateCh, drainCh, cfgSrcTerminated, watchCancel, err = s.ProxyWatcher.Watch(proxyID, nodeName, options.Token)
+				switch {
+				case errors.Is(err, limiter.ErrCapacityReached):
+					return errOverwhelmed
+				case err != nil:
+					return status.Errorf(codes.Internal, "failed to watch proxy: %s", err)
+				}
+				defer watchCancel()
+
+				logger = logger.With("service_id", proxyID.String())
+				logger.Trace("watching proxy, pending initial proxycfg snapshot for health discovery")
+				continue
+			}
+
+			if resp := req.GetEndpointHealthResponse(); resp != nil {
+				s.reportEndpointHealth(logger, resp, targets, reported, options.Token)
+			}
+
+		case cs, ok := <-stateCh:
+			if !ok {
+				return status.Error(codes.Aborted, "health discovery stream terminated due to an irrecoverable error, please try again")
+			}
+			if err := s.authorize(stream.Context(), cs); err != nil {
+				return err
+			}
+			if snapshot == nil {
+				extendAuthTimer()
+			}
+			snapshot = cs
+
+			newSpecifier, newTargets, err := makeHealthCheckSpecifier(logger, snapshot, gatewayID)
+			if err != nil {
+				return status.Errorf(codes.Unavailable, "failed to generate health checks from the snapshot: %v", err)
+			}
+			targets = newTargets
+
+			// Checks of instances that are no longer health checked would
+			// otherwise report their last status forever.
+			for id, report := range reported {
+				if hasHDSTarget(targets, id) {
+					continue
+				}
+				if err := s.HealthReporter.RemoveProxyHealthCheck(report.check, options.Token); err != nil {
+					logger.Warn("failed to remove health check", "check", id, "error", err)
+					continue
+				}
+				delete(reported, id)
+			}
+
+			if proto.Equal(specifier, newSpecifier) {
+				continue
+			}
+			if err := stream.Send(newSpecifier); err != nil {
+				return err
+			}
+			specifier = newSpecifier
+
+		case <-cfgSrcTerminated:
+			logger.Debug("config-source sync loop terminated due to error")
+			return errConfigSyncError
+		}
+	}
+}
+
+// reportEndpointHealth reports the health of the endpoints that Envoy checked
+// to the catalog. Checks whose status changed are updated right away.
+//
+// The checks are registered on the nodes of the checked instances. When such a
+// node is managed by a Consul agent, its anti-entropy sync removes the checks
+// because the agent does not know about them, so unchanged checks are reported
+// again every HealthCheckResyncInterval.
+func (s *Server) reportEndpointHealth(
+	logger hclog.Logger,
+	resp *envoy_health_v3.EndpointHealthResponse,
+	targets map[string]map[string][]hdsTarget,
+	reported map[types.CheckID]hdsReport,
+	token string,
+) {
+	for _, cluster := range resp.GetClusterEndpointsHealth() {
+		byAddress := targets[cluster.GetClusterName()]
+		for _, locality := range cluster.GetLocalityEndpointsHealth() {
+			for _, eh := range locality.GetEndpointsHealth() {
+				sa := eh.GetEndpoint().GetAddress().GetSocketAddress()
+				addr := net.JoinHostPort(sa.GetAddress(), strconv.Itoa(int(sa.GetPortValue())))
+
+				checkStatus, ok := hdsCheckStatus(eh.GetHealthStatus())
+				if !ok {
+					continue
+				}
+
+				for _, target := range byAddress[addr] {
+					prev, ok := reported[target.check.CheckID]
+					if ok && prev.check.Status == checkStatus && time.Since(prev.at) < s.HealthCheckResyncInterval {
+						continue
+					}
+
+					check := target.check.Clone()
+					check.Status = checkStatus
+					check.Output = fmt.Sprintf("%s: %s", check.Notes, eh.GetHealthStatus())
+					if err := s.HealthReporter.UpdateProxyHealthCheck(check, token); err != nil {
+						logger.Warn("failed to update health check", "check", check.CheckID, "error", err)
+						continue
+					}
+					reported[check.CheckID] = hdsReport{check: check, at: time.Now()}
+				}
+			}
+		}
+	}
+}
+
+// makeHealthCheckSpecifier returns the health checks that a terminating
+// gateway performs against the instances of its linked services along with
+// the service instances that are checked, indexed by cluster name and
+// endpoint address.
+func makeHealthCheckSpecifier(
+	logger hclog.Logger,
+	cfgSnap *proxycfg.ConfigSnapshot,
+	gatewayID string,
+) (*envoy_health_v3.HealthCheckSpecifier, map[string]map[string][]hdsTarget, error) {
+	specifier := &envoy_health_v3.HealthCheckSpecifier{
+		Interval: durationpb.New(hdsReportInterval),
+	}
+	targets := make(map[string]map[string][]hdsTarget)
+
+	if cfgSnap.Kind != structs.ServiceKindTerminatingGateway {
+		return specifier, targets, nil
+	}
+
+	services := make([]structs.ServiceName, 0, len(cfgSnap.TerminatingGateway.GatewayServices))
+	for svc, mapping := range cfgSnap.TerminatingGateway.GatewayServices {
+		if mapping.HealthCheck != nil {
+			services = append(services, svc)
+		}
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].String() < services[j].String()
+	})
+
+	for _, svc := range services {
+		mapping := cfgSnap.TerminatingGateway.GatewayServices[svc]
+		hc := mapping.HealthCheck.WithDefaults()
+		clusterName := svc.String()
+
+		chc := &envoy_health_v3.ClusterHealthCheck{
+			ClusterName: clusterName,
+		}
+
+		check := &envoy_core_v3.HealthCheck{
+			Interval:           durationpb.New(hc.Interval),
+			Timeout:            durationpb.New(hc.Timeout),
+			UnhealthyThreshold: response.MakeUint32Value(int(hc.UnhealthyThreshold)),
+			HealthyThreshold:   response.MakeUint32Value(int(hc.HealthyThreshold)),
+		}
+		switch hc.Type {
+		case structs.LinkedServiceHealthCheckHTTP:
+			host := mapping.SNI
+			if host == "" {
+				host = svc.Name
+			}
+			check.HealthChecker = &envoy_core_v3.HealthCheck_HttpHealthCheck_{
+				HttpHealthCheck: &envoy_core_v3.HealthCheck_HttpHealthCheck{
+					Path: hc.Path,
+					Host: host,
+				},
+			}
+		default:
+			// An empty TCP health check only verifies that a connection, and
+			// for tls checks the TLS handshake, succeeds.
+			check.HealthChecker = &envoy_core_v3.HealthCheck_TcpHealthCheck_{
+				TcpHealthCheck: &envoy_core_v3.HealthCheck_TcpHealthCheck{},
+			}
+		}
+
+		if hc.Type == structs.LinkedServiceHealthCheckTLS || mapping.CAFile != "" {
+			match, err := makeHDSTransportSocketMatch(mapping)
+			if err != nil {
+				return nil, nil, err
+			}
+			chc.TransportSocketMatches = []*envoy_cluster_v3.Cluster_TransportSocketMatch{match}
+			check.TransportSocketMatchCriteria = match.Match
+		}
+		chc.HealthChecks = []*envoy_core_v3.HealthCheck{check}
+
+		byAddress := make(map[string][]hdsTarget)
+		locality := &envoy_health_v3.LocalityEndpoints{}
+		for _, csn := range cfgSnap.TerminatingGateway.ServiceGroups[svc] {
+			_, host, port := csn.BestAddress(false)
+			if port == 0 || net.ParseIP(host) == nil {
+				logger.Debug("skipping health check of service instance without an IP address",
+					"service", svc.String(), "instance", csn.Service.CompoundServiceID().String())
+				continue
+			}
+
+			addr := net.JoinHostPort(host, strconv.Itoa(port))
+			if _, ok := byAddress[addr]; !ok {
+				locality.Endpoints = append(locality.Endpoints, &envoy_endpoint_v3.Endpoint{
+					Address: response.MakeAddress(host, port),
+				})
+			}
+			byAddress[addr] = append(byAddress[addr], hdsTarget{
+				check: makeHDSCheck(csn, gatewayID, hc.Type, addr),
+			})
+		}
+		if len(locality.Endpoints) == 0 {
+			continue
+		}
+		chc.LocalityEndpoints = []*envoy_health_v3.LocalityEndpoints{locality}
+
+		specifier.ClusterHealthChecks = append(specifier.ClusterHealthChecks, chc)
+		targets[clusterName] = byAddress
+	}
+
+	return specifier, targets, nil
+}
+
+// makeHDSTransportSocketMatch returns the TLS transport socket that health
+// checks use to connect to a linked service. The TLS configuration matches
+// the one used when proxying traffic to the service.
+func makeHDSTransportSocketMatch(mapping structs.GatewayService) (*envoy_cluster_v3.Cluster_TransportSocketMatch, error) {
+	tlsContext := &envoy_tls_v3.UpstreamTlsContext{
+		CommonTlsContext: makeCommonTLSContextFromFiles(mapping.CAFile, mapping.CertFile, mapping.KeyFile),
+	}
+	if mapping.SNI != "" {
+		tlsContext.Sni = mapping.SNI
+		if err := injectSANMatcher(tlsContext.CommonTlsContext, true, mapping.SNI); err != nil {
+			return nil, fmt.Errorf("failed to inject SNI matcher into TLS context: %v", err)
+		}
+	}
+
+	transportSocket, err := makeUpstreamTLSTransportSocket(tlsContext)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := structpb.NewStruct(map[string]interface{}{hdsTLSMatch: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return &envoy_cluster_v3.Cluster_TransportSocketMatch{
+		Name:            hdsTLSMatch,
+		Match:           match,
+		TransportSocket: transportSocket,
+	}, nil
+}
+
+// makeHDSCheck returns the catalog check that reports the health of a
+// service instance as seen by the given gateway.
+func makeHDSCheck(csn structs.CheckServiceNode, gatewayID, checkType, addr string) *structs.HealthCheck {
+	return &structs.HealthCheck{
+		Node:           csn.Node.Node,
+		CheckID:        types.CheckID(fmt.Sprintf("%s:%s:%s", hdsCheckType, gatewayID, csn.Service.ID)),
+		Name:           fmt.Sprintf("Terminating gateway %s health check", checkType),
+		Notes:          fmt.Sprintf("%s health check of %s by terminating gateway %s", checkType, addr, gatewayID),
+		Status:         api.HealthCritical,
+		ServiceID:      csn.Service.ID,
+		ServiceName:    csn.Service.Service,
+		ServiceTags:    csn.Service.Tags,
+		Type:           hdsCheckType,
+		EnterpriseMeta: csn.Service.EnterpriseMeta,
+	}
+}
+
+// hdsCheckStatus converts the health status that Envoy reports into a check
+// status. Endpoints that have not been checked yet are not reported.
+func hdsCheckStatus(s envoy_core_v3.HealthStatus) (string, bool) {
+	switch s {
+	case envoy_core_v3.HealthStatus_HEALTHY:
+		return api.HealthPassing, true
+	case envoy_core_v3.HealthStatus_DEGRADED:
+		return api.HealthWarning, true
+	case envoy_core_v3.HealthStatus_UNHEALTHY, envoy_core_v3.HealthStatus_TIMEOUT:
+		return api.HealthCritical, true
+	default:
+		return "", false
+	}
+}
+
+func hasHDSTarget(targets map[string]map[string][]hdsTarget, id types.CheckID) bool {
+	for _, byAddress := range targets {
+		for _, ts := range byAddress {
+			for _, t := range ts {
+				if t.check.CheckID == id {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
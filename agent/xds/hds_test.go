@@ -0,0 +1,447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_health_v3 "github.com/envoyproxy/go-control-plane/envoy/service/health/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/acl"
+	"github.com/hashicorp/consul/agent/proxycfg"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/agent/xds/response"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/sdk/testutil"
+	"github.com/hashicorp/consul/sdk/testutil/retry"
+	"github.com/hashicorp/consul/types"
+)
+
+func TestMakeHealthCheckSpecifier(t *testing.T) {
+	snap := testHDSSnapshot(t, &structs.LinkedServiceHealthCheck{
+		Type: structs.LinkedServiceHealthCheckHTTP,
+		Path: "/healthz",
+	})
+	// Instances without an IP address can't be checked.
+	snap.TerminatingGateway.ServiceGroups[structs.NewServiceName("web", nil)][1].Service.Address = "web.example.com"
+
+	specifier, targets, err := makeHealthCheckSpecifier(testutil.Logger(t), snap, "node-1/terminating-gateway")
+	require.NoError(t, err)
+
+	require.Len(t, specifier.ClusterHealthChecks, 1)
+	chc := specifier.ClusterHealthChecks[0]
+	require.Equal(t, "web", chc.ClusterName)
+
+	require.Len(t, chc.HealthChecks, 1)
+	httpCheck := chc.HealthChecks[0].GetHttpHealthCheck()
+	require.NotNil(t, httpCheck)
+	require.Equal(t, "/healthz", httpCheck.Path)
+	require.Equal(t, "web", httpCheck.Host)
+	require.Equal(t, 10*time.Second, chc.HealthChecks[0].Interval.AsDuration())
+
+	// The linked service has a CAFile, so the check uses TLS.
+	require.Len(t, chc.TransportSocketMatches, 1)
+	require.Equal(t, chc.TransportSocketMatches[0].Match, chc.HealthChecks[0].TransportSocketMatchCriteria)
+
+	require.Len(t, chc.LocalityEndpoints, 1)
+	require.Equal(t, []*envoy_endpoint_v3.Endpoint{
+		{Address: response.MakeAddress("10.10.1.1", 8080)},
+	}, chc.LocalityEndpoints[0].Endpoints)
+
+	require.Len(t, targets["web"]["10.10.1.1:8080"], 1)
+	check := targets["web"]["10.10.1.1:8080"][0].check
+	require.Equal(t, "test1", check.Node)
+	require.Equal(t, types.CheckID("terminating-gateway:node-1/terminating-gateway:web-1"), check.CheckID)
+	require.Equal(t, "web-1", check.ServiceID)
+	require.Equal(t, "web", check.ServiceName)
+}
+
+func TestMakeHealthCheckSpecifier_TCP(t *testing.T) {
+	snap := testHDSSnapshot(t, &structs.LinkedServiceHealthCheck{
+		Type: structs.LinkedServiceHealthCheckTCP,
+	})
+	// Without a CAFile the check connects without TLS.
+	web := structs.NewServiceName("web", nil)
+	mapping := snap.TerminatingGateway.GatewayServices[web]
+	mapping.CAFile = ""
+	snap.TerminatingGateway.GatewayServices[web] = mapping
+
+	specifier, _, err := makeHealthCheckSpecifier(testutil.Logger(t), snap, "node-1/terminating-gateway")
+	require.NoError(t, err)
+
+	require.Len(t, specifier.ClusterHealthChecks, 1)
+	chc := specifier.ClusterHealthChecks[0]
+	require.NotNil(t, chc.HealthChecks[0].GetTcpHealthCheck())
+	require.Empty(t, chc.TransportSocketMatches)
+	require.Len(t, chc.LocalityEndpoints[0].Endpoints, 2)
+}
+
+func TestServer_StreamHealthCheck(t *testing.T) {
+	mgr := newTestManager(t)
+	reporter := newTestHealthReporter()
+
+	s := NewServer(
+		"node-123",
+		testutil.Logger(t),
+		mgr,
+		func(id string) (acl.Authorizer, error) {
+			return acl.ManageAll(), nil
+		},
+		nil, /*cfgFetcher ConfigFetcher*/
+		reporter,
+	)
+
+	proxyID := structs.NewServiceID("terminating-gateway", nil)
+	mgr.RegisterProxy(t, proxyID)
+
+	stream := newTestHDSStream(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.StreamHealthCheck(stream)
+	}()
+
+	stream.recvCh <- &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse{
+		RequestType: &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse_HealthCheckRequest{
+			HealthCheckRequest: &envoy_health_v3.HealthCheckRequest{
+				Node: &envoy_core_v3.Node{Id: proxyID.ID},
+			},
+		},
+	}
+
+	snap := testHDSSnapshot(t, &structs.LinkedServiceHealthCheck{
+		Type: structs.LinkedServiceHealthCheckTLS,
+	})
+	mgr.DeliverConfig(t, proxyID, snap)
+
+	specifier := stream.expectSend(t)
+	require.Len(t, specifier.ClusterHealthChecks, 1)
+	require.Len(t, specifier.ClusterHealthChecks[0].LocalityEndpoints[0].Endpoints, 2)
+
+	sendHealth := func(statuses map[string]envoy_core_v3.HealthStatus) {
+		var endpoints []*envoy_health_v3.EndpointHealth
+		for ip, status := range statuses {
+			endpoints = append(endpoints, &envoy_health_v3.EndpointHealth{
+				Endpoint:     &envoy_endpoint_v3.Endpoint{Address: response.MakeAddress(ip, 8080)},
+				HealthStatus: status,
+			})
+		}
+		stream.recvCh <- &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse{
+			RequestType: &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse_EndpointHealthResponse{
+				EndpointHealthResponse: &envoy_health_v3.EndpointHealthResponse{
+					ClusterEndpointsHealth: []*envoy_health_v3.ClusterEndpointsHealth{
+						{
+							ClusterName: "web",
+							LocalityEndpointsHealth: []*envoy_health_v3.LocalityEndpointsHealth{
+								{EndpointsHealth: endpoints},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	checkID1 := types.CheckID("terminating-gateway:node-123/terminating-gateway:web-1")
+	checkID2 := types.CheckID("terminating-gateway:node-123/terminating-gateway:web-2")
+
+	sendHealth(map[string]envoy_core_v3.HealthStatus{
+		"10.10.1.1": envoy_core_v3.HealthStatus_HEALTHY,
+		"10.10.1.2": envoy_core_v3.HealthStatus_UNKNOWN,
+	})
+	retry.Run(t, func(r *retry.R) {
+		require.Equal(r, map[types.CheckID]string{checkID1: api.HealthPassing}, reporter.statuses())
+	})
+
+	sendHealth(map[string]envoy_core_v3.HealthStatus{
+		"10.10.1.1": envoy_core_v3.HealthStatus_HEALTHY,
+		"10.10.1.2": envoy_core_v3.HealthStatus_UNHEALTHY,
+	})
+	retry.Run(t, func(r *retry.R) {
+		require.Equal(r, map[types.CheckID]string{
+			checkID1: api.HealthPassing,
+			checkID2: api.HealthCritical,
+		}, reporter.statuses())
+	})
+	// Unchanged statuses are only reported once.
+	require.Equal(t, 2, reporter.updateCount())
+
+	// Removing the health check from the linked service removes its checks.
+	mgr.DeliverConfig(t, proxyID, testHDSSnapshot(t, nil))
+
+	specifier = stream.expectSend(t)
+	require.Empty(t, specifier.ClusterHealthChecks)
+	retry.Run(t, func(r *retry.R) {
+		require.Empty(r, reporter.statuses())
+	})
+
+	close(stream.recvCh)
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the stream to end")
+	}
+}
+
+func TestServer_StreamHealthCheck_RemovesChecksOnClose(t *testing.T) {
+	mgr := newTestManager(t)
+	reporter := newTestHealthReporter()
+
+	s := NewServer(
+		"node-123",
+		testutil.Logger(t),
+		mgr,
+		func(id string) (acl.Authorizer, error) {
+			return acl.ManageAll(), nil
+		},
+		nil, /*cfgFetcher ConfigFetcher*/
+		reporter,
+	)
+
+	proxyID := structs.NewServiceID("terminating-gateway", nil)
+	mgr.RegisterProxy(t, proxyID)
+
+	stream := newTestHDSStream(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.StreamHealthCheck(stream)
+	}()
+
+	stream.recvCh <- &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse{
+		RequestType: &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse_HealthCheckRequest{
+			HealthCheckRequest: &envoy_health_v3.HealthCheckRequest{
+				Node: &envoy_core_v3.Node{Id: proxyID.ID},
+			},
+		},
+	}
+	mgr.DeliverConfig(t, proxyID, testHDSSnapshot(t, &structs.LinkedServiceHealthCheck{
+		Type: structs.LinkedServiceHealthCheckTCP,
+	}))
+	stream.expectSend(t)
+
+	stream.recvCh <- &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse{
+		RequestType: &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse_EndpointHealthResponse{
+			EndpointHealthResponse: &envoy_health_v3.EndpointHealthResponse{
+				ClusterEndpointsHealth: []*envoy_health_v3.ClusterEndpointsHealth{
+					{
+						ClusterName: "web",
+						LocalityEndpointsHealth: []*envoy_health_v3.LocalityEndpointsHealth{
+							{
+								EndpointsHealth: []*envoy_health_v3.EndpointHealth{
+									{
+										Endpoint:     &envoy_endpoint_v3.Endpoint{Address: response.MakeAddress("10.10.1.1", 8080)},
+										HealthStatus: envoy_core_v3.HealthStatus_TIMEOUT,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	retry.Run(t, func(r *retry.R) {
+		require.Len(r, reporter.statuses(), 1)
+	})
+
+	close(stream.recvCh)
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the stream to end")
+	}
+	require.Empty(t, reporter.statuses())
+}
+
+func TestServer_StreamHealthCheck_ResyncsUnchangedChecks(t *testing.T) {
+	mgr := newTestManager(t)
+	reporter := newTestHealthReporter()
+
+	s := NewServer(
+		"node-123",
+		testutil.Logger(t),
+		mgr,
+		func(id string) (acl.Authorizer, error) {
+			return acl.ManageAll(), nil
+		},
+		nil, /*cfgFetcher ConfigFetcher*/
+		reporter,
+	)
+	// Report unchanged statuses every time so that checks removed from the
+	// catalog by the anti-entropy sync of another agent are restored.
+	s.HealthCheckResyncInterval = 0
+
+	proxyID := structs.NewServiceID("terminating-gateway", nil)
+	mgr.RegisterProxy(t, proxyID)
+
+	stream := newTestHDSStream(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.StreamHealthCheck(stream)
+	}()
+
+	stream.recvCh <- &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse{
+		RequestType: &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse_HealthCheckRequest{
+			HealthCheckRequest: &envoy_health_v3.HealthCheckRequest{
+				Node: &envoy_core_v3.Node{Id: proxyID.ID},
+			},
+		},
+	}
+	mgr.DeliverConfig(t, proxyID, testHDSSnapshot(t, &structs.LinkedServiceHealthCheck{
+		Type: structs.LinkedServiceHealthCheckTCP,
+	}))
+	stream.expectSend(t)
+
+	sendHealth := func() {
+		stream.recvCh <- &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse{
+			RequestType: &envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse_EndpointHealthResponse{
+				EndpointHealthResponse: &envoy_health_v3.EndpointHealthResponse{
+					ClusterEndpointsHealth: []*envoy_health_v3.ClusterEndpointsHealth{
+						{
+							ClusterName: "web",
+							LocalityEndpointsHealth: []*envoy_health_v3.LocalityEndpointsHealth{
+								{
+									EndpointsHealth: []*envoy_health_v3.EndpointHealth{
+										{
+											Endpoint:     &envoy_endpoint_v3.Endpoint{Address: response.MakeAddress("10.10.1.1", 8080)},
+											HealthStatus: envoy_core_v3.HealthStatus_HEALTHY,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	sendHealth()
+	retry.Run(t, func(r *retry.R) {
+		require.Equal(r, 1, reporter.updateCount())
+	})
+
+	sendHealth()
+	retry.Run(t, func(r *retry.R) {
+		require.Equal(r, 2, reporter.updateCount())
+	})
+
+	close(stream.recvCh)
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the stream to end")
+	}
+}
+
+// testHDSSnapshot returns a terminating gateway snapshot where the web
+// service is health checked with the given check.
+func testHDSSnapshot(t *testing.T, hc *structs.LinkedServiceHealthCheck) *proxycfg.ConfigSnapshot {
+	snap := proxycfg.TestConfigSnapshotTerminatingGateway(t, true, nil, nil)
+
+	web := structs.NewServiceName("web", nil)
+	mapping := snap.TerminatingGateway.GatewayServices[web]
+	mapping.HealthCheck = hc
+	snap.TerminatingGateway.GatewayServices[web] = mapping
+
+	nodes := proxycfg.TestUpstreamNodes(t, web.Name)
+	nodes[0].Service.ID = "web-1"
+	nodes[1].Service.ID = "web-2"
+	snap.TerminatingGateway.ServiceGroups[web] = nodes
+
+	return snap
+}
+
+type testHealthReporter struct {
+	mu      sync.Mutex
+	checks  map[types.CheckID]*structs.HealthCheck
+	updates int
+}
+
+func newTestHealthReporter() *testHealthReporter {
+	return &testHealthReporter{checks: make(map[types.CheckID]*structs.HealthCheck)}
+}
+
+func (r *testHealthReporter) UpdateProxyHealthCheck(check *structs.HealthCheck, _ string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[check.CheckID] = check
+	r.updates++
+	return nil
+}
+
+func (r *testHealthReporter) RemoveProxyHealthCheck(check *structs.HealthCheck, _ string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.checks, check.CheckID)
+	return nil
+}
+
+func (r *testHealthReporter) statuses() map[types.CheckID]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make(map[types.CheckID]string, len(r.checks))
+	for id, check := range r.checks {
+		out[id] = check.Status
+	}
+	return out
+}
+
+func (r *testHealthReporter) updateCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.updates
+}
+
+// testHDSStream mocks envoy_health_v3.HealthDiscoveryService_StreamHealthCheckServer
+// to allow testing the HDS handler.
+type testHDSStream struct {
+	stubGrpcServerStream
+	sendCh chan *envoy_health_v3.HealthCheckSpecifier
+	recvCh chan *envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse
+}
+
+var _ HDSStream = (*testHDSStream)(nil)
+
+func newTestHDSStream(ctx context.Context) *testHDSStream {
+	s := &testHDSStream{
+		sendCh: make(chan *envoy_health_v3.HealthCheckSpecifier, 1),
+		recvCh: make(chan *envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse),
+	}
+	s.stubGrpcServerStream.ctx = ctx
+	return s
+}
+
+func (s *testHDSStream) Send(r *envoy_health_v3.HealthCheckSpecifier) error {
+	s.sendCh <- r
+	return nil
+}
+
+func (s *testHDSStream) Recv() (*envoy_health_v3.HealthCheckRequestOrEndpointHealthResponse, error) {
+	r, ok := <-s.recvCh
+	if !ok {
+		return nil, io.EOF
+	}
+	return r, nil
+}
+
+func (s *testHDSStream) expectSend(t *testing.T) *envoy_health_v3.HealthCheckSpecifier {
+	t.Helper()
+	select {
+	case r := <-s.sendCh:
+		return r
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a health check specifier")
+		return nil
+	}
+}
@@ -6,11 +6,13 @@ package xds
 import (
 	"encoding/base64"
 	"fmt"
+	"sort"
 
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_http_jwt_authn_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
 	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/hashicorp/consul/agent/structs"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -75,6 +77,102 @@ func makeJWTAuthFilter(providerMap map[string]*structs.JWTProviderConfigEntry, i
 	return makeEnvoyHTTPFilter(jwtEnvoyFilter, cfg)
 }
 
+// makeJWTRoutingFilter builds a jwt filter for the outbound listener of a discovery chain whose
+// routes match on JWT claims. The filter only verifies tokens so that their payload is available
+// as dynamic metadata for route matching: requests without a valid token are never rejected and
+// the token is always forwarded so the upstream can still authorize it with intentions.
+func makeJWTRoutingFilter(providerMap map[string]*structs.JWTProviderConfigEntry, chain *structs.CompiledDiscoveryChain) (*envoy_http_v3.HttpFilter, error) {
+	providerNames := chainJWTProviders(chain)
+	if len(providerNames) == 0 {
+		return nil, nil
+	}
+
+	providers := make(map[string]*envoy_http_jwt_authn_v3.JwtProvider, len(providerNames))
+	jwtRequirements := make([]*envoy_http_jwt_authn_v3.JwtRequirement, 0, len(providerNames))
+	for _, providerName := range providerNames {
+		providerCE, ok := providerMap[providerName]
+		if !ok {
+			return nil, fmt.Errorf("provider specified in service-router does not exist. Provider name: %s", providerName)
+		}
+
+		envoyCfg, err := buildJWTProviderConfig(providerCE)
+		if err != nil {
+			return nil, err
+		}
+		envoyCfg.Forward = true
+		envoyCfg.ClearRouteCache = true
+		providers[providerName] = envoyCfg
+		jwtRequirements = append(jwtRequirements, providerToJWTRequirement(providerCE))
+	}
+
+	cfg := &envoy_http_jwt_authn_v3.JwtAuthentication{
+		Providers: providers,
+		Rules: []*envoy_http_jwt_authn_v3.RequirementRule{
+			{
+				Match: &envoy_route_v3.RouteMatch{
+					PathSpecifier: &envoy_route_v3.RouteMatch_Prefix{Prefix: "/"},
+				},
+				RequirementType: makeJWTRequirementRule(andJWTRequirements(jwtRequirements)),
+			},
+		},
+	}
+	return makeEnvoyHTTPFilter(jwtEnvoyFilter, cfg)
+}
+
+// chainJWTProviders returns the sorted names of the providers referenced by the JWT matches of
+// the routes in the compiled discovery chain.
+func chainJWTProviders(chain *structs.CompiledDiscoveryChain) []string {
+	if chain == nil {
+		return nil
+	}
+	seen := make(map[string]struct{})
+	var names []string
+	for _, node := range chain.Nodes {
+		for _, route := range node.Routes {
+			if !routeMatchesJWT(route) {
+				continue
+			}
+			name := route.Definition.Match.HTTP.JWT.Provider
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// routeMatchesJWT returns true if the route of a discovery chain matches on JWT claims.
+func routeMatchesJWT(route *structs.DiscoveryRoute) bool {
+	return route.Definition != nil && route.Definition.Match != nil &&
+		route.Definition.Match.HTTP != nil && route.Definition.Match.HTTP.JWT != nil
+}
+
+// makeJWTClaimMetadataMatchers converts the claims of a route's JWT match into dynamic metadata
+// matchers on the payload that the jwt filter stores for the provider.
+func makeJWTClaimMetadataMatchers(jwt *structs.ServiceRouteHTTPMatchJWT) []*envoy_matcher_v3.MetadataMatcher {
+	payloadKey := buildPayloadInMetadataKey(jwt.Provider)
+	matchers := make([]*envoy_matcher_v3.MetadataMatcher, 0, len(jwt.Claims))
+	for _, claim := range jwt.Claims {
+		matchers = append(matchers, &envoy_matcher_v3.MetadataMatcher{
+			Filter: jwtEnvoyFilter,
+			Path:   pathToSegments(claim.Path, payloadKey),
+			Value: &envoy_matcher_v3.ValueMatcher{
+				MatchPattern: &envoy_matcher_v3.ValueMatcher_StringMatch{
+					StringMatch: &envoy_matcher_v3.StringMatcher{
+						MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{
+							Exact: claim.Value,
+						},
+					},
+				},
+			},
+		})
+	}
+	return matchers
+}
+
 func makeJWTRequirementRule(r *envoy_http_jwt_authn_v3.JwtRequirement) *envoy_http_jwt_authn_v3.RequirementRule_Requires {
 	return &envoy_http_jwt_authn_v3.RequirementRule_Requires{
 		Requires: r,
@@ -253,6 +253,83 @@ func TestCollectJWTProviders(t *testing.T) {
 	}
 }
 
+func TestChainJWTProviders(t *testing.T) {
+	jwtRoute := func(provider string) *structs.DiscoveryRoute {
+		return &structs.DiscoveryRoute{
+			Definition: &structs.ServiceRoute{
+				Match: &structs.ServiceRouteMatch{
+					HTTP: &structs.ServiceRouteHTTPMatch{
+						JWT: &structs.ServiceRouteHTTPMatchJWT{Provider: provider},
+					},
+				},
+			},
+		}
+	}
+	pathRoute := structs.DiscoveryRoute{
+		Definition: &structs.ServiceRoute{
+			Match: &structs.ServiceRouteMatch{
+				HTTP: &structs.ServiceRouteHTTPMatch{PathPrefix: "/"},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		chain    *structs.CompiledDiscoveryChain
+		expected []string
+	}{
+		"nil-chain": {},
+		"no-jwt-routes": {
+			chain: &structs.CompiledDiscoveryChain{
+				Nodes: map[string]*structs.DiscoveryGraphNode{
+					"router:db": {Routes: []*structs.DiscoveryRoute{&pathRoute}},
+				},
+			},
+		},
+		"deduplicated-and-sorted": {
+			chain: &structs.CompiledDiscoveryChain{
+				Nodes: map[string]*structs.DiscoveryGraphNode{
+					"router:db": {Routes: []*structs.DiscoveryRoute{
+						jwtRoute("okta"),
+						&pathRoute,
+						jwtRoute("auth0"),
+						jwtRoute("okta"),
+					}},
+				},
+			},
+			expected: []string{"auth0", "okta"},
+		},
+	}
+
+	for name, tt := range tests {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.expected, chainJWTProviders(tt.chain))
+		})
+	}
+}
+
+func TestMakeJWTRoutingFilter_MissingProvider(t *testing.T) {
+	chain := &structs.CompiledDiscoveryChain{
+		Nodes: map[string]*structs.DiscoveryGraphNode{
+			"router:db": {Routes: []*structs.DiscoveryRoute{
+				{
+					Definition: &structs.ServiceRoute{
+						Match: &structs.ServiceRouteMatch{
+							HTTP: &structs.ServiceRouteHTTPMatch{
+								JWT: &structs.ServiceRouteHTTPMatchJWT{Provider: "okta"},
+							},
+						},
+					},
+				},
+			}},
+		},
+	}
+
+	_, err := makeJWTRoutingFilter(map[string]*structs.JWTProviderConfigEntry{}, chain)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "provider specified in service-router does not exist. Provider name: okta")
+}
+
 func TestGetPermissionsProviders(t *testing.T) {
 	tests := map[string]struct {
 		perms    []*structs.IntentionPermission
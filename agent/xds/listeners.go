@@ -173,17 +173,28 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 
 		filterName := fmt.Sprintf("%s.%s.%s.%s", chain.ServiceName, chain.Namespace, chain.Partition, chain.Datacenter)
 
+		// Routes that match on JWT claims need the tokens verified before routing.
+		var jwtRoutingFilter *envoy_http_v3.HttpFilter
+		if useRDS {
+			jwtRoutingFilter, err = makeJWTRoutingFilter(cfgSnap.JWTProviders, chain)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		// Generate the upstream listeners for when they are explicitly set with a local bind port or socket path
 		if upstreamCfg != nil && upstreamCfg.HasLocalPortOrSocket() {
 			filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-				accessLogs:      &cfgSnap.Proxy.AccessLogs,
-				routeName:       uid.EnvoyID(),
-				clusterName:     clusterName,
-				filterName:      filterName,
-				protocol:        cfg.Protocol,
-				useRDS:          useRDS,
-				fetchTimeoutRDS: cfgSnap.GetXDSCommonConfig(s.Logger).GetXDSFetchTimeout(),
-				tracing:         tracing,
+				accessLogs:       &cfgSnap.Proxy.AccessLogs,
+				routeName:        uid.EnvoyID(),
+				clusterName:      clusterName,
+				filterName:       filterName,
+				protocol:         cfg.Protocol,
+				useRDS:           useRDS,
+				fetchTimeoutRDS:  cfgSnap.GetXDSCommonConfig(s.Logger).GetXDSFetchTimeout(),
+				tracing:          tracing,
+				routeFilters:     chainRouteFilters(chain, useRDS),
+				jwtRoutingFilter: jwtRoutingFilter,
 			})
 			if err != nil {
 				return nil, err
@@ -212,14 +223,16 @@ func (s *ResourceGenerator) listenersFromSnapshotConnectProxy(cfgSnap *proxycfg.
 		// as we do for explicit upstreams above.
 
 		filterChain, err := s.makeUpstreamFilterChain(filterChainOpts{
-			accessLogs:      &cfgSnap.Proxy.AccessLogs,
-			routeName:       uid.EnvoyID(),
-			clusterName:     clusterName,
-			filterName:      filterName,
-			protocol:        cfg.Protocol,
-			useRDS:          useRDS,
-			fetchTimeoutRDS: cfgSnap.GetXDSCommonConfig(s.Logger).GetXDSFetchTimeout(),
-			tracing:         tracing,
+			accessLogs:       &cfgSnap.Proxy.AccessLogs,
+			routeName:        uid.EnvoyID(),
+			clusterName:      clusterName,
+			filterName:       filterName,
+			protocol:         cfg.Protocol,
+			useRDS:           useRDS,
+			fetchTimeoutRDS:  cfgSnap.GetXDSCommonConfig(s.Logger).GetXDSFetchTimeout(),
+			tracing:          tracing,
+			routeFilters:     chainRouteFilters(chain, useRDS),
+			jwtRoutingFilter: jwtRoutingFilter,
 		})
 		if err != nil {
 			return nil, err
@@ -1356,6 +1369,8 @@ func (s *ResourceGenerator) makeInboundListener(cfgSnap *proxycfg.ConfigSnapshot
 		tracing:          tracing,
 		accessLogs:       &cfgSnap.Proxy.AccessLogs,
 		logger:           s.Logger,
+		faultInjection:   cfg.FaultInjection,
+		rateLimit:        cfg.RateLimit,
 	}
 	if useHTTPFilter {
 		jwtFilter, err := makeJWTAuthFilter(cfgSnap.JWTProviders, cfgSnap.ConnectProxy.Intentions)
@@ -2204,6 +2219,8 @@ type filterChainOpts struct {
 	forwardClientDetails bool
 	forwardClientPolicy  envoy_http_v3.HttpConnectionManager_ForwardClientCertDetails
 	tracing              *envoy_http_v3.HttpConnectionManager_Tracing
+	routeFilters         routeFilters
+	jwtRoutingFilter     *envoy_http_v3.HttpFilter
 }
 
 func (s *ResourceGenerator) makeUpstreamFilterChain(opts filterChainOpts) (*envoy_listener_v3.FilterChain, error) {
@@ -2222,6 +2239,8 @@ func (s *ResourceGenerator) makeUpstreamFilterChain(opts filterChainOpts) (*envo
 		forwardClientPolicy:  opts.forwardClientPolicy,
 		tracing:              opts.tracing,
 		accessLogs:           opts.accessLogs,
+		routeFilters:         opts.routeFilters,
+		jwtRoutingFilter:     opts.jwtRoutingFilter,
 		logger:               s.Logger,
 	})
 	if err != nil {
@@ -2375,6 +2394,88 @@ type listenerFilterOpts struct {
 	tracing              *envoy_http_v3.HttpConnectionManager_Tracing
 	useRDS               bool
 	fetchTimeoutRDS      *durationpb.Duration
+	faultInjection       *structs.FaultInjection
+	routeFilters         routeFilters
+	rateLimit            *structs.LocalRateLimit
+	maxRequestBytes      uint32
+	maxRequestHeadersKB  uint32
+	jwtRoutingFilter     *envoy_http_v3.HttpFilter
+}
+
+// routeFilter is an HTTP filter that is only added to an HTTP connection
+// manager when one of the routes it serves configures the filter through
+// typed_per_filter_config.
+type routeFilter int
+
+const (
+	faultRouteFilter routeFilter = iota
+	localRateLimitRouteFilter
+	corsRouteFilter
+	bufferRouteFilter
+)
+
+// routeFilters lists the route filters an HTTP connection manager needs.
+type routeFilters []routeFilter
+
+func (fs routeFilters) has(f routeFilter) bool {
+	for _, existing := range fs {
+		if existing == f {
+			return true
+		}
+	}
+	return false
+}
+
+// add returns fs with the filters it doesn't already list appended.
+func (fs routeFilters) add(filters ...routeFilter) routeFilters {
+	for _, f := range filters {
+		if !fs.has(f) {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// chainRouteFilters returns the route filters needed by the routes of the
+// compiled discovery chain. Routes are only configured through RDS, so none
+// are needed otherwise.
+func chainRouteFilters(chain *structs.CompiledDiscoveryChain, useRDS bool) routeFilters {
+	var filters routeFilters
+	if !useRDS {
+		return filters
+	}
+	if chainRoutesAny(chain, func(route *structs.ServiceRoute) bool {
+		return route.Destination.FaultInjection != nil
+	}) {
+		filters = filters.add(faultRouteFilter)
+	}
+	if chainRoutesAny(chain, func(route *structs.ServiceRoute) bool {
+		return route.Destination.RateLimit != nil
+	}) {
+		filters = filters.add(localRateLimitRouteFilter)
+	}
+	if chainRoutesAny(chain, func(route *structs.ServiceRoute) bool {
+		return route.Destination.CORS != nil
+	}) {
+		filters = filters.add(corsRouteFilter)
+	}
+	return filters
+}
+
+// chainRoutesAny returns true if match returns true for any route in the
+// compiled discovery chain that has a destination.
+func chainRoutesAny(chain *structs.CompiledDiscoveryChain, match func(*structs.ServiceRoute) bool) bool {
+	if chain == nil {
+		return false
+	}
+	for _, node := range chain.Nodes {
+		for _, route := range node.Routes {
+			if route.Definition != nil && route.Definition.Destination != nil && match(route.Definition) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func makeListenerFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error) {
@@ -2556,6 +2657,7 @@ func makeHTTPFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error)
 						Routes: []*envoy_route_v3.Route{
 							route,
 						},
+						RateLimits: makeLocalRateLimitActions(opts.rateLimit),
 					},
 				},
 			},
@@ -2566,6 +2668,10 @@ func makeHTTPFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error)
 		cfg.Http2ProtocolOptions = &envoy_core_v3.Http2ProtocolOptions{}
 	}
 
+	if opts.maxRequestHeadersKB != 0 {
+		cfg.MaxRequestHeadersKb = wrapperspb.UInt32(opts.maxRequestHeadersKB)
+	}
+
 	// Note the default leads to setting HttpConnectionManager_SANITIZE
 	if opts.forwardClientDetails {
 		cfg.ForwardClientCertDetails = opts.forwardClientPolicy
@@ -2578,6 +2684,53 @@ func makeHTTPFilter(opts listenerFilterOpts) (*envoy_listener_v3.Filter, error)
 		}
 	}
 
+	// Faults are injected after authorization so that denied requests are
+	// never delayed or aborted with a misleading status.
+	if opts.routeFilters.has(faultRouteFilter) || opts.faultInjection != nil {
+		faultFilter, err := makeFaultInjectionFilter(opts.faultInjection)
+		if err != nil {
+			return nil, err
+		}
+		cfg.HttpFilters = append([]*envoy_http_v3.HttpFilter{faultFilter}, cfg.HttpFilters...)
+	}
+
+	// Request bodies are buffered after rate limiting so that rejected
+	// requests are never buffered.
+	if opts.routeFilters.has(bufferRouteFilter) || opts.maxRequestBytes != 0 {
+		bufferFilter, err := makeBufferFilter(opts.maxRequestBytes)
+		if err != nil {
+			return nil, err
+		}
+		cfg.HttpFilters = append([]*envoy_http_v3.HttpFilter{bufferFilter}, cfg.HttpFilters...)
+	}
+
+	// Rate limits are enforced ahead of fault injection so that delayed
+	// requests don't hold on to tokens.
+	if opts.routeFilters.has(localRateLimitRouteFilter) || opts.rateLimit != nil {
+		rateLimitFilter, err := makeLocalRateLimitFilter(opts.rateLimit, makeStatPrefix(opts.statPrefix, opts.filterName))
+		if err != nil {
+			return nil, err
+		}
+		cfg.HttpFilters = append([]*envoy_http_v3.HttpFilter{rateLimitFilter}, cfg.HttpFilters...)
+	}
+
+	// CORS preflight requests are answered ahead of rate limiting so that
+	// browsers don't spend tokens on them.
+	if opts.routeFilters.has(corsRouteFilter) {
+		corsFilter, err := makeCORSFilter()
+		if err != nil {
+			return nil, err
+		}
+		cfg.HttpFilters = append([]*envoy_http_v3.HttpFilter{corsFilter}, cfg.HttpFilters...)
+	}
+
+	// Tokens are verified ahead of the filters above, which can resolve the
+	// route of a request before its claims are known. Verifying a token also
+	// clears the route cache so that the request is routed on its claims.
+	if opts.jwtRoutingFilter != nil {
+		cfg.HttpFilters = append([]*envoy_http_v3.HttpFilter{opts.jwtRoutingFilter}, cfg.HttpFilters...)
+	}
+
 	// Like injectConnectFilters for L4, here we ensure that the first filter
 	// (other than the "envoy.grpc_http1_bridge" filter) in the http filter
 	// chain of a public listener is the authz filter to prevent unauthorized
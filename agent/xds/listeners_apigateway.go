@@ -54,7 +54,15 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 			return nil, err
 		}
 
-		if listenerCfg.Protocol == structs.ListenerProtocolTCP {
+		if listenerCfg.TLS.Mode == structs.APIGatewayTLSModePassthrough {
+			l, err := s.makeAPIGatewayPassthroughListener(address, cfgSnap, readyListener)
+			if err != nil {
+				return nil, err
+			}
+			if l != nil {
+				resources = append(resources, l)
+			}
+		} else if listenerCfg.Protocol == structs.ListenerProtocolTCP {
 			// Find the upstream matching this listener
 
 			// We rely on the invariant of upstreams slice always having at least 1
@@ -155,21 +163,21 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 			}
 			listener := makeListener(listenerOpts)
 
-			routes := make([]*structs.HTTPRouteConfigEntry, 0, len(readyListener.routeReferences))
-			for _, routeRef := range maps.Keys(readyListener.routeReferences) {
-				route, ok := cfgSnap.APIGateway.HTTPRoutes.Get(routeRef)
-				if !ok {
-					return nil, fmt.Errorf("missing route for routeRef %s:%s", routeRef.Kind, routeRef.Name)
-				}
-
-				routes = append(routes, route)
+			routes, err := httpRoutesForListener(cfgSnap, readyListener)
+			if err != nil {
+				return nil, err
 			}
 			consolidatedRoutes := discoverychain.ConsolidateHTTPRoutes(cfgSnap.APIGateway.GatewayConfig, &readyListener.listenerCfg, routes...)
 			routesWithJWT := []*structs.HTTPRouteConfigEntry{}
+			var filters routeFilters
 			for _, routeCfgEntry := range consolidatedRoutes {
 				routeCfgEntry := routeCfgEntry
 				route := &routeCfgEntry
 
+				if httpRouteUsesCORS(route) {
+					filters = filters.add(corsRouteFilter)
+				}
+
 				if listenerCfg.Override != nil && listenerCfg.Override.JWT != nil {
 					routesWithJWT = append(routesWithJWT, route)
 					continue
@@ -220,6 +228,7 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 				routePath:        "",
 				httpAuthzFilters: authFilters,
 				accessLogs:       &cfgSnap.Proxy.AccessLogs,
+				routeFilters:     filters,
 				logger:           s.Logger,
 			}
 
@@ -246,8 +255,10 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 			listener.FilterChains = sniFilterChains
 
 			// See if there are other services that didn't have specific SNI-matching
-			// filter chains. If so add a default filterchain to serve them.
-			if len(sniFilterChains) < len(readyListener.upstreams) && !isAPIGatewayWithTLS {
+			// filter chains. If so add a default filterchain to serve them. Listeners
+			// whose routes only redirect or respond directly have no upstreams, but
+			// still need the default filterchain.
+			if (len(sniFilterChains) < len(readyListener.upstreams) || len(readyListener.upstreams) == 0) && !isAPIGatewayWithTLS {
 				defaultFilter, err := makeListenerFilter(filterOpts)
 				if err != nil {
 					return nil, err
@@ -272,6 +283,110 @@ func (s *ResourceGenerator) makeAPIGatewayListeners(address string, cfgSnap *pro
 	return resources, nil
 }
 
+// makeAPIGatewayPassthroughListener builds a listener that forwards TLS
+// connections, without terminating them, to the upstream of the TLSRoute
+// whose hostnames match the SNI of the connection.
+func (s *ResourceGenerator) makeAPIGatewayPassthroughListener(address string, cfgSnap *proxycfg.ConfigSnapshot, readyListener readyListener) (*envoy_listener_v3.Listener, error) {
+	listenerKey := readyListener.listenerKey
+	listenerCfg := readyListener.listenerCfg
+
+	// Envoy rejects listeners with duplicate filter chain matches, so when
+	// multiple routes claim the same hostname the first bound route wins.
+	seenHostnames := make(map[string]struct{})
+
+	var filterChains []*envoy_listener_v3.FilterChain
+	for _, routeRef := range readyListener.boundListenerCfg.Routes {
+		if _, ok := readyListener.routeReferences[routeRef]; !ok || routeRef.Kind != structs.TLSRoute {
+			continue
+		}
+		route, ok := cfgSnap.APIGateway.TLSRoutes.Get(routeRef)
+		if !ok {
+			continue
+		}
+		upstreams := cfgSnap.APIGateway.Upstreams[routeRef][listenerKey]
+		if len(upstreams) == 0 {
+			continue
+		}
+
+		// TLSRoutes currently route to a single service
+		u := upstreams[0]
+		uid := proxycfg.NewUpstreamID(&u)
+		chain := cfgSnap.APIGateway.DiscoveryChain[uid]
+		if chain == nil {
+			// Wait until a chain is present in the snapshot.
+			continue
+		}
+
+		// Passthrough connections are forwarded without inspecting their
+		// requests, so they can only be routed to a chain with a single
+		// target. Skip the route rather than failing every listener of the
+		// gateway.
+		target, err := simpleChainTarget(chain)
+		if err != nil {
+			s.Logger.Warn("skipping TLS route whose service has a discovery chain with routes or splits",
+				"route", routeRef.Name, "service", chain.ServiceName, "error", err)
+			continue
+		}
+		clusterName := naming.CustomizeClusterName(target.Name, chain)
+
+		var serverNames []string
+		for _, hostname := range route.FilteredHostnames(listenerCfg.GetHostname()) {
+			if _, ok := seenHostnames[hostname]; ok {
+				continue
+			}
+			seenHostnames[hostname] = struct{}{}
+			serverNames = append(serverNames, hostname)
+		}
+		if len(serverNames) == 0 {
+			continue
+		}
+		if len(serverNames) == 1 && serverNames[0] == "*" {
+			// a route without any hostnames on a listener without a hostname
+			// accepts every connection, so don't match on SNI at all
+			serverNames = nil
+		}
+
+		filter, err := makeTCPProxyFilter(listenerFilterOpts{
+			filterName: fmt.Sprintf("%s.%s.%s.%s", chain.ServiceName, chain.Namespace, chain.Partition, chain.Datacenter),
+			cluster:    clusterName,
+			statPrefix: "ingress_upstream_",
+			accessLogs: &cfgSnap.Proxy.AccessLogs,
+			logger:     s.Logger,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		filterChains = append(filterChains, &envoy_listener_v3.FilterChain{
+			FilterChainMatch: makeSNIFilterChainMatch(serverNames...),
+			Filters:          []*envoy_listener_v3.Filter{filter},
+		})
+	}
+
+	if len(filterChains) == 0 {
+		return nil, nil
+	}
+
+	l := makeListener(makeListenerOpts{
+		name:       listenerKey.Protocol,
+		accessLogs: cfgSnap.Proxy.AccessLogs,
+		addr:       address,
+		port:       listenerKey.Port,
+		direction:  envoy_core_v3.TrafficDirection_OUTBOUND,
+		logger:     s.Logger,
+	})
+	l.FilterChains = filterChains
+
+	// add the tls inspector to do SNI introspection
+	tlsInspector, err := makeTLSInspectorListenerFilter()
+	if err != nil {
+		return nil, err
+	}
+	l.ListenerFilters = []*envoy_listener_v3.ListenerFilter{tlsInspector}
+
+	return l, nil
+}
+
 // helper struct to persist upstream parent information when ready upstream list is built out
 type readyListener struct {
 	listenerKey      proxycfg.APIGatewayListenerKey
@@ -290,9 +405,35 @@ func getReadyListeners(cfgSnap *proxycfg.ConfigSnapshot) map[string]readyListene
 			continue
 		}
 
-		// For each route bound to the listener
+		listenerKey := proxycfg.APIGatewayListenerKeyFromListener(l)
 		boundListener := cfgSnap.APIGateway.BoundListeners[l.Name]
+
+		// Insert or update readyListener for the listener to include this route
+		addRoute := func(routeRef structs.ResourceReference, upstreams ...structs.Upstream) {
+			r, ok := ready[l.Name]
+			if !ok {
+				r = readyListener{
+					listenerKey:      listenerKey,
+					listenerCfg:      l,
+					routeReferences:  map[structs.ResourceReference]struct{}{},
+					boundListenerCfg: boundListener,
+				}
+			}
+			r.routeReferences[routeRef] = struct{}{}
+			r.upstreams = append(r.upstreams, upstreams...)
+			ready[l.Name] = r
+		}
+
+		// For each route bound to the listener
 		for _, routeRef := range boundListener.Routes {
+			// Routes that redirect or respond directly don't need any
+			// upstreams to be served by the listener
+			if routeRef.Kind == structs.HTTPRoute {
+				if route, ok := cfgSnap.APIGateway.HTTPRoutes.Get(routeRef); ok && route.RespondsDirectly() {
+					addRoute(routeRef)
+				}
+			}
+
 			// Get all upstreams for the route
 			routeUpstreams, ok := cfgSnap.APIGateway.Upstreams[routeRef]
 			if !ok {
@@ -301,32 +442,43 @@ func getReadyListeners(cfgSnap *proxycfg.ConfigSnapshot) map[string]readyListene
 
 			// Filter to upstreams that attach to this specific listener since
 			// a route can bind to + have upstreams for multiple listeners
-			listenerKey := proxycfg.APIGatewayListenerKeyFromListener(l)
 			routeUpstreamsForListener, ok := routeUpstreams[listenerKey]
 			if !ok {
 				continue
 			}
 
 			for _, upstream := range routeUpstreamsForListener {
-				// Insert or update readyListener for the listener to include this upstream
-				r, ok := ready[l.Name]
-				if !ok {
-					r = readyListener{
-						listenerKey:      listenerKey,
-						listenerCfg:      l,
-						routeReferences:  map[structs.ResourceReference]struct{}{},
-						boundListenerCfg: boundListener,
-					}
-				}
-				r.routeReferences[routeRef] = struct{}{}
-				r.upstreams = append(r.upstreams, upstream)
-				ready[l.Name] = r
+				addRoute(routeRef, upstream)
 			}
 		}
 	}
 	return ready
 }
 
+// httpRoutesForListener returns the HTTP-based routes bound to a listener.
+// GRPCRoutes are converted to their HTTPRoute equivalent so that both kinds
+// can be consolidated into the same route configuration.
+func httpRoutesForListener(cfgSnap *proxycfg.ConfigSnapshot, readyListener readyListener) ([]*structs.HTTPRouteConfigEntry, error) {
+	routes := make([]*structs.HTTPRouteConfigEntry, 0, len(readyListener.routeReferences))
+	for _, routeRef := range maps.Keys(readyListener.routeReferences) {
+		switch routeRef.Kind {
+		case structs.GRPCRoute:
+			route, ok := cfgSnap.APIGateway.GRPCRoutes.Get(routeRef)
+			if !ok {
+				return nil, fmt.Errorf("missing route for routeRef %s:%s", routeRef.Kind, routeRef.Name)
+			}
+			routes = append(routes, route.ToHTTPRoute())
+		default:
+			route, ok := cfgSnap.APIGateway.HTTPRoutes.Get(routeRef)
+			if !ok {
+				return nil, fmt.Errorf("missing route for routeRef %s:%s", routeRef.Kind, routeRef.Name)
+			}
+			routes = append(routes, route)
+		}
+	}
+	return routes, nil
+}
+
 func makeDownstreamTLSContextFromSnapshotAPIListenerConfig(
 	cfgSnap *proxycfg.ConfigSnapshot,
 	listenerCfg structs.APIGatewayListener,
@@ -109,17 +109,35 @@ func (s *ResourceGenerator) makeIngressGatewayListeners(address string, cfgSnap
 			listener := makeListener(listenerOpts)
 
 			filterOpts := listenerFilterOpts{
-				useRDS:           true,
-				fetchTimeoutRDS:  cfgSnap.GetXDSCommonConfig(s.Logger).GetXDSFetchTimeout(),
-				protocol:         listenerKey.Protocol,
-				filterName:       listenerKey.RouteName(),
-				routeName:        listenerKey.RouteName(),
-				cluster:          "",
-				statPrefix:       "ingress_upstream_",
-				routePath:        "",
-				httpAuthzFilters: nil,
-				accessLogs:       &cfgSnap.Proxy.AccessLogs,
-				logger:           s.Logger,
+				useRDS:              true,
+				fetchTimeoutRDS:     cfgSnap.GetXDSCommonConfig(s.Logger).GetXDSFetchTimeout(),
+				protocol:            listenerKey.Protocol,
+				filterName:          listenerKey.RouteName(),
+				routeName:           listenerKey.RouteName(),
+				cluster:             "",
+				statPrefix:          "ingress_upstream_",
+				routePath:           "",
+				httpAuthzFilters:    nil,
+				accessLogs:          &cfgSnap.Proxy.AccessLogs,
+				logger:              s.Logger,
+				rateLimit:           listenerCfg.RateLimit,
+				maxRequestBytes:     listenerCfg.MaxRequestBytes,
+				maxRequestHeadersKB: listenerCfg.MaxRequestHeadersKB,
+			}
+			for _, u := range upstreams {
+				chain := cfgSnap.IngressGateway.DiscoveryChain[proxycfg.NewUpstreamID(&u)]
+				filterOpts.routeFilters = filterOpts.routeFilters.add(chainRouteFilters(chain, true)...)
+				if svc := findIngressServiceMatchingUpstream(listenerCfg, u); svc != nil {
+					if svc.CORS != nil {
+						filterOpts.routeFilters = filterOpts.routeFilters.add(corsRouteFilter)
+					}
+					if svc.RateLimit != nil {
+						filterOpts.routeFilters = filterOpts.routeFilters.add(localRateLimitRouteFilter)
+					}
+					if svc.MaxRequestBytes != 0 {
+						filterOpts.routeFilters = filterOpts.routeFilters.add(bufferRouteFilter)
+					}
+				}
 			}
 
 			// Generate any filter chains needed for services with custom TLS certs
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_ratelimit_common_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	envoy_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoy_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/agent/xds/response"
+)
+
+const (
+	localRateLimitEnvoyFilter = "envoy.filters.http.local_ratelimit"
+
+	// localRateLimitRouteKey is the descriptor key used to identify which of
+	// the LocalRateLimit routes a request matched.
+	localRateLimitRouteKey = "consul_route"
+)
+
+// makeLocalRateLimitFilter builds the Envoy local rate limit filter for an
+// HTTP connection manager. A nil rate limit config yields a filter that
+// limits nothing, which allows routes to configure limits individually.
+func makeLocalRateLimitFilter(rl *structs.LocalRateLimit, statPrefix string) (*envoy_http_v3.HttpFilter, error) {
+	return makeEnvoyHTTPFilter(localRateLimitEnvoyFilter, makeLocalRateLimitConfig(rl, statPrefix))
+}
+
+// makeLocalRateLimitPerRouteConfig builds the typed per-filter config that
+// gives a single route its own token bucket.
+func makeLocalRateLimitPerRouteConfig(rl *structs.LocalRateLimit, statPrefix string) (*anypb.Any, error) {
+	return anypb.New(makeLocalRateLimitConfig(rl, statPrefix))
+}
+
+func makeLocalRateLimitConfig(rl *structs.LocalRateLimit, statPrefix string) *envoy_local_ratelimit_v3.LocalRateLimit {
+	cfg := &envoy_local_ratelimit_v3.LocalRateLimit{
+		StatPrefix: statPrefix,
+	}
+	if rl == nil {
+		return cfg
+	}
+
+	enabled := &envoy_core_v3.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type_v3.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+		},
+	}
+	cfg.TokenBucket = makeLocalRateLimitTokenBucket(rl.RequestsPerSecond, rl.RequestsMaxBurst)
+	cfg.FilterEnabled = enabled
+	cfg.FilterEnforced = enabled
+	// A matching route or descriptor replaces the top-level limit rather
+	// than adding to it.
+	cfg.AlwaysConsumeDefaultTokenBucket = wrapperspb.Bool(false)
+
+	for i, route := range rl.Routes {
+		cfg.Descriptors = append(cfg.Descriptors, &envoy_ratelimit_common_v3.LocalRateLimitDescriptor{
+			Entries: []*envoy_ratelimit_common_v3.RateLimitDescriptor_Entry{
+				{Key: localRateLimitRouteKey, Value: strconv.Itoa(i)},
+			},
+			TokenBucket: makeLocalRateLimitTokenBucket(route.RequestsPerSecond, route.RequestsMaxBurst),
+		})
+	}
+	for _, desc := range rl.Descriptors {
+		cfg.Descriptors = append(cfg.Descriptors, &envoy_ratelimit_common_v3.LocalRateLimitDescriptor{
+			Entries: []*envoy_ratelimit_common_v3.RateLimitDescriptor_Entry{
+				{Key: strings.ToLower(desc.Header), Value: desc.Value},
+			},
+			TokenBucket: makeLocalRateLimitTokenBucket(desc.RequestsPerSecond, desc.RequestsMaxBurst),
+		})
+	}
+
+	return cfg
+}
+
+// makeLocalRateLimitActions builds the rate limit actions that produce the
+// descriptors matched against the rate limit config's Routes and
+// Descriptors. The actions must be attached to the virtual host or route the
+// rate limit applies to.
+func makeLocalRateLimitActions(rl *structs.LocalRateLimit) []*envoy_route_v3.RateLimit {
+	if rl == nil {
+		return nil
+	}
+
+	var actions []*envoy_route_v3.RateLimit
+	for i, route := range rl.Routes {
+		actions = append(actions, &envoy_route_v3.RateLimit{
+			Actions: []*envoy_route_v3.RateLimit_Action{{
+				ActionSpecifier: &envoy_route_v3.RateLimit_Action_HeaderValueMatch_{
+					HeaderValueMatch: &envoy_route_v3.RateLimit_Action_HeaderValueMatch{
+						DescriptorKey:   localRateLimitRouteKey,
+						DescriptorValue: strconv.Itoa(i),
+						Headers:         []*envoy_route_v3.HeaderMatcher{makeLocalRateLimitPathMatcher(route)},
+					},
+				},
+			}},
+		})
+	}
+	for _, desc := range rl.Descriptors {
+		actions = append(actions, &envoy_route_v3.RateLimit{
+			Actions: []*envoy_route_v3.RateLimit_Action{{
+				ActionSpecifier: &envoy_route_v3.RateLimit_Action_RequestHeaders_{
+					RequestHeaders: &envoy_route_v3.RateLimit_Action_RequestHeaders{
+						HeaderName:    desc.Header,
+						DescriptorKey: strings.ToLower(desc.Header),
+					},
+				},
+			}},
+		})
+	}
+	return actions
+}
+
+// makeLocalRateLimitPathMatcher matches the :path pseudo-header against a
+// rate limit route. Unlike route matching, the :path header includes the
+// query string so exact and regex matches allow for one.
+func makeLocalRateLimitPathMatcher(route structs.LocalRateLimitRoute) *envoy_route_v3.HeaderMatcher {
+	const optionalQuery = `(\?.*)?`
+
+	var pattern *envoy_matcher_v3.StringMatcher
+	switch {
+	case route.PathExact != "":
+		pattern = &envoy_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_matcher_v3.StringMatcher_SafeRegex{
+				SafeRegex: response.MakeEnvoyRegexMatch(regexp.QuoteMeta(route.PathExact) + optionalQuery),
+			},
+		}
+	case route.PathPrefix != "":
+		pattern = &envoy_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_matcher_v3.StringMatcher_Prefix{
+				Prefix: route.PathPrefix,
+			},
+		}
+	default:
+		pattern = &envoy_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_matcher_v3.StringMatcher_SafeRegex{
+				SafeRegex: response.MakeEnvoyRegexMatch(fmt.Sprintf("(?:%s)%s", route.PathRegex, optionalQuery)),
+			},
+		}
+	}
+
+	return &envoy_route_v3.HeaderMatcher{
+		Name: ":path",
+		HeaderMatchSpecifier: &envoy_route_v3.HeaderMatcher_StringMatch{
+			StringMatch: pattern,
+		},
+	}
+}
+
+func makeLocalRateLimitTokenBucket(perSecond, maxBurst uint32) *envoy_type_v3.TokenBucket {
+	if maxBurst == 0 {
+		maxBurst = perSecond
+	}
+	return &envoy_type_v3.TokenBucket{
+		MaxTokens:     maxBurst,
+		TokensPerFill: wrapperspb.UInt32(perSecond),
+		FillInterval:  durationpb.New(time.Second),
+	}
+}
@@ -5,7 +5,12 @@ package xds
 
 import (
 	"fmt"
+	"sort"
+
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/hashicorp/go-hclog"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/hashicorp/consul/agent/structs"
 )
@@ -21,3 +26,52 @@ func groupedEndpoints(logger hclog.Logger, locality *structs.Locality, policy *s
 		return nil, fmt.Errorf("unexpected priortize-by-locality mode %q", policy.Mode)
 	}
 }
+
+// localityEndpoints is a set of endpoints that share a locality.
+type localityEndpoints struct {
+	Locality  *structs.Locality
+	Endpoints structs.CheckServiceNodes
+}
+
+// endpointsByLocality groups the endpoints by locality, sorted by region and
+// zone so that the generated load assignment is stable.
+func endpointsByLocality(csns structs.CheckServiceNodes) []localityEndpoints {
+	var (
+		groups []localityEndpoints
+		index  = make(map[structs.Locality]int)
+	)
+	for _, csn := range csns {
+		var key structs.Locality
+		if l := csn.Locality(); l != nil {
+			key = *l
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, localityEndpoints{Locality: csn.Locality()})
+		}
+		groups[i].Endpoints = append(groups[i].Endpoints, csn)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := groups[i].Locality, groups[j].Locality
+		if a.GetRegion() != b.GetRegion() {
+			return a.GetRegion() < b.GetRegion()
+		}
+		return a.GetZone() < b.GetZone()
+	})
+	return groups
+}
+
+// makeZoneAwareLbConfig enables Envoy's zone-aware routing for all requests.
+// Envoy compares the zones of the upstream hosts with those of the local
+// service cluster, so a zero MinClusterSize leaves Envoy's own default.
+func makeZoneAwareLbConfig(policy *structs.DiscoveryPrioritizeByLocality) *envoy_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig_ {
+	cfg := &envoy_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig{
+		RoutingEnabled: &envoy_type_v3.Percent{Value: 100},
+	}
+	if policy.MinClusterSize != 0 {
+		cfg.MinClusterSize = wrapperspb.UInt64(uint64(policy.MinClusterSize))
+	}
+	return &envoy_cluster_v3.Cluster_CommonLbConfig_ZoneAwareLbConfig_{ZoneAwareLbConfig: cfg}
+}
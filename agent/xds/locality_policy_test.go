@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package xds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func Test_endpointsByLocality(t *testing.T) {
+	makeNodes := func(zones ...string) structs.CheckServiceNodes {
+		var csns structs.CheckServiceNodes
+		for i, zone := range zones {
+			csn := structs.CheckServiceNode{
+				Node:    &structs.Node{Node: "node" + string(rune('a'+i))},
+				Service: &structs.NodeService{Service: "db"},
+			}
+			if zone != "" {
+				csn.Node.Locality = &structs.Locality{Region: "us-east-1", Zone: zone}
+			}
+			csns = append(csns, csn)
+		}
+		return csns
+	}
+
+	type summary struct {
+		Zone  string
+		Nodes []string
+	}
+	summarize := func(groups []localityEndpoints) []summary {
+		var out []summary
+		for _, g := range groups {
+			s := summary{Zone: g.Locality.GetZone()}
+			for _, csn := range g.Endpoints {
+				s.Nodes = append(s.Nodes, csn.Node.Node)
+			}
+			out = append(out, s)
+		}
+		return out
+	}
+
+	cases := map[string]struct {
+		csns   structs.CheckServiceNodes
+		expect []summary
+	}{
+		"empty": {},
+		"grouped and sorted by zone": {
+			csns: makeNodes("us-east-1b", "us-east-1a", "us-east-1c", "us-east-1a"),
+			expect: []summary{
+				{Zone: "us-east-1a", Nodes: []string{"nodeb", "noded"}},
+				{Zone: "us-east-1b", Nodes: []string{"nodea"}},
+				{Zone: "us-east-1c", Nodes: []string{"nodec"}},
+			},
+		},
+		"without locality": {
+			csns: makeNodes("us-east-1b", "", "us-east-1b", ""),
+			expect: []summary{
+				{Zone: "", Nodes: []string{"nodeb", "noded"}},
+				{Zone: "us-east-1b", Nodes: []string{"nodea", "nodec"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expect, summarize(endpointsByLocality(tc.csns)))
+		})
+	}
+}
@@ -34,14 +34,15 @@ func makeRBACNetworkFilter(
 	localInfo rbacLocalInfo,
 	peerTrustBundles []*pbpeering.PeeringTrustBundle,
 ) (*envoy_listener_v3.Filter, error) {
-	rules, err := makeRBACRules(intentions, intentionDefaultAllow, localInfo, false, peerTrustBundles, nil)
+	rules, shadowRules, err := makeRBACRules(intentions, intentionDefaultAllow, localInfo, false, peerTrustBundles, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	cfg := &envoy_network_rbac_v3.RBAC{
-		StatPrefix: "connect_authz",
-		Rules:      rules,
+		StatPrefix:  "connect_authz",
+		Rules:       rules,
+		ShadowRules: shadowRules,
 	}
 	return makeFilter(envoyNetworkRBACFilterKey, cfg)
 }
@@ -53,13 +54,14 @@ func makeRBACHTTPFilter(
 	peerTrustBundles []*pbpeering.PeeringTrustBundle,
 	providerMap map[string]*structs.JWTProviderConfigEntry,
 ) (*envoy_http_v3.HttpFilter, error) {
-	rules, err := makeRBACRules(intentions, intentionDefaultAllow, localInfo, true, peerTrustBundles, providerMap)
+	rules, shadowRules, err := makeRBACRules(intentions, intentionDefaultAllow, localInfo, true, peerTrustBundles, providerMap)
 	if err != nil {
 		return nil, err
 	}
 
 	cfg := &envoy_http_rbac_v3.RBAC{
-		Rules: rules,
+		Rules:       rules,
+		ShadowRules: shadowRules,
 	}
 	return makeEnvoyHTTPFilter(envoyHTTPRBACFilterKey, cfg)
 }
@@ -586,6 +588,14 @@ type rbacLocalInfo struct {
 //	<default>    : DENY
 //
 // Which really is just an allow-list of [A, C AND NOT(B)]
+//
+// Alongside the enforced rules a set of shadow rules is returned. Shadow rules
+// never affect traffic, but Envoy records the name of the shadow policy that
+// matched in the filter's dynamic metadata (shadow_effective_policy_id). The
+// shadow rules are always a deny-list with one policy per intention that can
+// deny a request, named after the intention's source, so that access logs can
+// attribute a denial to the intention responsible for it. A denied request
+// that matches no shadow policy was denied by the default intention policy.
 func makeRBACRules(
 	intentions structs.SimplifiedIntentions,
 	intentionDefaultAllow bool,
@@ -593,7 +603,7 @@ func makeRBACRules(
 	isHTTP bool,
 	peerTrustBundles []*pbpeering.PeeringTrustBundle,
 	providerMap map[string]*structs.JWTProviderConfigEntry,
-) (*envoy_rbac_v3.RBAC, error) {
+) (*envoy_rbac_v3.RBAC, *envoy_rbac_v3.RBAC, error) {
 	// TODO(banks,rb): Implement revocation list checking?
 
 	// TODO(peering): mkeeler asked that these maps come from proxycfg instead of
@@ -615,7 +625,12 @@ func makeRBACRules(
 	// First build up just the basic principal matches.
 	rbacIxns, err := intentionListToIntermediateRBACForm(intentions, localInfo, isHTTP, trustBundlesByPeer, providerMap)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	shadowRules, err := makeRBACShadowRules(intentions, localInfo, isHTTP, trustBundlesByPeer, providerMap)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// Normalize: if we are in default-deny then all intentions must be allows and vice versa
@@ -677,6 +692,50 @@ func makeRBACRules(
 	if len(rbac.Policies) == 0 {
 		rbac.Policies = nil
 	}
+	return rbac, shadowRules, nil
+}
+
+// makeRBACShadowRules builds the deny-list of intentions described on
+// makeRBACRules. It returns nil if no intention can deny a request.
+func makeRBACShadowRules(
+	intentions structs.SimplifiedIntentions,
+	localInfo rbacLocalInfo,
+	isHTTP bool,
+	trustBundlesByPeer map[string]*pbpeering.PeeringTrustBundle,
+	providerMap map[string]*structs.JWTProviderConfigEntry,
+) (*envoy_rbac_v3.RBAC, error) {
+	// The intermediate form is mutated while removing precedence, so the
+	// shadow rules need their own copy.
+	rbacIxns, err := intentionListToIntermediateRBACForm(intentions, localInfo, isHTTP, trustBundlesByPeer, providerMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// Removing precedence against an allow default leaves exactly the
+	// intentions (and L7 permissions) that deny.
+	rbacIxns = removeIntentionPrecedence(rbacIxns, intentionActionAllow, localInfo)
+	if len(rbacIxns) == 0 {
+		return nil, nil
+	}
+
+	rbac := &envoy_rbac_v3.RBAC{
+		Action:   envoy_rbac_v3.RBAC_DENY,
+		Policies: make(map[string]*envoy_rbac_v3.Policy, len(rbacIxns)),
+	}
+	for _, rbacIxn := range rbacIxns {
+		policy := &envoy_rbac_v3.Policy{
+			Principals: optimizePrincipals([]*envoy_rbac_v3.Principal{rbacIxn.ComputedPrincipal}),
+		}
+		if rbacIxn.Action == intentionActionLayer7 {
+			policy.Permissions = make([]*envoy_rbac_v3.Permission, 0, len(rbacIxn.Permissions))
+			for _, perm := range rbacIxn.Permissions {
+				policy.Permissions = append(policy.Permissions, perm.ComputedPermission)
+			}
+		} else {
+			policy.Permissions = []*envoy_rbac_v3.Permission{anyPermission()}
+		}
+		rbac.Policies[structs.IntentionDenyPolicyID(rbacIxn.Source.ServiceName, rbacIxn.Source.Peer)] = policy
+	}
 	return rbac, nil
 }
 
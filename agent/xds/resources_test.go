@@ -19,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/hashicorp/consul/agent/configentry"
 	"github.com/hashicorp/consul/agent/connect"
 	"github.com/hashicorp/consul/agent/consul/discoverychain"
 	"github.com/hashicorp/consul/agent/proxycfg"
@@ -270,6 +271,58 @@ func TestAllResourcesFromSnapshot(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			name: "http-listener-with-rate-limit",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "http"
+					ns.Proxy.Config["rate_limit"] = map[string]interface{}{
+						"requests_per_second": 100,
+						"requests_max_burst":  150,
+						"routes": []interface{}{
+							map[string]interface{}{
+								"path_exact":          "/login",
+								"requests_per_second": 5,
+							},
+							map[string]interface{}{
+								"path_prefix":         "/api/",
+								"requests_per_second": 50,
+							},
+						},
+						"descriptors": []interface{}{
+							map[string]interface{}{
+								"header":              "x-tenant",
+								"value":               "free",
+								"requests_per_second": 10,
+							},
+						},
+					}
+				}, nil)
+			},
+		},
+		{
+			name: "http-listener-with-fault-injection",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.Config["protocol"] = "http"
+					ns.Proxy.Config["fault_injection"] = map[string]interface{}{
+						"delay": map[string]interface{}{
+							"fixed_delay": "250ms",
+						},
+						"abort": map[string]interface{}{
+							"http_status": 503,
+							"percent":     10,
+						},
+						"headers": []interface{}{
+							map[string]interface{}{
+								"name":    "x-chaos",
+								"present": true,
+							},
+						},
+					}
+				}, nil)
+			},
+		},
 		{
 			name: "http-upstream",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -534,6 +587,50 @@ func getConnectProxyDiscoChainTests(enterprise bool) []goldenTestCase {
 				return cfgSnap
 			},
 		},
+		{
+			name: "connect-proxy-with-chain-and-mirrors",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-mirrors", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-chain-and-fault-injection",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-fault-injection", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-chain-and-rate-limit",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-rate-limit", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-chain-and-cors",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-cors", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-chain-and-jwt-routing",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-jwt-routing", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-chain-and-resolver-health-checks",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-resolver-health-checks", enterprise, nil, nil)
+			},
+		},
+		{
+			name: "connect-proxy-with-chain-and-zone-aware",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotDiscoveryChain(t, "chain-and-zone-aware", enterprise, func(ns *structs.NodeService) {
+					ns.Locality = &structs.Locality{Region: "us-east-1", Zone: "us-east-1a"}
+				}, nil)
+			},
+		},
 		{
 			name: "connect-proxy-with-grpc-router",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -946,6 +1043,60 @@ func getAPIGatewayGoldenTestCases(t *testing.T) []goldenTestCase {
 	serviceUID := proxycfg.NewUpstreamIDFromServiceName(service)
 	serviceChain := discoverychain.TestCompileConfigEntries(t, "service", "default", "default", "dc1", connect.TestClusterID+".consul", nil, nil)
 
+	// httpRouteSnapshot returns an API gateway with an HTTP listener bound to
+	// an http-route with the given rules.
+	httpRouteSnapshot := func(t testinf.T, rules ...structs.HTTPRouteRule) *proxycfg.ConfigSnapshot {
+		return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+			entry.Listeners = []structs.APIGatewayListener{
+				{
+					Name:     "listener",
+					Protocol: structs.ListenerProtocolHTTP,
+					Port:     8080,
+				},
+			}
+			bound.Listeners = []structs.BoundAPIGatewayListener{
+				{
+					Name: "listener",
+					Certificates: []structs.ResourceReference{{
+						Kind: structs.InlineCertificate,
+						Name: "certificate",
+					}},
+					Routes: []structs.ResourceReference{{
+						Kind: structs.HTTPRoute,
+						Name: "route",
+					}},
+				},
+			}
+		}, []structs.BoundRoute{
+			&structs.HTTPRouteConfigEntry{
+				Kind:  structs.HTTPRoute,
+				Name:  "route",
+				Rules: rules,
+				Parents: []structs.ResourceReference{
+					{
+						Kind: structs.APIGateway,
+						Name: "api-gateway",
+					},
+				},
+			},
+		}, []structs.InlineCertificateConfigEntry{{
+			Kind:        structs.InlineCertificate,
+			Name:        "certificate",
+			PrivateKey:  gatewayTestPrivateKey,
+			Certificate: gatewayTestCertificate,
+		}}, []proxycfg.UpdateEvent{{
+			CorrelationID: "discovery-chain:" + serviceUID.String(),
+			Result: &structs.DiscoveryChainResponse{
+				Chain: serviceChain,
+			},
+		}, {
+			CorrelationID: "upstream-target:" + serviceChain.ID() + ":" + serviceUID.String(),
+			Result: &structs.IndexedCheckServiceNodes{
+				Nodes: proxycfg.TestUpstreamNodes(t, "service"),
+			},
+		}})
+	}
+
 	return []goldenTestCase{
 		{
 			name: "api-gateway-with-tcp-route-and-inline-certificate",
@@ -1154,6 +1305,76 @@ func getAPIGatewayGoldenTestCases(t *testing.T) []goldenTestCase {
 				}})
 			},
 		},
+		{
+			name: "api-gateway-with-grpc-route",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolHTTP2,
+							Port:     8080,
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Certificates: []structs.ResourceReference{{
+								Kind: structs.InlineCertificate,
+								Name: "certificate",
+							}},
+							Routes: []structs.ResourceReference{{
+								Kind: structs.GRPCRoute,
+								Name: "route",
+							}},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.GRPCRouteConfigEntry{
+						Kind: structs.GRPCRoute,
+						Name: "route",
+						Rules: []structs.GRPCRouteRule{{
+							Matches: []structs.GRPCMatch{{
+								Method: structs.GRPCMethodMatch{
+									Match:   structs.GRPCMethodMatchExact,
+									Service: "helloworld.Greeter",
+									Method:  "SayHello",
+								},
+								Headers: []structs.HTTPHeaderMatch{{
+									Match: structs.HTTPHeaderMatchExact,
+									Name:  "x-tenant",
+									Value: "a",
+								}},
+							}},
+							Services: []structs.HTTPService{{
+								Name: "service",
+							}},
+						}},
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+					},
+				}, []structs.InlineCertificateConfigEntry{{
+					Kind:        structs.InlineCertificate,
+					Name:        "certificate",
+					PrivateKey:  gatewayTestPrivateKey,
+					Certificate: gatewayTestCertificate,
+				}}, []proxycfg.UpdateEvent{{
+					CorrelationID: "discovery-chain:" + serviceUID.String(),
+					Result: &structs.DiscoveryChainResponse{
+						Chain: serviceChain,
+					},
+				}, {
+					CorrelationID: "upstream-target:" + serviceChain.ID() + ":" + serviceUID.String(),
+					Result: &structs.IndexedCheckServiceNodes{
+						Nodes: proxycfg.TestUpstreamNodes(t, "service"),
+					},
+				}})
+			},
+		},
 		{
 			name: "api-gateway-with-http-route-timeoutfilter-one-set",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -1228,6 +1449,193 @@ func getAPIGatewayGoldenTestCases(t *testing.T) []goldenTestCase {
 				}})
 			},
 		},
+		{
+			name: "api-gateway-with-http-route-cors",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return httpRouteSnapshot(t, structs.HTTPRouteRule{
+					CORS: &structs.CORSPolicy{
+						AllowOrigins:     []string{"https://example.com"},
+						AllowMethods:     []string{"GET", "POST"},
+						AllowHeaders:     []string{"content-type"},
+						MaxAge:           time.Hour,
+						AllowCredentials: true,
+					},
+					Services: []structs.HTTPService{{
+						Name: "service",
+					}},
+				})
+			},
+		},
+		{
+			name: "api-gateway-with-http-route-redirect-and-direct-response",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolHTTP,
+							Port:     8080,
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Routes: []structs.ResourceReference{{
+								Kind: structs.HTTPRoute,
+								Name: "route",
+							}},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.HTTPRouteConfigEntry{
+						Kind: structs.HTTPRoute,
+						Name: "route",
+						Rules: []structs.HTTPRouteRule{
+							{
+								Matches: []structs.HTTPMatch{{
+									Path: structs.HTTPPathMatch{
+										Match: structs.HTTPPathMatchPrefix,
+										Value: "/old",
+									},
+								}},
+								Filters: structs.HTTPFilters{
+									RequestRedirect: &structs.RequestRedirectFilter{
+										Hostname:   "example.com",
+										Path:       "/new",
+										StatusCode: 308,
+									},
+								},
+							},
+							{
+								Matches: []structs.HTTPMatch{{
+									Path: structs.HTTPPathMatch{
+										Match: structs.HTTPPathMatchPrefix,
+										Value: "/maintenance",
+									},
+								}},
+								Filters: structs.HTTPFilters{
+									DirectResponse: &structs.DirectResponseFilter{
+										StatusCode: 503,
+										Body:       "down for maintenance",
+									},
+								},
+							},
+							{
+								Services: []structs.HTTPService{{
+									Name: "service",
+								}},
+							},
+						},
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+					},
+				}, nil, []proxycfg.UpdateEvent{{
+					CorrelationID: "discovery-chain:" + serviceUID.String(),
+					Result: &structs.DiscoveryChainResponse{
+						Chain: serviceChain,
+					},
+				}, {
+					CorrelationID: "upstream-target:" + serviceChain.ID() + ":" + serviceUID.String(),
+					Result: &structs.IndexedCheckServiceNodes{
+						Nodes: proxycfg.TestUpstreamNodes(t, "service"),
+					},
+				}})
+			},
+		},
+		{
+			name: "api-gateway-with-http-route-acme-challenges",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolHTTP,
+							Port:     80,
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Routes: []structs.ResourceReference{{
+								Kind: structs.HTTPRoute,
+								Name: "route",
+							}},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.HTTPRouteConfigEntry{
+						Kind: structs.HTTPRoute,
+						Name: "route",
+						Rules: []structs.HTTPRouteRule{{
+							Filters: structs.HTTPFilters{
+								RequestRedirect: &structs.RequestRedirectFilter{
+									Scheme: "https",
+									Port:   443,
+								},
+							},
+						}},
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+					},
+				}, nil, []proxycfg.UpdateEvent{
+					proxycfg.TestACMEChallengesUpdateEvent(structs.ACMEChallenge{
+						Token:            "token",
+						KeyAuthorization: "token.thumbprint",
+					}),
+				})
+			},
+		},
+		{
+			name: "api-gateway-with-http-route-https-redirect",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolHTTP,
+							Port:     8080,
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Routes: []structs.ResourceReference{{
+								Kind: structs.HTTPRoute,
+								Name: "route",
+							}},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.HTTPRouteConfigEntry{
+						Kind: structs.HTTPRoute,
+						Name: "route",
+						Rules: []structs.HTTPRouteRule{{
+							Filters: structs.HTTPFilters{
+								RequestRedirect: &structs.RequestRedirectFilter{
+									Scheme:     "https",
+									Port:       443,
+									StatusCode: 301,
+								},
+							},
+						}},
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+					},
+				}, nil, nil)
+			},
+		},
 		{
 			name: "api-gateway",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -1298,6 +1706,159 @@ func getAPIGatewayGoldenTestCases(t *testing.T) []goldenTestCase {
 				}, nil, nil)
 			},
 		},
+		{
+			name: "api-gateway-passthrough-listener-with-tls-routes",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolTCP,
+							Port:     8443,
+							TLS: structs.APIGatewayTLSConfiguration{
+								Mode: structs.APIGatewayTLSModePassthrough,
+							},
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Routes: []structs.ResourceReference{
+								{
+									Name: "tls-route-a",
+									Kind: structs.TLSRoute,
+								},
+								{
+									Name: "tls-route-b",
+									Kind: structs.TLSRoute,
+								},
+							},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.TLSRouteConfigEntry{
+						Name: "tls-route-a",
+						Kind: structs.TLSRoute,
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+						Hostnames: []string{"a.example.com"},
+						Services: []structs.TCPService{
+							{Name: "service-a"},
+						},
+					},
+					&structs.TLSRouteConfigEntry{
+						Name: "tls-route-b",
+						Kind: structs.TLSRoute,
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+						Hostnames: []string{"b.example.com", "*.b.example.com"},
+						Services: []structs.TCPService{
+							{Name: "service-b"},
+						},
+					},
+				}, nil, nil)
+			},
+		},
+		{
+			name: "api-gateway-passthrough-listener-with-routed-tls-route",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				routedSet := configentry.NewDiscoveryChainSet()
+				routedSet.AddEntries(
+					&structs.ServiceConfigEntry{
+						Kind:     structs.ServiceDefaults,
+						Name:     "service-b",
+						Protocol: "http",
+					},
+					&structs.ServiceConfigEntry{
+						Kind:     structs.ServiceDefaults,
+						Name:     "service-b-v2",
+						Protocol: "http",
+					},
+					&structs.ServiceRouterConfigEntry{
+						Kind: structs.ServiceRouter,
+						Name: "service-b",
+						Routes: []structs.ServiceRoute{{
+							Match: &structs.ServiceRouteMatch{HTTP: &structs.ServiceRouteHTTPMatch{PathPrefix: "/v2"}},
+							Destination: &structs.ServiceRouteDestination{
+								Service: "service-b-v2",
+							},
+						}},
+					},
+				)
+
+				return proxycfg.TestConfigSnapshotAPIGateway(t, "default", nil, func(entry *structs.APIGatewayConfigEntry, bound *structs.BoundAPIGatewayConfigEntry) {
+					entry.Listeners = []structs.APIGatewayListener{
+						{
+							Name:     "listener",
+							Protocol: structs.ListenerProtocolTCP,
+							Port:     8443,
+							TLS: structs.APIGatewayTLSConfiguration{
+								Mode: structs.APIGatewayTLSModePassthrough,
+							},
+						},
+					}
+					bound.Listeners = []structs.BoundAPIGatewayListener{
+						{
+							Name: "listener",
+							Routes: []structs.ResourceReference{
+								{
+									Name: "tls-route-a",
+									Kind: structs.TLSRoute,
+								},
+								{
+									Name: "tls-route-b",
+									Kind: structs.TLSRoute,
+								},
+							},
+						},
+					}
+				}, []structs.BoundRoute{
+					&structs.TLSRouteConfigEntry{
+						Name: "tls-route-a",
+						Kind: structs.TLSRoute,
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+						Hostnames: []string{"a.example.com"},
+						Services: []structs.TCPService{
+							{Name: "service-a"},
+						},
+					},
+					&structs.TLSRouteConfigEntry{
+						Name: "tls-route-b",
+						Kind: structs.TLSRoute,
+						Parents: []structs.ResourceReference{
+							{
+								Kind: structs.APIGateway,
+								Name: "api-gateway",
+							},
+						},
+						Hostnames: []string{"b.example.com", "*.b.example.com"},
+						Services: []structs.TCPService{
+							{Name: "service-b"},
+						},
+					},
+				}, nil, []proxycfg.UpdateEvent{{
+					// The chain of service-b starts with a router, so
+					// connections can't be passed through to it.
+					CorrelationID: "discovery-chain:" + proxycfg.NewUpstreamIDFromServiceName(structs.NewServiceName("service-b", nil)).String(),
+					Result: &structs.DiscoveryChainResponse{
+						Chain: discoverychain.TestCompileConfigEntries(t, "service-b", "default", "default", "dc1", connect.TestClusterID+".consul", nil, routedSet),
+					},
+				}})
+			},
+		},
 		{
 			name: "api-gateway-http-listener",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -2296,6 +2857,18 @@ func getIngressGatewayGoldenTestCases() []goldenTestCase {
 					}, nil)
 			},
 		},
+		{
+			name: "ingress-with-service-passive-health-check-and-resolver-outlier-detection",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http",
+					"chain-and-resolver-health-checks", nil,
+					func(entry *structs.IngressGatewayConfigEntry) {
+						entry.Listeners[0].Services[0].PassiveHealthCheck = &structs.PassiveHealthCheck{
+							MaxEjectionPercent: uint32ptr(90),
+						}
+					}, nil)
+			},
+		},
 		{
 			name: "ingress-with-chain-external-sni",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
@@ -2425,6 +2998,72 @@ func getIngressGatewayGoldenTestCases() []goldenTestCase {
 					"chain-and-router", nil, nil, nil)
 			},
 		},
+		{
+			name: "ingress-with-chain-and-jwt-routing",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http",
+					"chain-and-jwt-routing", nil, nil, nil)
+			},
+		},
+		{
+			name: "ingress-with-chain-and-cors",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http",
+					"chain-and-cors", nil,
+					func(entry *structs.IngressGatewayConfigEntry) {
+						entry.Listeners[0].Services[0].CORS = &structs.CORSPolicy{
+							AllowOrigins: []string{"*"},
+							AllowMethods: []string{"GET"},
+						}
+					}, nil)
+			},
+		},
+		{
+			name: "ingress-with-listener-request-limits",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http",
+					"default", nil,
+					func(entry *structs.IngressGatewayConfigEntry) {
+						entry.Listeners[0].RateLimit = &structs.LocalRateLimit{
+							RequestsPerSecond: 100,
+							RequestsMaxBurst:  200,
+							Descriptors: []structs.LocalRateLimitDescriptor{
+								{Header: "X-Tenant", Value: "free", RequestsPerSecond: 10},
+							},
+						}
+						entry.Listeners[0].MaxRequestBytes = 1048576
+						entry.Listeners[0].MaxRequestHeadersKB = 32
+					}, nil)
+			},
+		},
+		{
+			name: "ingress-with-service-request-limits",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http",
+					"default", nil,
+					func(entry *structs.IngressGatewayConfigEntry) {
+						entry.Listeners[0].Services[0].RateLimit = &structs.LocalRateLimit{
+							RequestsPerSecond: 50,
+							Routes: []structs.LocalRateLimitRoute{
+								{PathPrefix: "/upload", RequestsPerSecond: 5},
+							},
+						}
+						entry.Listeners[0].Services[0].MaxRequestBytes = 65536
+					}, nil)
+			},
+		},
+		{
+			name: "ingress-with-acme-challenges",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshotIngressGateway(t, true, "http", "default", nil, nil,
+					[]proxycfg.UpdateEvent{
+						proxycfg.TestACMEChallengesUpdateEvent(
+							structs.ACMEChallenge{Token: "token-one", KeyAuthorization: "token-one.thumbprint"},
+							structs.ACMEChallenge{Token: "token-two", KeyAuthorization: "token-two.thumbprint"},
+						),
+					})
+			},
+		},
 		{
 			name:   "ingress-http-multiple-services",
 			create: proxycfg.TestConfigSnapshotIngress_HTTPMultipleServices,
@@ -2606,6 +3245,18 @@ func getAccessLogsGoldenTestCases() []goldenTestCase {
 					nil)
 			},
 		},
+		{
+			name: "access-logs-intention-denials",
+			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
+				return proxycfg.TestConfigSnapshot(t, func(ns *structs.NodeService) {
+					ns.Proxy.AccessLogs = structs.AccessLogsConfig{
+						Enabled:                 true,
+						IncludeIntentionDenials: true,
+					}
+				},
+					nil)
+			},
+		},
 		{
 			name: "access-logs-json-file",
 			create: func(t testinf.T) *proxycfg.ConfigSnapshot {
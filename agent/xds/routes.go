@@ -6,6 +6,7 @@ package xds
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"sort"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/hashicorp/consul/agent/xds/config"
 	"github.com/hashicorp/consul/agent/xds/response"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -420,6 +423,10 @@ func (s *ResourceGenerator) routesForIngressGateway(cfgSnap *proxycfg.ConfigSnap
 				return nil, err
 			}
 
+			if err := injectIngressServiceFiltersToVirtualHost(lCfg, svc, uid, virtualHost); err != nil {
+				return nil, err
+			}
+
 			// See if this upstream has its own route/filter chain
 			svcRouteName := routeNameForUpstream(lCfg, *svc)
 
@@ -444,6 +451,7 @@ func (s *ResourceGenerator) routesForIngressGateway(cfgSnap *proxycfg.ConfigSnap
 		}
 	}
 
+	injectACMEChallengeRoutes(cfgSnap.IngressGateway.ACMEChallenges, result)
 	return result, nil
 }
 
@@ -463,7 +471,7 @@ func (s *ResourceGenerator) routesForAPIGateway(cfgSnap *proxycfg.ConfigSnapshot
 			continue
 		}
 		// Do not create any route configuration for TCP listeners
-		if readyListener.listenerCfg.Protocol != structs.ListenerProtocolHTTP {
+		if readyListener.listenerCfg.Protocol == structs.ListenerProtocolTCP {
 			continue
 		}
 
@@ -476,13 +484,9 @@ func (s *ResourceGenerator) routesForAPIGateway(cfgSnap *proxycfg.ConfigSnapshot
 		}
 
 		// Consolidate all routes for this listener into the minimum possible set based on hostname matching.
-		allRoutesForListener := []*structs.HTTPRouteConfigEntry{}
-		for _, routeRef := range maps.Keys(readyListener.routeReferences) {
-			route, ok := cfgSnap.APIGateway.HTTPRoutes.Get(routeRef)
-			if !ok {
-				return nil, fmt.Errorf("missing route for route routeRef %s:%s", routeRef.Name, routeRef.Kind)
-			}
-			allRoutesForListener = append(allRoutesForListener, route)
+		allRoutesForListener, err := httpRoutesForListener(cfgSnap, readyListener)
+		if err != nil {
+			return nil, err
 		}
 		consolidatedRoutes := discoverychain.ConsolidateHTTPRoutes(cfgSnap.APIGateway.GatewayConfig, &readyListener.listenerCfg, allRoutesForListener...)
 
@@ -522,6 +526,7 @@ func (s *ResourceGenerator) routesForAPIGateway(cfgSnap *proxycfg.ConfigSnapshot
 		}
 	}
 
+	injectACMEChallengeRoutes(cfgSnap.APIGateway.ACMEChallenges, result)
 	return result, nil
 }
 
@@ -659,8 +664,27 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 
 		for _, discoveryRoute := range startNode.Routes {
 			discoveryRoute := discoveryRoute
+
+			// Only the outbound listeners of connect proxies verify the tokens
+			// that JWT matches read their claims from. Gateways skip these routes
+			// rather than sending them requests the claims would not have matched.
+			if cfgSnap.Kind != structs.ServiceKindConnectProxy && routeMatchesJWT(discoveryRoute) {
+				s.Logger.Warn("skipping route that matches on JWT claims, which are only verified by connect proxies",
+					"service", chain.ServiceName, "kind", cfgSnap.Kind)
+				continue
+			}
+
 			routeMatch := makeRouteMatchForDiscoveryRoute(discoveryRoute)
 
+			if destination := discoveryRoute.Definition.Destination; destination.RespondsDirectly() {
+				route, err := makeDirectResponseRoute(routeMatch, destination)
+				if err != nil {
+					return nil, err
+				}
+				routes = append(routes, route)
+				continue
+			}
+
 			var (
 				routeAction *envoy_route_v3.Route_Route
 				err         error
@@ -731,10 +755,48 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 				}
 			}
 
+			// Requests matching the route are mirrored before any mirroring
+			// configured on the splits it leads to.
+			if len(discoveryRoute.Mirrors) > 0 {
+				policies := s.makeRequestMirrorPolicies(upstreamsSnapshot, discoveryRoute.Mirrors, 100, chain, forMeshGateway)
+				routeAction.Route.RequestMirrorPolicies = append(policies, routeAction.Route.RequestMirrorPolicies...)
+			}
+
 			filter, err := filterBuilder.buildTypedPerFilterConfig(routeMatch, routeAction)
 			if err != nil {
 				return nil, err
 			}
+			if destination != nil && destination.FaultInjection != nil {
+				fault, err := makeFaultInjectionPerRouteConfig(destination.FaultInjection)
+				if err != nil {
+					return nil, err
+				}
+				if filter == nil {
+					filter = make(map[string]*anypb.Any)
+				}
+				filter[faultEnvoyFilter] = fault
+			}
+			if destination != nil && destination.RateLimit != nil {
+				rateLimit, err := makeLocalRateLimitPerRouteConfig(destination.RateLimit, makeStatPrefix("upstream.", routeName))
+				if err != nil {
+					return nil, err
+				}
+				if filter == nil {
+					filter = make(map[string]*anypb.Any)
+				}
+				filter[localRateLimitEnvoyFilter] = rateLimit
+				routeAction.Route.RateLimits = makeLocalRateLimitActions(destination.RateLimit)
+			}
+			if destination != nil && destination.CORS != nil {
+				cors, err := makeCORSPerRouteConfig(destination.CORS)
+				if err != nil {
+					return nil, err
+				}
+				if filter == nil {
+					filter = make(map[string]*anypb.Any)
+				}
+				filter[corsEnvoyFilter] = cors
+			}
 			route.Match = routeMatch
 			route.Action = routeAction
 			route.TypedPerFilterConfig = filter
@@ -807,6 +869,106 @@ func (s *ResourceGenerator) makeUpstreamRouteForDiscoveryChain(
 	return host, nil
 }
 
+// injectACMEChallengeRoutes prepends routes answering the pending ACME HTTP-01
+// challenges to every virtual host of the route configurations, so that
+// acme-certificate hostnames can be validated through the gateway.
+func injectACMEChallengeRoutes(challenges []structs.ACMEChallenge, routeConfigs []proto.Message) {
+	if len(challenges) == 0 {
+		return
+	}
+
+	for _, msg := range routeConfigs {
+		routeConfig, ok := msg.(*envoy_route_v3.RouteConfiguration)
+		if !ok {
+			continue
+		}
+		for _, virtualHost := range routeConfig.VirtualHosts {
+			routes := make([]*envoy_route_v3.Route, 0, len(challenges)+len(virtualHost.Routes))
+			for _, challenge := range challenges {
+				routes = append(routes, &envoy_route_v3.Route{
+					Match: &envoy_route_v3.RouteMatch{
+						PathSpecifier: &envoy_route_v3.RouteMatch_Path{
+							Path: structs.ACMEChallengePathPrefix + challenge.Token,
+						},
+					},
+					Action: &envoy_route_v3.Route_DirectResponse{
+						DirectResponse: &envoy_route_v3.DirectResponseAction{
+							Status: 200,
+							Body: &envoy_core_v3.DataSource{
+								Specifier: &envoy_core_v3.DataSource_InlineString{
+									InlineString: challenge.KeyAuthorization,
+								},
+							},
+						},
+					},
+				})
+			}
+			virtualHost.Routes = append(routes, virtualHost.Routes...)
+		}
+	}
+}
+
+// makeDirectResponseRoute builds a route that answers matching requests with
+// a redirect or a fixed response instead of forwarding them to a cluster.
+func makeDirectResponseRoute(match *envoy_route_v3.RouteMatch, destination *structs.ServiceRouteDestination) (*envoy_route_v3.Route, error) {
+	route := &envoy_route_v3.Route{Match: match}
+
+	if redirect := destination.RequestRedirect; redirect != nil {
+		action := &envoy_route_v3.RedirectAction{
+			HostRedirect: redirect.Hostname,
+			PortRedirect: redirect.Port,
+		}
+		if redirect.Scheme != "" {
+			action.SchemeRewriteSpecifier = &envoy_route_v3.RedirectAction_SchemeRedirect{
+				SchemeRedirect: redirect.Scheme,
+			}
+		}
+		if redirect.Path != "" {
+			action.PathRewriteSpecifier = &envoy_route_v3.RedirectAction_PathRedirect{
+				PathRedirect: redirect.Path,
+			}
+		}
+
+		statusCode := redirect.StatusCode
+		if statusCode == 0 {
+			statusCode = structs.DefaultRequestRedirectStatusCode
+		}
+		switch statusCode {
+		case 301:
+			action.ResponseCode = envoy_route_v3.RedirectAction_MOVED_PERMANENTLY
+		case 302:
+			action.ResponseCode = envoy_route_v3.RedirectAction_FOUND
+		case 303:
+			action.ResponseCode = envoy_route_v3.RedirectAction_SEE_OTHER
+		case 307:
+			action.ResponseCode = envoy_route_v3.RedirectAction_TEMPORARY_REDIRECT
+		case 308:
+			action.ResponseCode = envoy_route_v3.RedirectAction_PERMANENT_REDIRECT
+		default:
+			return nil, fmt.Errorf("unsupported redirect status code %d", statusCode)
+		}
+
+		route.Action = &envoy_route_v3.Route_Redirect{Redirect: action}
+	} else {
+		action := &envoy_route_v3.DirectResponseAction{
+			Status: uint32(destination.DirectResponse.StatusCode),
+		}
+		if body := destination.DirectResponse.Body; body != "" {
+			action.Body = &envoy_core_v3.DataSource{
+				Specifier: &envoy_core_v3.DataSource_InlineString{InlineString: body},
+			}
+		}
+
+		route.Action = &envoy_route_v3.Route_DirectResponse{DirectResponse: action}
+	}
+
+	if err := injectHeaderManipToRoute(destination, route); err != nil {
+		return nil, fmt.Errorf("failed to apply header manipulation configuration to route: %v", err)
+	}
+
+	return route, nil
+}
+
 func getRetryPolicyForDestination(destination *structs.ServiceRouteDestination) *envoy_route_v3.RetryPolicy {
 	retryPolicy := &envoy_route_v3.RetryPolicy{}
 	if destination.NumRetries > 0 {
@@ -876,66 +1038,7 @@ func makeRouteMatchForDiscoveryRoute(discoveryRoute *structs.DiscoveryRoute) *en
 	}
 
 	if len(match.HTTP.Header) > 0 {
-		em.Headers = make([]*envoy_route_v3.HeaderMatcher, 0, len(match.HTTP.Header))
-		for _, hdr := range match.HTTP.Header {
-			eh := &envoy_route_v3.HeaderMatcher{
-				Name: hdr.Name,
-			}
-
-			switch {
-			case hdr.Exact != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{
-							Exact: hdr.Exact,
-						},
-						IgnoreCase: false,
-					},
-				}
-			case hdr.Regex != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_SafeRegex{
-							SafeRegex: response.MakeEnvoyRegexMatch(hdr.Regex),
-						},
-						IgnoreCase: false,
-					},
-				}
-
-			case hdr.Prefix != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_Prefix{
-							Prefix: hdr.Prefix,
-						},
-						IgnoreCase: false,
-					},
-				}
-
-			case hdr.Suffix != "":
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
-					StringMatch: &envoy_matcher_v3.StringMatcher{
-						MatchPattern: &envoy_matcher_v3.StringMatcher_Suffix{
-							Suffix: hdr.Suffix,
-						},
-						IgnoreCase: false,
-					},
-				}
-
-			case hdr.Present:
-				eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_PresentMatch{
-					PresentMatch: true,
-				}
-			default:
-				continue // skip this impossible situation
-			}
-
-			if hdr.Invert {
-				eh.InvertMatch = true
-			}
-
-			em.Headers = append(em.Headers, eh)
-		}
+		em.Headers = makeHeaderMatchers(match.HTTP.Header)
 	}
 
 	if len(match.HTTP.Methods) > 0 {
@@ -991,9 +1094,79 @@ func makeRouteMatchForDiscoveryRoute(discoveryRoute *structs.DiscoveryRoute) *en
 		}
 	}
 
+	if match.HTTP.JWT != nil {
+		em.DynamicMetadata = makeJWTClaimMetadataMatchers(match.HTTP.JWT)
+	}
+
 	return em
 }
 
+// makeHeaderMatchers converts discovery chain header matches into Envoy
+// header matchers.
+func makeHeaderMatchers(headers []structs.ServiceRouteHTTPMatchHeader) []*envoy_route_v3.HeaderMatcher {
+	matchers := make([]*envoy_route_v3.HeaderMatcher, 0, len(headers))
+	for _, hdr := range headers {
+		eh := &envoy_route_v3.HeaderMatcher{
+			Name: hdr.Name,
+		}
+
+		switch {
+		case hdr.Exact != "":
+			eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_matcher_v3.StringMatcher_Exact{
+						Exact: hdr.Exact,
+					},
+					IgnoreCase: false,
+				},
+			}
+		case hdr.Regex != "":
+			eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_matcher_v3.StringMatcher_SafeRegex{
+						SafeRegex: response.MakeEnvoyRegexMatch(hdr.Regex),
+					},
+					IgnoreCase: false,
+				},
+			}
+
+		case hdr.Prefix != "":
+			eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_matcher_v3.StringMatcher_Prefix{
+						Prefix: hdr.Prefix,
+					},
+					IgnoreCase: false,
+				},
+			}
+
+		case hdr.Suffix != "":
+			eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_StringMatch{
+				StringMatch: &envoy_matcher_v3.StringMatcher{
+					MatchPattern: &envoy_matcher_v3.StringMatcher_Suffix{
+						Suffix: hdr.Suffix,
+					},
+					IgnoreCase: false,
+				},
+			}
+
+		case hdr.Present:
+			eh.HeaderMatchSpecifier = &envoy_route_v3.HeaderMatcher_PresentMatch{
+				PresentMatch: true,
+			}
+		default:
+			continue // skip this impossible situation
+		}
+
+		if hdr.Invert {
+			eh.InvertMatch = true
+		}
+
+		matchers = append(matchers, eh)
+	}
+	return matchers
+}
+
 func makeDefaultRouteMatch() *envoy_route_v3.RouteMatch {
 	return &envoy_route_v3.RouteMatch{
 		PathSpecifier: &envoy_route_v3.RouteMatch_Prefix{
@@ -1036,6 +1209,7 @@ func (s *ResourceGenerator) makeRouteActionForSplitter(
 	chain *structs.CompiledDiscoveryChain,
 	forMeshGateway bool,
 ) (*envoy_route_v3.Route_Route, error) {
+	var mirrors []*envoy_route_v3.RouteAction_RequestMirrorPolicy
 	clusters := make([]*envoy_route_v3.WeightedCluster_ClusterWeight, 0, len(splits))
 	for _, split := range splits {
 		nextNode := chain.Nodes[split.NextNode]
@@ -1062,6 +1236,11 @@ func (s *ResourceGenerator) makeRouteActionForSplitter(
 		}
 
 		clusters = append(clusters, cw)
+
+		// Envoy only supports mirroring on the route action rather than per
+		// weighted cluster, so a split's mirrors apply to the same share of
+		// the route's requests as the split's weight.
+		mirrors = append(mirrors, s.makeRequestMirrorPolicies(upstreamsSnapshot, split.Mirrors, split.Weight, chain, forMeshGateway)...)
 	}
 
 	if len(clusters) <= 0 {
@@ -1075,10 +1254,53 @@ func (s *ResourceGenerator) makeRouteActionForSplitter(
 					Clusters: clusters,
 				},
 			},
+			RequestMirrorPolicies: mirrors,
 		},
 	}, nil
 }
 
+// makeRequestMirrorPolicies converts compiled mirrors into Envoy request
+// mirror policies. Each mirror's percentage is scaled by weight, the portion
+// of the route's requests that the mirrors apply to.
+func (s *ResourceGenerator) makeRequestMirrorPolicies(
+	upstreamsSnapshot *proxycfg.ConfigSnapshotUpstreams,
+	mirrors []*structs.DiscoveryMirror,
+	weight float32,
+	chain *structs.CompiledDiscoveryChain,
+	forMeshGateway bool,
+) []*envoy_route_v3.RouteAction_RequestMirrorPolicy {
+	var policies []*envoy_route_v3.RouteAction_RequestMirrorPolicy
+	for _, mirror := range mirrors {
+		nextNode := chain.Nodes[mirror.NextNode]
+		if nextNode == nil || nextNode.Type != structs.DiscoveryGraphNodeTypeResolver {
+			continue
+		}
+
+		clusterName := s.getTargetClusterName(upstreamsSnapshot, chain, nextNode.Resolver.Target, forMeshGateway)
+		if clusterName == "" {
+			continue
+		}
+
+		policy := &envoy_route_v3.RouteAction_RequestMirrorPolicy{
+			Cluster: clusterName,
+		}
+
+		// The smallest representable percentage is 1/10000 or .01% so we
+		// express it in ten thousandths to avoid losing precision.
+		numerator := uint32(math.Round(float64(mirror.Percent * weight)))
+		if numerator < 100*100 {
+			policy.RuntimeFraction = &envoy_core_v3.RuntimeFractionalPercent{
+				DefaultValue: &envoy_type_v3.FractionalPercent{
+					Numerator:   numerator,
+					Denominator: envoy_type_v3.FractionalPercent_TEN_THOUSAND,
+				},
+			}
+		}
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
 func injectLBToRouteAction(lb *structs.LoadBalancer, action *envoy_route_v3.RouteAction) error {
 	if lb == nil || !lb.IsHashBased() {
 		return nil
@@ -1181,6 +1403,48 @@ func injectHeaderManipToRoute(dest *structs.ServiceRouteDestination, r *envoy_ro
 	return nil
 }
 
+// injectIngressServiceFiltersToVirtualHost configures the HTTP filters of an
+// ingress listener for the virtual host of one of its services. Limits set
+// on the service replace those set on the listener.
+func injectIngressServiceFiltersToVirtualHost(lCfg structs.IngressListener, svc *structs.IngressService, uid proxycfg.UpstreamID, vh *envoy_route_v3.VirtualHost) error {
+	filter := make(map[string]*anypb.Any)
+
+	if svc.CORS != nil {
+		cors, err := makeCORSPerRouteConfig(svc.CORS)
+		if err != nil {
+			return err
+		}
+		filter[corsEnvoyFilter] = cors
+	}
+
+	// The listener's token bucket is configured on the filter itself, but the
+	// actions producing the descriptors for its Routes and Descriptors must
+	// be attached to each virtual host.
+	if svc.RateLimit != nil {
+		rateLimit, err := makeLocalRateLimitPerRouteConfig(svc.RateLimit, makeStatPrefix("ingress_upstream_", uid.EnvoyID()))
+		if err != nil {
+			return err
+		}
+		filter[localRateLimitEnvoyFilter] = rateLimit
+		vh.RateLimits = makeLocalRateLimitActions(svc.RateLimit)
+	} else {
+		vh.RateLimits = makeLocalRateLimitActions(lCfg.RateLimit)
+	}
+
+	if svc.MaxRequestBytes != 0 {
+		buffer, err := makeBufferPerRouteConfig(svc.MaxRequestBytes)
+		if err != nil {
+			return err
+		}
+		filter[bufferEnvoyFilter] = buffer
+	}
+
+	if len(filter) > 0 {
+		vh.TypedPerFilterConfig = filter
+	}
+	return nil
+}
+
 func injectHeaderManipToVirtualHost(dest *structs.IngressService, vh *envoy_route_v3.VirtualHost) error {
 	if !dest.RequestHeaders.IsZero() {
 		vh.RequestHeadersToAdd = append(
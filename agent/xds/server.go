@@ -12,6 +12,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/armon/go-metrics/prometheus"
 	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoy_health_v3 "github.com/envoyproxy/go-control-plane/envoy/service/health/v3"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -70,9 +71,19 @@ const (
 	// services named "local_agent" in the future.
 	LocalAgentClusterName = "local_agent"
 
+	// LocalServiceClusterName is the name of the static cluster that holds the
+	// instances of the proxy's own service. Envoy uses it as its local cluster
+	// to compare the zones of the local service with those of its upstreams
+	// when zone-aware routing is enabled.
+	LocalServiceClusterName = "local_service"
+
 	// DefaultAuthCheckFrequency is the default value for
 	// Server.AuthCheckFrequency to use when the zero value is provided.
 	DefaultAuthCheckFrequency = 5 * time.Minute
+
+	// DefaultHealthCheckResyncInterval is the default value for
+	// Server.HealthCheckResyncInterval to use when the zero value is provided.
+	DefaultHealthCheckResyncInterval = 30 * time.Second
 )
 
 // ACLResolverFunc is a shim to resolve ACLs. Since ACL enforcement is so far
@@ -87,6 +98,13 @@ type ProxyWatcher interface {
 	Watch(proxyID structs.ServiceID, nodeName string, token string) (<-chan *proxycfg.ConfigSnapshot, limiter.SessionTerminatedChan, proxycfg.SrcTerminatedChan, context.CancelFunc, error)
 }
 
+// HealthReporter is the interface xds.Server requires to report the results
+// of health checks that Envoy performs on behalf of a proxy to the catalog.
+type HealthReporter interface {
+	UpdateProxyHealthCheck(check *structs.HealthCheck, token string) error
+	RemoveProxyHealthCheck(check *structs.HealthCheck, token string) error
+}
+
 // Server represents a gRPC server that can handle xDS requests from Envoy. All
 // of it's public members must be set before the gRPC server is started.
 //
@@ -99,12 +117,21 @@ type Server struct {
 	ResolveToken ACLResolverFunc
 	CfgFetcher   configfetcher.ConfigFetcher
 
+	// HealthReporter receives the results of the health checks that
+	// terminating gateways perform using the health discovery service.
+	HealthReporter HealthReporter
+
 	// AuthCheckFrequency is how often we should re-check the credentials used
 	// during a long-lived gRPC Stream after it has been initially established.
 	// This is only used during idle periods of stream interactions (i.e. when
 	// there has been no recent DiscoveryRequest).
 	AuthCheckFrequency time.Duration
 
+	// HealthCheckResyncInterval is how often the results of the health checks
+	// that terminating gateways perform are reported again even though their
+	// status did not change.
+	HealthCheckResyncInterval time.Duration
+
 	// ResourceMapMutateFn exclusively exists for testing purposes.
 	ResourceMapMutateFn func(resourceMap *xdscommon.IndexedResources)
 
@@ -149,15 +176,18 @@ func NewServer(
 	proxyWatcher ProxyWatcher,
 	resolveTokenSecret ACLResolverFunc,
 	cfgFetcher configfetcher.ConfigFetcher,
+	healthReporter HealthReporter,
 ) *Server {
 	return &Server{
-		NodeName:           nodeName,
-		Logger:             logger,
-		ProxyWatcher:       proxyWatcher,
-		ResolveToken:       resolveTokenSecret,
-		CfgFetcher:         cfgFetcher,
-		AuthCheckFrequency: DefaultAuthCheckFrequency,
-		activeStreams:      &activeStreamCounters{},
+		NodeName:                  nodeName,
+		Logger:                    logger,
+		ProxyWatcher:              proxyWatcher,
+		ResolveToken:              resolveTokenSecret,
+		CfgFetcher:                cfgFetcher,
+		HealthReporter:            healthReporter,
+		AuthCheckFrequency:        DefaultAuthCheckFrequency,
+		HealthCheckResyncInterval: DefaultHealthCheckResyncInterval,
+		activeStreams:             &activeStreamCounters{},
 	}
 }
 
@@ -173,6 +203,7 @@ func (s *Server) StreamAggregatedResources(stream ADSStream) error {
 // Register the XDS server handlers to the given gRPC server.
 func (s *Server) Register(srv *grpc.Server) {
 	envoy_discovery_v3.RegisterAggregatedDiscoveryServiceServer(srv, s)
+	envoy_health_v3.RegisterHealthDiscoveryServiceServer(srv, s)
 }
 
 func (s *Server) authenticate(ctx context.Context) (acl.Authorizer, error) {
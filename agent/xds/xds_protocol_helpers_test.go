@@ -207,6 +207,7 @@ func newTestServerDeltaScenario(
 		mgr,
 		resolveTokenSecret,
 		nil, /*cfgFetcher ConfigFetcher*/
+		nil, /*healthReporter HealthReporter*/
 	)
 	if authCheckFrequency > 0 {
 		s.AuthCheckFrequency = authCheckFrequency
@@ -218,6 +218,23 @@ type ACLPolicyListEntry struct {
 	Partition string `json:",omitempty"`
 }
 
+// ACLPolicyLintFinding describes a single issue reported when linting the
+// rules of a policy.
+type ACLPolicyLintFinding struct {
+	// Code identifies the kind of finding, for example "redundant-prefix",
+	// "shadowed-exact", "duplicate-rule", "broad-write" or "deprecated-syntax".
+	Code string
+
+	// Resource is the rule block the finding applies to, such as "key_prefix".
+	Resource string
+
+	// Segment is the name or prefix of the offending rule.
+	Segment string
+
+	// Message is a human readable description of the finding.
+	Message string
+}
+
 type ACLRolePolicyLink = ACLLink
 
 // ACLRole represents an ACL Role.
@@ -1131,6 +1148,32 @@ func (a *ACL) PolicyList(q *QueryOptions) ([]*ACLPolicyListEntry, *QueryMeta, er
 	return entries, qm, nil
 }
 
+// PolicyLint parses the given policy rules on the servers and returns any
+// rules that are redundant, unreachable or overly broad. The rules are not
+// persisted. An error is returned if the rules are invalid.
+func (a *ACL) PolicyLint(rules string, q *QueryOptions) ([]*ACLPolicyLintFinding, *QueryMeta, error) {
+	r := a.c.newRequest("POST", "/v1/acl/policy/lint")
+	r.setQueryOptions(q)
+	r.obj = struct{ Rules string }{Rules: rules}
+	rtt, resp, err := a.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var findings []*ACLPolicyLintFinding
+	if err := decodeBody(resp, &findings); err != nil {
+		return nil, nil, err
+	}
+	return findings, qm, nil
+}
+
 // RulesTranslate translates the legacy rule syntax into the current syntax.
 //
 // Deprecated: Support for the legacy syntax translation has been removed.
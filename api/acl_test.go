@@ -149,6 +149,32 @@ func TestAPI_ACLPolicy_CreateUpdate(t *testing.T) {
 	require.Equal(t, updated, updated_read)
 }
 
+func TestAPI_ACLPolicy_Lint(t *testing.T) {
+	t.Parallel()
+	c, s := makeACLClient(t)
+	defer s.Stop()
+
+	acl := c.ACL()
+
+	findings, qm, err := acl.PolicyLint(`node_prefix "" { policy = "read" }`, nil)
+	require.NoError(t, err)
+	require.Empty(t, findings)
+	require.NotEqual(t, 0, qm.RequestTime)
+
+	findings, _, err = acl.PolicyLint(`
+		key_prefix "app/" { policy = "write" }
+		key_prefix "app/config/" { policy = "write" }
+	`, nil)
+	require.NoError(t, err)
+	require.Len(t, findings, 1)
+	require.Equal(t, "redundant-prefix", findings[0].Code)
+	require.Equal(t, "key_prefix", findings[0].Resource)
+	require.Equal(t, "app/config/", findings[0].Segment)
+
+	_, _, err = acl.PolicyLint(`key "foo" { policy = "nope" }`, nil)
+	require.Error(t, err)
+}
+
 func TestAPI_ACLPolicy_List(t *testing.T) {
 	t.Parallel()
 	c, s := makeACLClient(t)
@@ -416,6 +416,38 @@ type SampledValue struct {
 	Labels map[string]string
 }
 
+// MeshGatewayStats is the traffic handled by a local mesh gateway, grouped
+// by the remote datacenter or cluster peer it was sent to.
+type MeshGatewayStats struct {
+	// Service is the ID of the mesh gateway service the stats were read from.
+	Service string
+
+	Datacenters map[string]MeshGatewayTrafficStats
+	Peers       map[string]MeshGatewayTrafficStats
+}
+
+// MeshGatewayTrafficStats are the aggregated Envoy upstream cluster stats for
+// a single remote datacenter or peer.
+type MeshGatewayTrafficStats struct {
+	BytesSent         uint64
+	BytesReceived     uint64
+	ActiveConnections uint64
+	TotalConnections  uint64
+	ConnectionErrors  uint64
+}
+
+// MeshGatewayStatsOptions select the mesh gateway whose stats are returned
+// by Agent.MeshGatewayStats.
+type MeshGatewayStatsOptions struct {
+	// ServiceID is the ID of the local mesh gateway service. It may be left
+	// empty when exactly one mesh gateway is registered with the agent.
+	ServiceID string
+
+	// EnvoyAdminEndpoint is the loopback address of the gateway's Envoy
+	// admin API. Defaults to 127.0.0.1:19000.
+	EnvoyAdminEndpoint string
+}
+
 // AgentAuthorizeParams are the request parameters for authorizing a request.
 type AgentAuthorizeParams struct {
 	Target           string
@@ -427,6 +459,10 @@ type AgentAuthorizeParams struct {
 type AgentAuthorize struct {
 	Authorized bool
 	Reason     string
+
+	// DefaultAllow is true if the default intention policy allows traffic
+	// that matches no intention or, for L7 intentions, no permission.
+	DefaultAllow bool
 }
 
 // ConnectProxyConfig is the response structure for agent-local proxy
@@ -547,6 +583,39 @@ func (a *Agent) Metrics() (*MetricsInfo, error) {
 	return out, nil
 }
 
+// MeshGatewayStats returns the traffic handled by a mesh gateway registered
+// with the local agent, aggregated per remote datacenter and per peer.
+// Requires service:read on the mesh gateway.
+func (a *Agent) MeshGatewayStats(opts *MeshGatewayStatsOptions, q *QueryOptions) (*MeshGatewayStats, *QueryMeta, error) {
+	r := a.c.newRequest("GET", "/v1/agent/mesh-gateway/stats")
+	r.setQueryOptions(q)
+	if opts != nil {
+		if opts.ServiceID != "" {
+			r.params.Set("service-id", opts.ServiceID)
+		}
+		if opts.EnvoyAdminEndpoint != "" {
+			r.params.Set("envoy-admin-endpoint", opts.EnvoyAdminEndpoint)
+		}
+	}
+	rtt, resp, err := a.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out *MeshGatewayStats
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return out, qm, nil
+}
+
 // MetricsStream returns an io.ReadCloser which will emit a stream of metrics
 // until the context is cancelled. The metrics are json encoded.
 // The caller is responsible for closing the returned io.ReadCloser.
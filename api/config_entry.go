@@ -26,6 +26,7 @@ const (
 	ServiceIntentions  string = "service-intentions"
 	MeshConfig         string = "mesh"
 	ExportedServices   string = "exported-services"
+	ImportedServices   string = "imported-services"
 	SamenessGroup      string = "sameness-group"
 	RateLimitIPConfig  string = "control-plane-request-limit"
 
@@ -33,19 +34,24 @@ const (
 	MeshConfigMesh        string = "mesh"
 	APIGateway            string = "api-gateway"
 	TCPRoute              string = "tcp-route"
+	TLSRoute              string = "tls-route"
 	FileSystemCertificate string = "file-system-certificate"
 	InlineCertificate     string = "inline-certificate"
+	ACMECertificate       string = "acme-certificate"
 	HTTPRoute             string = "http-route"
+	GRPCRoute             string = "grpc-route"
 	JWTProvider           string = "jwt-provider"
 )
 
 const (
-	BuiltinAWSLambdaExtension         string = "builtin/aws/lambda"
-	BuiltinExtAuthzExtension          string = "builtin/ext-authz"
-	BuiltinLuaExtension               string = "builtin/lua"
-	BuiltinOTELAccessLoggingExtension string = "builtin/otel-access-logging"
-	BuiltinPropertyOverrideExtension  string = "builtin/property-override"
-	BuiltinWasmExtension              string = "builtin/wasm"
+	BuiltinAWSLambdaExtension          string = "builtin/aws/lambda"
+	BuiltinExtAuthzExtension           string = "builtin/ext-authz"
+	BuiltinGRPCJSONTranscoderExtension string = "builtin/grpc-json-transcoder"
+	BuiltinLuaExtension                string = "builtin/lua"
+	BuiltinOTELAccessLoggingExtension  string = "builtin/otel-access-logging"
+	BuiltinOTELTracingExtension        string = "builtin/otel-tracing"
+	BuiltinPropertyOverrideExtension   string = "builtin/property-override"
+	BuiltinWasmExtension               string = "builtin/wasm"
 	// BuiltinValidateExtension should not be exposed directly or accepted as a valid configured
 	// extension type, as it is only used indirectly via troubleshooting tools. It is included here
 	// for common reference alongside other builtin extensions.
@@ -198,6 +204,10 @@ type AccessLogsConfig struct {
 	// Defining Both is invalid.
 	JSONFormat string `json:",omitempty" alias:"json_format"`
 	TextFormat string `json:",omitempty" alias:"text_format"`
+
+	// IncludeIntentionDenials adds the fields needed to report requests and
+	// connections denied by intentions to the default JSON format.
+	IncludeIntentionDenials bool `json:",omitempty" alias:"include_intention_denials"`
 }
 
 type UpstreamConfiguration struct {
@@ -358,6 +368,37 @@ type InstanceLevelRouteRateLimits struct {
 	RequestsMaxBurst  int `alias:"requests_max_burst"`
 }
 
+// LocalRateLimit is request rate limiting that each proxy enforces on its
+// own. Requests matching one of Routes or Descriptors are limited by it
+// instead of the top-level limit.
+type LocalRateLimit struct {
+	RequestsPerSecond uint32                     `json:",omitempty" alias:"requests_per_second"`
+	RequestsMaxBurst  uint32                     `json:",omitempty" alias:"requests_max_burst"`
+	Routes            []LocalRateLimitRoute      `json:",omitempty"`
+	Descriptors       []LocalRateLimitDescriptor `json:",omitempty"`
+}
+
+// LocalRateLimitRoute is a rate limit applied to requests whose path matches
+// one of PathExact, PathPrefix or PathRegex.
+type LocalRateLimitRoute struct {
+	PathExact  string `json:",omitempty" alias:"path_exact"`
+	PathPrefix string `json:",omitempty" alias:"path_prefix"`
+	PathRegex  string `json:",omitempty" alias:"path_regex"`
+
+	RequestsPerSecond uint32 `json:",omitempty" alias:"requests_per_second"`
+	RequestsMaxBurst  uint32 `json:",omitempty" alias:"requests_max_burst"`
+}
+
+// LocalRateLimitDescriptor is a rate limit applied to requests where the
+// value of Header equals Value.
+type LocalRateLimitDescriptor struct {
+	Header string
+	Value  string
+
+	RequestsPerSecond uint32 `json:",omitempty" alias:"requests_per_second"`
+	RequestsMaxBurst  uint32 `json:",omitempty" alias:"requests_max_burst"`
+}
+
 type ServiceConfigEntry struct {
 	Kind                      string
 	Name                      string
@@ -377,6 +418,8 @@ type ServiceConfigEntry struct {
 	LocalRequestTimeoutMs     int                     `json:",omitempty" alias:"local_request_timeout_ms"`
 	BalanceInboundConnections string                  `json:",omitempty" alias:"balance_inbound_connections"`
 	RateLimits                *RateLimits             `json:",omitempty" alias:"rate_limits"`
+	FaultInjection            *FaultInjection         `json:",omitempty" alias:"fault_injection"`
+	RateLimit                 *LocalRateLimit         `json:",omitempty" alias:"rate_limit"`
 	EnvoyExtensions           []EnvoyExtension        `json:",omitempty" alias:"envoy_extensions"`
 	Meta                      map[string]string       `json:",omitempty"`
 	CreateIndex               uint64
@@ -442,18 +485,26 @@ func makeConfigEntry(kind, name string) (ConfigEntry, error) {
 		return &MeshConfigEntry{}, nil
 	case ExportedServices:
 		return &ExportedServicesConfigEntry{Name: name}, nil
+	case ImportedServices:
+		return &ImportedServicesConfigEntry{Kind: kind, Name: name}, nil
 	case SamenessGroup:
 		return &SamenessGroupConfigEntry{Kind: kind, Name: name}, nil
 	case APIGateway:
 		return &APIGatewayConfigEntry{Kind: kind, Name: name}, nil
 	case TCPRoute:
 		return &TCPRouteConfigEntry{Kind: kind, Name: name}, nil
+	case TLSRoute:
+		return &TLSRouteConfigEntry{Kind: kind, Name: name}, nil
 	case FileSystemCertificate:
 		return &FileSystemCertificateConfigEntry{Kind: kind, Name: name}, nil
 	case InlineCertificate:
 		return &InlineCertificateConfigEntry{Kind: kind, Name: name}, nil
+	case ACMECertificate:
+		return &ACMECertificateConfigEntry{Kind: kind, Name: name}, nil
 	case HTTPRoute:
 		return &HTTPRouteConfigEntry{Kind: kind, Name: name}, nil
+	case GRPCRoute:
+		return &GRPCRouteConfigEntry{Kind: kind, Name: name}, nil
 	case RateLimitIPConfig:
 		return &RateLimitIPConfigEntry{Kind: kind, Name: name}, nil
 	case JWTProvider:
@@ -620,6 +671,31 @@ func (conf *ConfigEntries) CAS(entry ConfigEntry, index uint64, w *WriteOptions)
 	return conf.set(entry, map[string]string{"cas": strconv.FormatUint(index, 10)}, w)
 }
 
+// SetDryRun evaluates the given service-intentions config entry against the
+// catalog and reports which intention decisions would change if it were
+// applied. Nothing is written.
+func (conf *ConfigEntries) SetDryRun(entry ConfigEntry, w *WriteOptions) (*IntentionDryRunResponse, *WriteMeta, error) {
+	r := conf.c.newRequest("PUT", "/v1/config")
+	r.setWriteOptions(w)
+	r.params.Set("dry-run", "")
+	r.obj = entry
+	rtt, resp, err := conf.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	wm := &WriteMeta{RequestTime: rtt}
+	var out IntentionDryRunResponse
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return &out, wm, nil
+}
+
 func (conf *ConfigEntries) set(entry ConfigEntry, params map[string]string, w *WriteOptions) (bool, *WriteMeta, error) {
 	r := conf.c.newRequest("PUT", "/v1/config")
 	r.setWriteOptions(w)
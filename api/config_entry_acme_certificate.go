@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+import "time"
+
+// ACMECertificateConfigEntry manages a certificate that Consul obtains and
+// renews from an ACME certificate authority. The issued certificate is
+// stored in an inline-certificate config entry with the same name.
+type ACMECertificateConfigEntry struct {
+	// Kind of the config entry. This should be set to api.ACMECertificate.
+	Kind string
+
+	// Name is used to match the config entry with the inline-certificate
+	// the issued certificate is stored in.
+	Name string
+
+	// DirectoryURL is the URL of the ACME directory of the certificate
+	// authority. Defaults to the Let's Encrypt production directory.
+	DirectoryURL string `json:",omitempty" alias:"directory_url"`
+
+	// Email is the contact address registered with the ACME account.
+	Email string `json:",omitempty"`
+
+	// Hostnames are the DNS names the certificate is issued for.
+	Hostnames []string
+
+	// RenewBefore is how long before the certificate expires it is renewed.
+	// Defaults to 720h.
+	RenewBefore time.Duration `json:",omitempty" alias:"renew_before"`
+
+	// Challenges are the pending HTTP-01 challenges that gateways respond
+	// to. This is a read-only field managed by Consul.
+	Challenges []ACMEChallenge `json:",omitempty"`
+
+	Meta map[string]string `json:",omitempty"`
+
+	// Status is the asynchronous status of certificate issuance.
+	Status ConfigEntryStatus
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+
+	// Partition is the partition the config entry is associated with.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Namespace is the namespace the config entry is associated with.
+	// Namespacing is a Consul Enterprise feature.
+	Namespace string `json:",omitempty"`
+}
+
+// ACMEChallenge is a pending HTTP-01 challenge served by gateways at
+// /.well-known/acme-challenge/<Token>.
+type ACMEChallenge struct {
+	Token            string
+	KeyAuthorization string
+}
+
+func (a *ACMECertificateConfigEntry) GetKind() string            { return ACMECertificate }
+func (a *ACMECertificateConfigEntry) GetName() string            { return a.Name }
+func (a *ACMECertificateConfigEntry) GetPartition() string       { return a.Partition }
+func (a *ACMECertificateConfigEntry) GetNamespace() string       { return a.Namespace }
+func (a *ACMECertificateConfigEntry) GetMeta() map[string]string { return a.Meta }
+func (a *ACMECertificateConfigEntry) GetCreateIndex() uint64     { return a.CreateIndex }
+func (a *ACMECertificateConfigEntry) GetModifyIndex() uint64     { return a.ModifyIndex }
@@ -49,6 +49,10 @@ type ServiceRouteHTTPMatch struct {
 	Header     []ServiceRouteHTTPMatchHeader     `json:",omitempty"`
 	QueryParam []ServiceRouteHTTPMatchQueryParam `json:",omitempty" alias:"query_param"`
 	Methods    []string                          `json:",omitempty"`
+
+	// JWT matches on the claims of a JSON Web Token verified by a
+	// jwt-provider config entry.
+	JWT *ServiceRouteHTTPMatchJWT `json:",omitempty"`
 }
 
 type ServiceRouteHTTPMatchHeader struct {
@@ -68,6 +72,26 @@ type ServiceRouteHTTPMatchQueryParam struct {
 	Regex   string `json:",omitempty"`
 }
 
+// ServiceRouteHTTPMatchJWT matches requests carrying a JSON Web Token that
+// was verified by the given provider and contains all of the given claims.
+type ServiceRouteHTTPMatchJWT struct {
+	// Provider is the name of the jwt-provider config entry used to verify
+	// the token.
+	Provider string
+
+	// Claims is the list of claims that must all be present in the token.
+	Claims []ServiceRouteHTTPMatchJWTClaim `json:",omitempty"`
+}
+
+// ServiceRouteHTTPMatchJWTClaim matches a single claim of a verified token.
+type ServiceRouteHTTPMatchJWTClaim struct {
+	// Path is the path to the claim in the token JSON.
+	Path []string
+
+	// Value is the expected value of the claim at the given path.
+	Value string
+}
+
 type ServiceRouteDestination struct {
 	Service               string               `json:",omitempty"`
 	ServiceSubset         string               `json:",omitempty" alias:"service_subset"`
@@ -82,6 +106,127 @@ type ServiceRouteDestination struct {
 	RetryOn               []string             `json:",omitempty" alias:"retry_on"`
 	RequestHeaders        *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders       *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+	Mirror                []ServiceRouteMirror `json:",omitempty"`
+	FaultInjection        *FaultInjection      `json:",omitempty" alias:"fault_injection"`
+	RateLimit             *LocalRateLimit      `json:",omitempty" alias:"rate_limit"`
+	CORS                  *CORSPolicy          `json:",omitempty"`
+}
+
+// ServiceRouteMirror identifies a target that receives a copy of the requests
+// sent to a route or split. Responses from the mirrored target are discarded.
+type ServiceRouteMirror struct {
+	Service       string
+	ServiceSubset string  `json:",omitempty" alias:"service_subset"`
+	Namespace     string  `json:",omitempty"`
+	Partition     string  `json:",omitempty"`
+	Peer          string  `json:",omitempty"`
+	Percent       float32 `json:",omitempty"`
+}
+
+// FaultInjection configures delays and aborts injected into HTTP requests
+// for chaos testing. Faults only apply to requests matching all of Headers.
+type FaultInjection struct {
+	Delay   *FaultInjectionDelay          `json:",omitempty"`
+	Abort   *FaultInjectionAbort          `json:",omitempty"`
+	Headers []ServiceRouteHTTPMatchHeader `json:",omitempty"`
+}
+
+// FaultInjectionDelay delays a percentage of requests by a fixed duration.
+type FaultInjectionDelay struct {
+	FixedDelay time.Duration `json:",omitempty" alias:"fixed_delay"`
+	Percent    float32       `json:",omitempty"`
+}
+
+// FaultInjectionAbort terminates a percentage of requests with an HTTP or
+// gRPC status.
+type FaultInjectionAbort struct {
+	HTTPStatus uint32  `json:",omitempty" alias:"http_status"`
+	GRPCStatus uint32  `json:",omitempty" alias:"grpc_status"`
+	Percent    float32 `json:",omitempty"`
+}
+
+func (d *FaultInjectionDelay) MarshalJSON() ([]byte, error) {
+	type Alias FaultInjectionDelay
+	exported := &struct {
+		FixedDelay string `json:",omitempty"`
+		*Alias
+	}{
+		FixedDelay: d.FixedDelay.String(),
+		Alias:      (*Alias)(d),
+	}
+	if d.FixedDelay == 0 {
+		exported.FixedDelay = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (d *FaultInjectionDelay) UnmarshalJSON(data []byte) error {
+	type Alias FaultInjectionDelay
+	aux := &struct {
+		FixedDelay string
+		*Alias
+	}{
+		Alias: (*Alias)(d),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.FixedDelay != "" {
+		if d.FixedDelay, err = time.ParseDuration(aux.FixedDelay); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CORSPolicy configures how cross-origin resource sharing requests are
+// handled, including responding to preflight requests.
+type CORSPolicy struct {
+	AllowOrigins       []string      `json:",omitempty" alias:"allow_origins"`
+	AllowOriginRegexes []string      `json:",omitempty" alias:"allow_origin_regexes"`
+	AllowMethods       []string      `json:",omitempty" alias:"allow_methods"`
+	AllowHeaders       []string      `json:",omitempty" alias:"allow_headers"`
+	ExposeHeaders      []string      `json:",omitempty" alias:"expose_headers"`
+	MaxAge             time.Duration `json:",omitempty" alias:"max_age"`
+	AllowCredentials   bool          `json:",omitempty" alias:"allow_credentials"`
+}
+
+func (c *CORSPolicy) MarshalJSON() ([]byte, error) {
+	type Alias CORSPolicy
+	exported := &struct {
+		MaxAge string `json:",omitempty"`
+		*Alias
+	}{
+		MaxAge: c.MaxAge.String(),
+		Alias:  (*Alias)(c),
+	}
+	if c.MaxAge == 0 {
+		exported.MaxAge = ""
+	}
+
+	return json.Marshal(exported)
+}
+
+func (c *CORSPolicy) UnmarshalJSON(data []byte) error {
+	type Alias CORSPolicy
+	aux := &struct {
+		MaxAge string
+		*Alias
+	}{
+		Alias: (*Alias)(c),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	var err error
+	if aux.MaxAge != "" {
+		if c.MaxAge, err = time.ParseDuration(aux.MaxAge); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (e *ServiceRouteDestination) MarshalJSON() ([]byte, error) {
@@ -160,6 +305,7 @@ type ServiceSplit struct {
 	Partition       string               `json:",omitempty"`
 	RequestHeaders  *HTTPHeaderModifiers `json:",omitempty" alias:"request_headers"`
 	ResponseHeaders *HTTPHeaderModifiers `json:",omitempty" alias:"response_headers"`
+	Mirror          []ServiceRouteMirror `json:",omitempty"`
 }
 
 type ServiceResolverConfigEntry struct {
@@ -183,6 +329,14 @@ type ServiceResolverConfigEntry struct {
 	// issuing requests to this upstream service.
 	LoadBalancer *LoadBalancer `json:",omitempty" alias:"load_balancer"`
 
+	// OutlierDetection configures passive health checking of the instances of
+	// this service by the proxies issuing requests to it.
+	OutlierDetection *PassiveHealthCheck `json:",omitempty" alias:"outlier_detection"`
+
+	// HealthChecks configures active health checks that the proxies issuing
+	// requests to this service send to each of its instances.
+	HealthChecks []ActiveHealthCheck `json:",omitempty" alias:"health_checks"`
+
 	Meta        map[string]string `json:",omitempty"`
 	CreateIndex uint64
 	ModifyIndex uint64
@@ -286,11 +440,50 @@ type ServiceResolverFailoverPolicy struct {
 	Regions []string `json:",omitempty"`
 }
 
+// ActiveHealthCheck configures a health check that proxies actively send to
+// each instance of an upstream service. Exactly one of HTTP or GRPC must be set.
+type ActiveHealthCheck struct {
+	// Interval is the time between health checks. Defaults to 10s.
+	Interval time.Duration `json:",omitempty"`
+
+	// Timeout is the time to wait for a health check response. Defaults to 5s.
+	Timeout time.Duration `json:",omitempty"`
+
+	// UnhealthyThreshold is the number of consecutive failed health checks
+	// before an instance is considered unhealthy. Defaults to 3.
+	UnhealthyThreshold uint32 `json:",omitempty" alias:"unhealthy_threshold"`
+
+	// HealthyThreshold is the number of consecutive successful health checks
+	// before an unhealthy instance is considered healthy again. Defaults to 2.
+	HealthyThreshold uint32 `json:",omitempty" alias:"healthy_threshold"`
+
+	// HTTP configures an HTTP health check.
+	HTTP *ActiveHealthCheckHTTP `json:",omitempty"`
+
+	// GRPC configures a gRPC health check.
+	GRPC *ActiveHealthCheckGRPC `json:",omitempty"`
+}
+
+type ActiveHealthCheckHTTP struct {
+	Path string
+	Host string `json:",omitempty"`
+}
+
+type ActiveHealthCheckGRPC struct {
+	ServiceName string `json:",omitempty" alias:"service_name"`
+	Authority   string `json:",omitempty"`
+}
+
 type ServiceResolverPrioritizeByLocality struct {
 	// Mode specifies the type of prioritization that will be performed
 	// when selecting nodes in the local partition.
-	// Valid values are: "" (default "none"), "none", and "failover".
+	// Valid values are: "" (default "none"), "none", "failover", and "zone-aware".
 	Mode string `json:",omitempty"`
+
+	// MinClusterSize is the minimum number of instances the service must
+	// have for zone-aware routing to be used. Only used by the "zone-aware"
+	// mode and defaults to Envoy's default of 6 when unset.
+	MinClusterSize uint32 `json:",omitempty" alias:"min_cluster_size"`
 }
 
 // LoadBalancer determines the load balancing policy and configuration for services
@@ -3,6 +3,8 @@
 
 package api
 
+import "time"
+
 // IngressGatewayConfigEntry manages the configuration for an ingress service
 // with the given name.
 type IngressGatewayConfigEntry struct {
@@ -98,6 +100,18 @@ type IngressListener struct {
 
 	// TLS allows specifying some TLS configuration per listener.
 	TLS *GatewayTLSConfig `json:",omitempty"`
+
+	// RateLimit limits the rate of requests accepted by the listener. Services
+	// that configure their own RateLimit are limited by it instead.
+	RateLimit *LocalRateLimit `json:",omitempty" alias:"rate_limit"`
+
+	// MaxRequestBytes is the maximum size of a request body in bytes. Services
+	// that configure their own MaxRequestBytes are limited by it instead.
+	MaxRequestBytes uint32 `json:",omitempty" alias:"max_request_bytes"`
+
+	// MaxRequestHeadersKB is the maximum size of the request headers in
+	// kilobytes.
+	MaxRequestHeadersKB uint32 `json:",omitempty" alias:"max_request_headers_kb"`
 }
 
 // IngressService manages configuration for services that are exposed to
@@ -148,6 +162,18 @@ type IngressService struct {
 	// PassiveHealthCheck configuration determines how upstream proxy instances will
 	// be monitored for removal from the load balancing pool.
 	PassiveHealthCheck *PassiveHealthCheck `json:",omitempty" alias:"passive_health_check"`
+
+	// CORS configures the cross-origin resource sharing policy for requests
+	// to this service.
+	CORS *CORSPolicy `json:",omitempty"`
+
+	// RateLimit limits the rate of requests to this service, replacing the
+	// listener's RateLimit.
+	RateLimit *LocalRateLimit `json:",omitempty" alias:"rate_limit"`
+
+	// MaxRequestBytes is the maximum size of a request body to this service
+	// in bytes, replacing the listener's MaxRequestBytes.
+	MaxRequestBytes uint32 `json:",omitempty" alias:"max_request_bytes"`
 }
 
 func (i *IngressGatewayConfigEntry) GetKind() string            { return i.Kind }
@@ -218,6 +244,34 @@ type LinkedService struct {
 
 	// SNI is the optional name to specify during the TLS handshake with a linked service.
 	SNI string `json:",omitempty"`
+
+	// HealthCheck is the optional active health check that the gateway performs
+	// against each instance of the linked service.
+	HealthCheck *LinkedServiceHealthCheck `json:",omitempty" alias:"health_check"`
+}
+
+// LinkedServiceHealthCheck configures a health check that a terminating
+// gateway actively sends to each instance of a linked service.
+type LinkedServiceHealthCheck struct {
+	// Type is the kind of health check: "tcp", "http", or "tls".
+	Type string
+
+	// Path is the request path of an http check. Defaults to "/".
+	Path string `json:",omitempty"`
+
+	// Interval is the time between health checks. Defaults to 10s.
+	Interval time.Duration `json:",omitempty"`
+
+	// Timeout is the time to wait for a health check response. Defaults to 5s.
+	Timeout time.Duration `json:",omitempty"`
+
+	// UnhealthyThreshold is the number of consecutive failed health checks
+	// before an instance is considered unhealthy. Defaults to 3.
+	UnhealthyThreshold uint32 `json:",omitempty" alias:"unhealthy_threshold"`
+
+	// HealthyThreshold is the number of consecutive successful health checks
+	// before an unhealthy instance is considered healthy again. Defaults to 2.
+	HealthyThreshold uint32 `json:",omitempty" alias:"healthy_threshold"`
 }
 
 func (g *TerminatingGatewayConfigEntry) GetKind() string            { return g.Kind }
@@ -283,7 +337,7 @@ type APIGatewayListener struct {
 	// Port is the port at which this listener should bind.
 	Port int
 	// Protocol is the protocol that a listener should use, it must
-	// either be "http" or "tcp"
+	// be one of "http", "http2", "grpc", or "tcp"
 	Protocol string
 	// TLS is the TLS settings for the listener.
 	TLS APIGatewayTLSConfiguration
@@ -296,6 +350,10 @@ type APIGatewayListener struct {
 // APIGatewayTLSConfiguration specifies the configuration of a listener’s
 // TLS settings.
 type APIGatewayTLSConfiguration struct {
+	// Mode is whether the listener terminates TLS or passes it through
+	// to the service selected by a TLSRoute. Must be "terminate" or
+	// "passthrough", defaults to "terminate".
+	Mode string `json:",omitempty"`
 	// Certificates is a set of references to certificates
 	// that a gateway listener uses for TLS termination.
 	Certificates []ResourceReference
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package api
+
+// ImportedServicesConfigEntry controls which of the services exported by a
+// cluster peer are imported into the local catalog. Without this entry every
+// service exported to the local cluster is imported.
+type ImportedServicesConfigEntry struct {
+	Kind string
+
+	// Name is the local name of the peer the filters apply to.
+	Name string
+
+	// Partition is the partition where the ImportedServicesConfigEntry is stored.
+	// Partitioning is a Consul Enterprise feature.
+	Partition string `json:",omitempty"`
+
+	// Allow is a list of filters of which at least one must match a service
+	// instance for it to be imported. When empty, all instances that are not
+	// denied are imported.
+	Allow []ImportedServiceFilter `json:",omitempty"`
+
+	// Deny is a list of filters matching service instances that must not be
+	// imported. Deny filters take precedence over Allow filters.
+	Deny []ImportedServiceFilter `json:",omitempty"`
+
+	Meta map[string]string `json:",omitempty"`
+
+	// CreateIndex is the Raft index this entry was created at. This is a
+	// read-only field.
+	CreateIndex uint64
+
+	// ModifyIndex is used for the Check-And-Set operations and can also be fed
+	// back into the WaitIndex of the QueryOptions in order to perform blocking
+	// queries.
+	ModifyIndex uint64
+}
+
+// ImportedServiceFilter matches service instances imported from a peer. All
+// of the specified fields must match for the filter to apply.
+type ImportedServiceFilter struct {
+	// Name is the name of the service, or the wildcard "*" to match all
+	// services.
+	Name string
+
+	// Namespace is the namespace of the service in the exporting cluster, or
+	// the wildcard "*" to match all namespaces.
+	Namespace string `json:",omitempty"`
+
+	// Tags is a list of tags that the service instance must all have.
+	Tags []string `json:",omitempty"`
+
+	// ServiceMeta is a set of key/value pairs that the service instance
+	// metadata must all contain.
+	ServiceMeta map[string]string `json:",omitempty" alias:"service_meta"`
+}
+
+func (e *ImportedServicesConfigEntry) GetKind() string            { return ImportedServices }
+func (e *ImportedServicesConfigEntry) GetName() string            { return e.Name }
+func (e *ImportedServicesConfigEntry) GetPartition() string       { return e.Partition }
+func (e *ImportedServicesConfigEntry) GetNamespace() string       { return "" }
+func (e *ImportedServicesConfigEntry) GetMeta() map[string]string { return e.Meta }
+func (e *ImportedServicesConfigEntry) GetCreateIndex() uint64     { return e.CreateIndex }
+func (e *ImportedServicesConfigEntry) GetModifyIndex() uint64     { return e.ModifyIndex }
@@ -98,3 +98,38 @@ type IntentionJWTClaimVerification struct {
 	//   that this value matches.
 	Value string `json:",omitempty"`
 }
+
+// IntentionDryRunResponse is the result of evaluating a service-intentions
+// config entry without applying it.
+type IntentionDryRunResponse struct {
+	// Evaluated is the number of source and destination pairs that were
+	// considered.
+	Evaluated int
+
+	// Changes lists the pairs whose decision would change.
+	Changes []IntentionDecisionChange
+}
+
+// IntentionDecisionChange is a single source and destination pair whose
+// intention decision would change.
+type IntentionDecisionChange struct {
+	SourceName           string
+	SourceNS             string `json:",omitempty"`
+	SourcePartition      string `json:",omitempty"`
+	SourcePeer           string `json:",omitempty"`
+	DestinationName      string
+	DestinationNS        string `json:",omitempty"`
+	DestinationPartition string `json:",omitempty"`
+
+	Before IntentionDecision
+	After  IntentionDecision
+}
+
+// IntentionDecision summarizes the intention decision between two services.
+type IntentionDecision struct {
+	Allowed        bool
+	HasPermissions bool
+	ExternalSource string
+	HasExact       bool
+	DefaultAllow   bool
+}
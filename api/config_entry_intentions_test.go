@@ -137,3 +137,39 @@ func TestAPI_ConfigEntries_ServiceIntentions(t *testing.T) {
 	_, _, err = config_entries.Get(ServiceIntentions, "foo", nil)
 	require.Error(t, err)
 }
+
+func TestAPI_ConfigEntries_ServiceIntentions_DryRun(t *testing.T) {
+	t.Parallel()
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForServiceIntentions(t)
+
+	agent := c.Agent()
+	for _, name := range []string{"web", "db"} {
+		require.NoError(t, agent.ServiceRegister(&AgentServiceRegistration{Name: name}))
+	}
+
+	config_entries := c.ConfigEntries()
+
+	out, _, err := config_entries.SetDryRun(&ServiceIntentionsConfigEntry{
+		Kind: ServiceIntentions,
+		Name: "db",
+		Sources: []*SourceIntention{
+			{
+				Name:   "web",
+				Action: IntentionActionDeny,
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, out.Changes, 1)
+	require.Equal(t, "web", out.Changes[0].SourceName)
+	require.Equal(t, "db", out.Changes[0].DestinationName)
+	require.True(t, out.Changes[0].Before.Allowed)
+	require.False(t, out.Changes[0].After.Allowed)
+
+	// Nothing should have been written.
+	_, _, err = config_entries.Get(ServiceIntentions, "db", nil)
+	require.Error(t, err)
+}
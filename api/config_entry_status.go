@@ -348,6 +348,81 @@ func ValidateRouteConditionReason(name RouteConditionType, status ConditionStatu
 	return nil
 }
 
+// ACMECertificateConditionType is a type of condition for an acme-certificate.
+type ACMECertificateConditionType string
+
+// ACMECertificateConditionReason is a reason for an acme-certificate condition.
+type ACMECertificateConditionReason string
+
+const (
+	// This condition indicates whether a certificate has been issued for the
+	// acme-certificate and stored in its inline-certificate.
+	//
+	// Possible reasons for this condition to be true are:
+	//
+	// * "Issued"
+	//
+	// Possible reasons for this condition to be false are:
+	//
+	// * "Pending"
+	// * "Failed"
+	// * "CertificateConflict"
+	ACMECertificateConditionIssued ACMECertificateConditionType = "Issued"
+
+	// This reason is used with the "Issued" condition when a valid
+	// certificate has been issued.
+	ACMECertificateReasonIssued ACMECertificateConditionReason = "Issued"
+
+	// This reason is used with the "Issued" condition while an order with
+	// the certificate authority is in progress.
+	ACMECertificateReasonPending ACMECertificateConditionReason = "Pending"
+
+	// This reason is used with the "Issued" condition when the certificate
+	// authority failed or refused to issue the certificate.
+	ACMECertificateReasonFailed ACMECertificateConditionReason = "Failed"
+
+	// This reason is used with the "Issued" condition when an
+	// inline-certificate with the same name exists that is not managed by
+	// the acme-certificate.
+	ACMECertificateReasonCertificateConflict ACMECertificateConditionReason = "CertificateConflict"
+)
+
+var validACMECertificateConditionReasonsMapping = map[ACMECertificateConditionType]map[ConditionStatus][]ACMECertificateConditionReason{
+	ACMECertificateConditionIssued: {
+		ConditionStatusTrue: {
+			ACMECertificateReasonIssued,
+		},
+		ConditionStatusFalse: {
+			ACMECertificateReasonPending,
+			ACMECertificateReasonFailed,
+			ACMECertificateReasonCertificateConflict,
+		},
+		ConditionStatusUnknown: {},
+	},
+}
+
+func ValidateACMECertificateConditionReason(name ACMECertificateConditionType, status ConditionStatus, reason ACMECertificateConditionReason) error {
+	if err := checkConditionStatus(status); err != nil {
+		return err
+	}
+
+	reasons, ok := validACMECertificateConditionReasonsMapping[name]
+	if !ok {
+		return fmt.Errorf("unrecognized ACMECertificateConditionType %s", name)
+	}
+
+	reasonsForStatus, ok := reasons[status]
+	if !ok {
+		return fmt.Errorf("unrecognized ConditionStatus %s", name)
+	}
+
+	if !slices.Contains(reasonsForStatus, reason) {
+		return fmt.Errorf("acme-certificate condition reason %s not allowed for acme-certificate condition type %s with status %s", reason, name, status)
+	}
+
+	return nil
+}
+
 func checkConditionStatus(status ConditionStatus) error {
 	switch status {
 	case ConditionStatusTrue, ConditionStatusFalse, ConditionStatusUnknown:
@@ -179,6 +179,53 @@ type IntentionCheck struct {
 	SourceType IntentionSourceType
 }
 
+// IntentionDenial is a single request or connection that a proxy denied
+// based on intentions.
+type IntentionDenial struct {
+	SourceName      string
+	SourceNS        string `json:",omitempty"`
+	SourcePartition string `json:",omitempty"`
+	SourcePeer      string `json:",omitempty"`
+
+	// SourceURI is the SPIFFE ID presented by the downstream. It is used
+	// when SourceName is empty.
+	SourceURI string `json:",omitempty"`
+
+	DestinationName      string
+	DestinationNS        string `json:",omitempty"`
+	DestinationPartition string `json:",omitempty"`
+
+	// PolicyID is the shadow_effective_policy_id Envoy recorded in the RBAC
+	// filter's dynamic metadata for the request.
+	PolicyID string `json:",omitempty"`
+
+	// Timestamp is when the proxy denied the request. The time it was
+	// reported is used if it is zero.
+	Timestamp time.Time
+}
+
+// IntentionDenialSummary aggregates the denials between a source and a
+// destination that were caused by the same intention.
+type IntentionDenialSummary struct {
+	SourceName      string
+	SourceNS        string `json:",omitempty"`
+	SourcePartition string `json:",omitempty"`
+	SourcePeer      string `json:",omitempty"`
+
+	DestinationName      string
+	DestinationNS        string `json:",omitempty"`
+	DestinationPartition string `json:",omitempty"`
+
+	// Intention is the source of the deny intention that matched, or empty
+	// if the default intention policy denied the requests.
+	Intention     string `json:",omitempty"`
+	IntentionPeer string `json:",omitempty"`
+
+	Count     uint64
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
 // Intentions returns the list of intentions.
 func (h *Connect) Intentions(q *QueryOptions) ([]*Intention, *QueryMeta, error) {
 	r := h.c.newRequest("GET", "/v1/connect/intentions")
@@ -459,3 +506,47 @@ func (c *Connect) IntentionUpdate(ixn *Intention, q *WriteOptions) (*WriteMeta,
 	wm.RequestTime = rtt
 	return wm, nil
 }
+
+// IntentionDenials returns the intention denials aggregated by the leader,
+// most recently seen first.
+func (h *Connect) IntentionDenials(q *QueryOptions) ([]*IntentionDenialSummary, *QueryMeta, error) {
+	r := h.c.newRequest("GET", "/v1/connect/intentions/denials")
+	r.setQueryOptions(q)
+	rtt, resp, err := h.c.doRequest(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	var out []*IntentionDenialSummary
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+	return out, qm, nil
+}
+
+// IntentionReportDenials reports denials observed by a proxy.
+func (h *Connect) IntentionReportDenials(denials []*IntentionDenial, q *WriteOptions) (*WriteMeta, error) {
+	r := h.c.newRequest("PUT", "/v1/connect/intentions/denials")
+	r.setWriteOptions(q)
+	r.obj = denials
+	rtt, resp, err := h.c.doRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, err
+	}
+
+	wm := &WriteMeta{}
+	wm.RequestTime = rtt
+	return wm, nil
+}
@@ -196,3 +196,36 @@ func testIntention() *Intention {
 		SourceType:      IntentionSourceConsul,
 	}
 }
+
+func TestAPI_ConnectIntentionDenials(t *testing.T) {
+	t.Parallel()
+
+	c, s := makeClient(t)
+	defer s.Stop()
+
+	s.WaitForServiceIntentions(t)
+
+	connect := c.Connect()
+
+	_, err := connect.IntentionReportDenials([]*IntentionDenial{
+		{
+			SourceName:      "web",
+			DestinationName: "db",
+			PolicyID:        "consul-intentions-deny:web",
+		},
+		{
+			SourceName:      "web",
+			DestinationName: "db",
+			PolicyID:        "consul-intentions-deny:web",
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	denials, _, err := connect.IntentionDenials(nil)
+	require.NoError(t, err)
+	require.Len(t, denials, 1)
+	require.Equal(t, "web", denials[0].SourceName)
+	require.Equal(t, "db", denials[0].DestinationName)
+	require.Equal(t, "web", denials[0].Intention)
+	require.Equal(t, uint64(2), denials[0].Count)
+}
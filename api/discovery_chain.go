@@ -36,6 +36,9 @@ func (d *DiscoveryChain) Get(name string, opts *DiscoveryChainOptions, q *QueryO
 		if opts.EvaluateInDatacenter != "" {
 			r.params.Set("compile-dc", opts.EvaluateInDatacenter)
 		}
+		if opts.Explain {
+			r.params.Set("explain", "")
+		}
 	}
 
 	if method == "POST" {
@@ -66,6 +69,10 @@ func (d *DiscoveryChain) Get(name string, opts *DiscoveryChainOptions, q *QueryO
 type DiscoveryChainOptions struct {
 	EvaluateInDatacenter string `json:"-"`
 
+	// Explain requests that each node of the compiled chain lists the config
+	// entries that produced it.
+	Explain bool `json:"-"`
+
 	// OverrideMeshGateway allows for the mesh gateway setting to be overridden
 	// for any resolver in the compiled chain.
 	OverrideMeshGateway MeshGatewayConfig `json:",omitempty"`
@@ -163,26 +170,53 @@ type DiscoveryGraphNode struct {
 
 	// shared by Type==resolver || Type==splitter
 	LoadBalancer *LoadBalancer `json:",omitempty"`
+
+	// Sources lists the config entries that produced this node. It is only
+	// populated when the chain was requested with Explain set.
+	Sources []DiscoveryGraphNodeSource `json:",omitempty"`
+}
+
+// DiscoveryGraphNodeSource identifies a config entry that contributed to a
+// node in a compiled discovery chain.
+type DiscoveryGraphNodeSource struct {
+	Kind      string
+	Name      string
+	Namespace string `json:",omitempty"`
+	Partition string `json:",omitempty"`
+
+	// Default is true if no config entry exists and the node was built
+	// from the default values of the entry kind.
+	Default bool `json:",omitempty"`
 }
 
 // compiled form of ServiceRoute
 type DiscoveryRoute struct {
 	Definition *ServiceRoute
 	NextNode   string
+	Mirrors    []*DiscoveryMirror `json:",omitempty"`
 }
 
 // compiled form of ServiceSplit
 type DiscoverySplit struct {
 	Weight   float32
 	NextNode string
+	Mirrors  []*DiscoveryMirror `json:",omitempty"`
+}
+
+// compiled form of ServiceRouteMirror
+type DiscoveryMirror struct {
+	NextNode string
+	Percent  float32
 }
 
 // compiled form of ServiceResolverConfigEntry
 type DiscoveryResolver struct {
-	Default        bool
-	ConnectTimeout time.Duration
-	Target         string
-	Failover       *DiscoveryFailover
+	Default          bool
+	ConnectTimeout   time.Duration
+	Target           string
+	Failover         *DiscoveryFailover
+	OutlierDetection *PassiveHealthCheck `json:",omitempty"`
+	HealthChecks     []ActiveHealthCheck `json:",omitempty"`
 }
 
 func (r *DiscoveryResolver) MarshalJSON() ([]byte, error) {
@@ -236,7 +270,9 @@ type DiscoveryTarget struct {
 	Service       string
 	ServiceSubset string
 	Namespace     string
+	Partition     string
 	Datacenter    string
+	Peer          string
 
 	MeshGateway    MeshGatewayConfig
 	Subset         ServiceResolverSubset
@@ -105,6 +105,99 @@ type PeeringStreamStatus struct {
 	LastSend *time.Time
 }
 
+// PeeringDiagnostics contains detailed information about the replication
+// stream of a peering, used to troubleshoot peerings that are not healthy.
+type PeeringDiagnostics struct {
+	// Name is the local alias for the peering.
+	Name string
+	// ID is the local identifier of the peering.
+	ID string
+	// State is the current state of the peering.
+	State PeeringState
+	// Connected is true when there is an open stream for the peer.
+	Connected bool
+	// Dialer is true when this cluster dials the peer's servers, false when it
+	// generated the peering token and accepts the stream.
+	Dialer bool
+	// LastHeartbeat is when the last heartbeat was received from the peer.
+	LastHeartbeat *time.Time `json:",omitempty"`
+	// LastAck is when the peer last acknowledged a resource sent to it.
+	LastAck *time.Time `json:",omitempty"`
+	// LastNack is when the peer last failed to apply a resource sent to it.
+	LastNack *time.Time `json:",omitempty"`
+	// LastNackMessage is the error reported by the peer with the last NACK.
+	LastNackMessage string `json:",omitempty"`
+	// LastSendError is when sending into the stream last failed.
+	LastSendError *time.Time `json:",omitempty"`
+	// LastSendErrorMessage is the error of the last failed send.
+	LastSendErrorMessage string `json:",omitempty"`
+	// LastRecvError is when receiving from the stream, or storing a resource
+	// replicated from the peer, last failed.
+	LastRecvError *time.Time `json:",omitempty"`
+	// LastRecvErrorMessage is the error of the last failed receive.
+	LastRecvErrorMessage string `json:",omitempty"`
+	// Resources is the replication state of each resource sent to the peer.
+	Resources []PeeringResourceStatus
+	// StreamEvents is the history of the most recent stream connections and
+	// disconnections, oldest first.
+	StreamEvents []PeeringStreamEvent
+	// Dial describes how the peer's servers are reached. It is only set for
+	// dialers.
+	Dial *PeeringDialDiagnostics `json:",omitempty"`
+}
+
+// PeeringResourceStatus is the replication state of a single resource sent
+// to a peer.
+type PeeringResourceStatus struct {
+	// ResourceURL is the type of the resource.
+	ResourceURL string
+	// ResourceID identifies the resource, for example the name of an exported
+	// service.
+	ResourceID string
+	// LastSent is when the resource was last sent to the peer.
+	LastSent *time.Time `json:",omitempty"`
+	// LastSentNonce is the nonce of the last response that carried the resource.
+	LastSentNonce string `json:",omitempty"`
+	// LastAck is when the peer last acknowledged the resource.
+	LastAck *time.Time `json:",omitempty"`
+	// LastNack is when the peer last failed to apply the resource.
+	LastNack *time.Time `json:",omitempty"`
+	// LastNackMessage is the error reported by the peer with the last NACK.
+	LastNackMessage string `json:",omitempty"`
+}
+
+// PeeringStreamEvent records the peering stream connecting or disconnecting.
+type PeeringStreamEvent struct {
+	Time time.Time
+	// Connected is true when the stream was opened, false when it was closed.
+	Connected bool
+	// ErrorMessage is the error that caused the stream to close, if any.
+	ErrorMessage string `json:",omitempty"`
+}
+
+// PeeringDialDiagnostics describes the addresses used to dial a peer.
+type PeeringDialDiagnostics struct {
+	// ServerName is the TLS server name expected from the peer's servers.
+	ServerName string
+	// ServerAddresses are the addresses of the peer's servers.
+	ServerAddresses []string
+	// PeerThroughMeshGateways is true when the mesh config entry routes peering
+	// control plane traffic through the local mesh gateways.
+	PeerThroughMeshGateways bool
+	// GatewayAddresses are the addresses of the local mesh gateways tried
+	// before the peer's servers.
+	GatewayAddresses []string `json:",omitempty"`
+	// LastDialAddress is the address most recently dialed.
+	LastDialAddress string `json:",omitempty"`
+	// LastDialTime is when LastDialAddress was dialed.
+	LastDialTime *time.Time `json:",omitempty"`
+	// ViaMeshGateway is true when LastDialAddress is a local mesh gateway.
+	ViaMeshGateway bool
+	// Error is set when the addresses to dial could not be resolved, or when
+	// peering through mesh gateways is enabled but no gateway is available.
+	Error string `json:",omitempty"`
+}
+
 type PeeringReadResponse struct {
 	Peering *Peering
 }
@@ -190,6 +283,43 @@ func (p *Peerings) Read(ctx context.Context, name string, q *QueryOptions) (*Pee
 	return &out, qm, nil
 }
 
+// Diagnostics returns detailed information about the replication stream of
+// the named peering. It returns nil when the peering does not exist.
+func (p *Peerings) Diagnostics(ctx context.Context, name string, q *QueryOptions) (*PeeringDiagnostics, *QueryMeta, error) {
+	if name == "" {
+		return nil, nil, fmt.Errorf("peering name cannot be empty")
+	}
+
+	req := p.c.newRequest("GET", fmt.Sprintf("/v1/peering/%s/diagnostics", name))
+	req.setQueryOptions(q)
+	req.ctx = ctx
+
+	rtt, resp, err := p.c.doRequest(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer closeResponseBody(resp)
+	found, resp, err := requireNotFoundOrOK(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	qm := &QueryMeta{}
+	parseQueryMeta(resp, qm)
+	qm.RequestTime = rtt
+
+	if !found {
+		return nil, qm, nil
+	}
+
+	var out PeeringDiagnostics
+	if err := decodeBody(resp, &out); err != nil {
+		return nil, nil, err
+	}
+
+	return &out, qm, nil
+}
+
 func (p *Peerings) Delete(ctx context.Context, name string, q *WriteOptions) (*WriteMeta, error) {
 	if name == "" {
 		return nil, fmt.Errorf("peering name cannot be empty")
@@ -212,6 +342,31 @@ func (p *Peerings) Delete(ctx context.Context, name string, q *WriteOptions) (*W
 	return wm, nil
 }
 
+// RotateSecrets replaces the stream secret of an established peering without
+// re-establishing it. It must be called in the cluster that generated the
+// peering token.
+func (p *Peerings) RotateSecrets(ctx context.Context, name string, q *WriteOptions) (*WriteMeta, error) {
+	if name == "" {
+		return nil, fmt.Errorf("peering name cannot be empty")
+	}
+
+	req := p.c.newRequest("POST", fmt.Sprintf("/v1/peering/%s/rotate", name))
+	req.setWriteOptions(q)
+	req.ctx = ctx
+
+	rtt, resp, err := p.c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+	if err := requireOK(resp); err != nil {
+		return nil, err
+	}
+
+	wm := &WriteMeta{RequestTime: rtt}
+	return wm, nil
+}
+
 // TODO(peering): verify this is the ultimate signature we want
 func (p *Peerings) GenerateToken(ctx context.Context, g PeeringGenerateTokenRequest, wq *WriteOptions) (*PeeringGenerateTokenResponse, *WriteMeta, error) {
 	if g.PeerName == "" {
@@ -21,6 +21,7 @@ const (
 type Formatter interface {
 	FormatPolicy(policy *api.ACLPolicy) (string, error)
 	FormatPolicyList(policies []*api.ACLPolicyListEntry) (string, error)
+	FormatPolicyLintFindings(findings []*api.ACLPolicyLintFinding) (string, error)
 }
 
 // GetSupportedFormats returns supported formats
@@ -106,6 +107,20 @@ func (f *prettyFormatter) formatPolicyListEntry(policy *api.ACLPolicyListEntry)
 	return buffer.String()
 }
 
+func (f *prettyFormatter) FormatPolicyLintFindings(findings []*api.ACLPolicyLintFinding) (string, error) {
+	if len(findings) == 0 {
+		return "No issues found", nil
+	}
+
+	var buffer bytes.Buffer
+
+	for _, finding := range findings {
+		buffer.WriteString(fmt.Sprintf("[%s] %s\n", finding.Code, finding.Message))
+	}
+
+	return buffer.String(), nil
+}
+
 func newJSONFormatter(showMeta bool) Formatter {
 	return &jsonFormatter{showMeta}
 }
@@ -129,3 +144,14 @@ func (f *jsonFormatter) FormatPolicyList(policies []*api.ACLPolicyListEntry) (st
 	}
 	return string(b), nil
 }
+
+func (f *jsonFormatter) FormatPolicyLintFindings(findings []*api.ACLPolicyLintFinding) (string, error) {
+	if findings == nil {
+		findings = []*api.ACLPolicyLintFinding{}
+	}
+	b, err := json.MarshalIndent(findings, "", "    ")
+	if err != nil {
+		return "", fmt.Errorf("Failed to marshal policy lint findings: %v", err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package policylint
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/command/acl/policy"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/hashicorp/consul/command/helpers"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	rules  string
+	format string
+
+	testStdin io.Reader
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(&c.rules, "rules", "", "The policy rules to lint. May be prefixed with '@' "+
+		"to indicate that the value is a file path to load the rules from. '-' may also be "+
+		"given to indicate that the rules are available on stdin. This flag is required.")
+	c.flags.StringVar(
+		&c.format,
+		"format",
+		policy.PrettyFormat,
+		fmt.Sprintf("Output format {%s}", strings.Join(policy.GetSupportedFormats(), "|")),
+	)
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	if c.rules == "" {
+		c.UI.Error("Missing required '-rules' flag")
+		c.UI.Error(c.Help())
+		return 1
+	}
+
+	rules, err := helpers.LoadDataSource(c.rules, c.testStdin)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error loading rules: %v", err))
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	findings, _, err := client.ACL().PolicyLint(rules, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Failed to lint policy rules: %v", err))
+		return 1
+	}
+
+	formatter, err := policy.NewFormatter(c.format, false)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	out, err := formatter.FormatPolicyLintFindings(findings)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+	if out != "" {
+		c.UI.Info(out)
+	}
+
+	if len(findings) > 0 {
+		return 2
+	}
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Lint ACL policy rules"
+	help     = `
+Usage: consul acl policy lint -rules RULES [options]
+
+    Parses the given policy rules on the Consul servers and reports rules
+    that are redundant, shadowed by a prefix rule, declared more than once,
+    grant write access on the empty prefix or use deprecated syntax. The
+    rules are not saved.
+
+    The command exits with 1 if the rules are invalid, 2 if any findings
+    were reported and 0 otherwise.
+
+    The -rules option values allows loading the value from stdin, a file
+    or the raw value. To use stdin pass '-' as the value. To load the value
+    from a file prefix the value with an '@'. Any other values will be used
+    directly.
+
+    Lint the rules in a file:
+
+        $ consul acl policy lint -rules @rules.hcl
+`
+)
@@ -57,5 +57,9 @@ Usage: consul acl policy <subcommand> [options] [args]
 
     $ consul acl policy delete -name "my-policy"
 
+  Lint policy rules
+
+    $ consul acl policy lint -rules @rules.hcl
+
   For more examples, ask for subcommand help or view the documentation.
 `
@@ -1726,3 +1726,47 @@ func TestAppendTelemetryCollectorMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestBootstrapConfig_GenerateJSON_Locality(t *testing.T) {
+	args := &BootstrapTplArgs{
+		GRPC: GRPC{
+			AgentAddress: "127.0.0.1",
+			AgentPort:    "8502",
+		},
+		ProxyCluster:          "web",
+		ProxyID:               "web-sidecar-proxy",
+		ProxySourceService:    "web",
+		AdminAccessLogPath:    "/dev/null",
+		AdminBindAddress:      "127.0.0.1",
+		AdminBindPort:         "19000",
+		LocalAgentClusterName: "local_agent",
+		Locality: &Locality{
+			Region: "us-east-1",
+			Zone:   "us-east-1a",
+		},
+		LocalServiceClusterName: "local_service",
+	}
+
+	var cfg BootstrapConfig
+	out, err := cfg.GenerateJSON(args, true)
+	require.NoError(t, err)
+
+	var bootstrap struct {
+		Node struct {
+			Locality map[string]string `json:"locality"`
+		} `json:"node"`
+		StaticResources struct {
+			Clusters []map[string]interface{} `json:"clusters"`
+		} `json:"static_resources"`
+		ClusterManager struct {
+			LocalClusterName string `json:"local_cluster_name"`
+		} `json:"cluster_manager"`
+	}
+	require.NoError(t, json.Unmarshal(out, &bootstrap))
+
+	require.Equal(t, map[string]string{"region": "us-east-1", "zone": "us-east-1a"}, bootstrap.Node.Locality)
+	require.Equal(t, "local_service", bootstrap.ClusterManager.LocalClusterName)
+	require.Len(t, bootstrap.StaticResources.Clusters, 2)
+	require.Equal(t, "local_service", bootstrap.StaticResources.Clusters[1]["name"])
+	require.Equal(t, "EDS", bootstrap.StaticResources.Clusters[1]["type"])
+}
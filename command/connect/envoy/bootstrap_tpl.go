@@ -135,6 +135,28 @@ type BootstrapTplArgs struct {
 	// PrometheusKeyFile is the path to a private key file Envoy to use when serving TLS on the Prometheus metrics
 	// endpoint. Only applicable when envoy_prometheus_bind_addr is set in the proxy config.
 	PrometheusKeyFile string
+
+	// HealthDiscovery configures Envoy to fetch health checks from the local
+	// agent using the health discovery service. Terminating gateways use it
+	// to health check their linked services.
+	HealthDiscovery bool
+
+	// Locality is the locality of the sidecar proxy service instance. When
+	// set, it is reported as the Envoy node locality and the local service
+	// cluster is defined so that Envoy can use zone-aware routing.
+	Locality *Locality
+
+	// LocalServiceClusterName is the name reserved for the static cluster
+	// holding the instances of the proxy's own service. It is only defined
+	// when Locality is set.
+	LocalServiceClusterName string
+}
+
+// Locality is the region and zone of the proxy used in the bootstrap
+// template.
+type Locality struct {
+	Region string
+	Zone   string
 }
 
 // GRPC settings used in the bootstrap template.
@@ -188,6 +210,12 @@ const bootstrapTemplate = `{
   "node": {
     "cluster": "{{ .ProxyCluster }}",
     "id": "{{ .ProxyID }}",
+    {{- if .Locality }}
+    "locality": {
+      "region": "{{ .Locality.Region }}",
+      "zone": "{{ .Locality.Zone }}"
+    },
+    {{- end }}
     "metadata": {
       {{- if .NodeName }}
       "node_name": "{{ .NodeName }}",
@@ -262,6 +290,21 @@ const bootstrapTemplate = `{
           ]
         }
       }
+      {{- if .Locality -}}
+      ,
+      {
+        "name": "{{ .LocalServiceClusterName }}",
+        "ignore_health_on_host_removal": false,
+        "connect_timeout": "1s",
+        "type": "EDS",
+        "eds_cluster_config": {
+          "eds_config": {
+            "ads": {},
+            "resource_api_version": "V3"
+          }
+        }
+      }
+      {{- end }}
       {{- if .StaticClustersJSON -}}
       ,
       {{ .StaticClustersJSON }}
@@ -293,6 +336,28 @@ const bootstrapTemplate = `{
   {{- if .TracingConfigJSON }}
   "tracing": {{ .TracingConfigJSON }},
   {{- end }}
+  {{- if .Locality }}
+  "cluster_manager": {
+    "local_cluster_name": "{{ .LocalServiceClusterName }}"
+  },
+  {{- end }}
+  {{- if .HealthDiscovery }}
+  "hds_config": {
+    "api_type": "GRPC",
+    "transport_api_version": "V3",
+    "grpc_services": {
+      "initial_metadata": [
+        {
+          "key": "x-consul-token",
+          "value": "{{ .Token }}"
+        }
+      ],
+      "envoy_grpc": {
+        "cluster_name": "{{ .LocalAgentClusterName }}"
+      }
+    }
+  },
+  {{- end }}
   "dynamic_resources": {
     "lds_config": {
       "ads": {},
@@ -680,17 +680,21 @@ func (c *cmd) generateConfig() ([]byte, error) {
 
 	// Fetch any customization from the registration
 	var svcProxyConfig *api.AgentServiceConnectProxyConfig
+	var svcLocality *api.Locality
 	var serviceName, ns, partition, datacenter string
+	kind := c.gatewayKind
 	if c.nodeName == "" {
 		svc, _, err := c.client.Agent().Service(c.proxyID, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed fetch proxy config from local agent: %s", err)
 		}
 		svcProxyConfig = svc.Proxy
+		svcLocality = svc.Locality
 		serviceName = svc.Service
 		ns = svc.Namespace
 		partition = svc.Partition
 		datacenter = svc.Datacenter
+		kind = svc.Kind
 	} else {
 		filter := fmt.Sprintf("ID == %q", c.proxyID)
 		svcList, _, err := c.client.Catalog().NodeServiceList(c.nodeName,
@@ -706,11 +710,13 @@ func (c *cmd) generateConfig() ([]byte, error) {
 		}
 
 		svcProxyConfig = svcList.Services[0].Proxy
+		svcLocality = svcList.Services[0].Locality
 		serviceName = svcList.Services[0].Service
 		ns = svcList.Services[0].Namespace
 		partition = svcList.Services[0].Partition
 		datacenter = svcList.Node.Datacenter
 		c.gatewayKind = svcList.Services[0].Kind
+		kind = c.gatewayKind
 	}
 	c.logger.Debug("Fetched registration info")
 	if svcProxyConfig == nil {
@@ -745,6 +751,20 @@ func (c *cmd) generateConfig() ([]byte, error) {
 		args.Datacenter = datacenter
 	}
 
+	// Sidecars with a locality define the local service cluster that Envoy
+	// compares the zones of their upstreams with for zone-aware routing.
+	if kind == api.ServiceKindConnectProxy && svcLocality != nil {
+		args.Locality = &Locality{
+			Region: svcLocality.Region,
+			Zone:   svcLocality.Zone,
+		}
+		args.LocalServiceClusterName = xds.LocalServiceClusterName
+	}
+
+	// Terminating gateways health check their linked services on behalf of
+	// the agent.
+	args.HealthDiscovery = kind == api.ServiceKindTerminatingGateway
+
 	if err := generateAccessLogs(c, args); err != nil {
 		return nil, err
 	}
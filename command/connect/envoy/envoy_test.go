@@ -1044,6 +1044,24 @@ func TestGenerateConfig(t *testing.T) {
 				PrometheusScrapePath:  "/metrics",
 			},
 		},
+		{
+			Name:  "terminating-gateway-nodemeta",
+			Flags: []string{"-proxy-id", "terminating-gateway-1", "-node-name", "test-node"},
+			WantArgs: BootstrapTplArgs{
+				ProxyCluster: "terminating-gateway-1",
+				ProxyID:      "terminating-gateway-1",
+				NodeName:     "test-node",
+				GRPC: GRPC{
+					AgentAddress: "127.0.0.1",
+					AgentPort:    "8502",
+				},
+				AdminAccessLogPath:    "/dev/null",
+				AdminBindAddress:      "127.0.0.1",
+				AdminBindPort:         "19000",
+				LocalAgentClusterName: xds.LocalAgentClusterName,
+				PrometheusScrapePath:  "/metrics",
+			},
+		},
 		{
 			Name: "envoy-readiness-probe",
 			Flags: []string{"-proxy-id", "test-proxy",
@@ -1631,6 +1649,8 @@ func testMockCatalogNodeServiceList() http.HandlerFunc {
 		var svcKind api.ServiceKind
 		if strings.Contains(proxyID, "ingress-gateway") {
 			svcKind = api.ServiceKindIngressGateway
+		} else if strings.Contains(proxyID, "terminating-gateway") {
+			svcKind = api.ServiceKindTerminatingGateway
 		} else {
 			svcKind = api.ServiceKindConnectProxy
 		}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package discoverychain
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	format    string
+	explain   bool
+	compileDC string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+	c.flags.StringVar(
+		&c.format,
+		"format",
+		TreeFormat,
+		fmt.Sprintf("Output format {%s}", strings.Join(GetSupportedFormats(), "|")),
+	)
+	c.flags.BoolVar(&c.explain, "explain", false,
+		"Annotate each node of the chain with the config entries that produced it.")
+	c.flags.StringVar(&c.compileDC, "compile-datacenter", "",
+		"The datacenter to compile the discovery chain in. Defaults to the datacenter of the agent.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.ServerFlags())
+	flags.Merge(c.flags, c.http.MultiTenancyFlags())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	args = c.flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("Must specify the name of the service")
+		return 1
+	}
+	name := args[0]
+
+	formatter, err := NewFormatter(c.format)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	opts := &api.DiscoveryChainOptions{
+		EvaluateInDatacenter: c.compileDC,
+		Explain:              c.explain,
+	}
+	resp, _, err := client.DiscoveryChain().Get(name, opts, nil)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading discovery chain for %q: %s", name, err))
+		return 1
+	}
+
+	out, err := formatter.FormatChain(resp.Chain)
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error formatting discovery chain: %s", err))
+		return 1
+	}
+
+	c.UI.Output(strings.TrimSuffix(out, "\n"))
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Visualize the compiled discovery chain of a service"
+	help     = `
+Usage: consul discovery-chain [options] <service>
+
+  Compiles the discovery chain of a service and renders its routers,
+  splitters, resolvers and failover targets.
+
+  Print the chain as an indented tree:
+
+    $ consul discovery-chain web
+
+  Render the chain as a Graphviz graph:
+
+    $ consul discovery-chain -format=dot web | dot -Tsvg > web.svg
+
+  Render the chain as a Mermaid flowchart and show the config entries that
+  produced each node:
+
+    $ consul discovery-chain -format=mermaid -explain web
+`
+)
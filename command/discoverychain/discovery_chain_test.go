@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package discoverychain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestDiscoveryChainCommand_noTabs(t *testing.T) {
+	t.Parallel()
+
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestDiscoveryChainCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := agent.NewTestAgent(t, ``)
+	t.Cleanup(func() { _ = a.Shutdown() })
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	client := a.Client()
+
+	_, _, err := client.ConfigEntries().Set(&api.ServiceConfigEntry{
+		Kind:     api.ServiceDefaults,
+		Name:     "web",
+		Protocol: "http",
+	}, nil)
+	require.NoError(t, err)
+	_, _, err = client.ConfigEntries().Set(&api.ServiceRouterConfigEntry{
+		Kind: api.ServiceRouter,
+		Name: "web",
+	}, nil)
+	require.NoError(t, err)
+
+	t.Run("no service", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		code := cmd.Run([]string{"-http-addr=" + a.HTTPAddr()})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "Must specify the name of the service")
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		code := cmd.Run([]string{"-http-addr=" + a.HTTPAddr(), "-format=svg", "web"})
+		require.Equal(t, 1, code)
+		require.Contains(t, ui.ErrorWriter.String(), "Unknown format: svg")
+	})
+
+	t.Run("tree with explain", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		code := cmd.Run([]string{"-http-addr=" + a.HTTPAddr(), "-explain", "web"})
+		require.Equal(t, 0, code, "err: %s", ui.ErrorWriter.String())
+
+		expected := `Discovery chain for "web" (protocol: http, datacenter: dc1)
+router: web.default.default
+    from service-router/web
+    [route 0: prefix=/] resolver: web.default.default.dc1
+        from service-resolver/web [default]
+        target: web.default.default.dc1
+`
+		require.Equal(t, expected, ui.OutputWriter.String())
+	})
+
+	t.Run("dot", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		code := cmd.Run([]string{"-http-addr=" + a.HTTPAddr(), "-format=dot", "web"})
+		require.Equal(t, 0, code, "err: %s", ui.ErrorWriter.String())
+		require.Contains(t, ui.OutputWriter.String(), `"router:web.default.default" -> "resolver:web.default.default.dc1"`)
+	})
+}
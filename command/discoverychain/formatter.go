@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package discoverychain
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	TreeFormat    string = "tree"
+	DOTFormat     string = "dot"
+	MermaidFormat string = "mermaid"
+)
+
+// Formatter defines methods provided by a discovery chain output formatter
+type Formatter interface {
+	FormatChain(chain *api.CompiledDiscoveryChain) (string, error)
+}
+
+// GetSupportedFormats returns supported formats
+func GetSupportedFormats() []string {
+	return []string{TreeFormat, DOTFormat, MermaidFormat}
+}
+
+// NewFormatter returns Formatter implementation
+func NewFormatter(format string) (formatter Formatter, err error) {
+	switch format {
+	case TreeFormat:
+		formatter = &treeFormatter{}
+	case DOTFormat:
+		formatter = &dotFormatter{}
+	case MermaidFormat:
+		formatter = &mermaidFormatter{}
+	default:
+		err = fmt.Errorf("Unknown format: %s", format)
+	}
+
+	return formatter, err
+}
+
+// graphNode is a node of the rendered graph. It is either a node of the
+// compiled chain or one of its targets.
+type graphNode struct {
+	ID      string
+	Label   string
+	Target  bool
+	Sources []api.DiscoveryGraphNodeSource
+}
+
+type graphEdge struct {
+	From     string
+	To       string
+	Label    string
+	Failover bool
+}
+
+type graph struct {
+	nodes []graphNode
+	edges []graphEdge
+}
+
+// buildGraph walks the chain from its start node and returns its nodes and
+// edges in a stable order.
+func buildGraph(chain *api.CompiledDiscoveryChain) (*graph, error) {
+	g := &graph{}
+	seen := make(map[string]bool)
+
+	addTarget := func(id string) string {
+		key := "target:" + id
+		if !seen[key] {
+			seen[key] = true
+			g.nodes = append(g.nodes, graphNode{ID: key, Label: "target: " + id, Target: true})
+		}
+		return key
+	}
+
+	queue := []string{chain.StartNode}
+	seen[chain.StartNode] = true
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+
+		node, ok := chain.Nodes[key]
+		if !ok {
+			return nil, fmt.Errorf("discovery chain references unknown node %q", key)
+		}
+		g.nodes = append(g.nodes, graphNode{
+			ID:      key,
+			Label:   node.Type + ": " + node.Name,
+			Sources: node.Sources,
+		})
+
+		follow := func(next, label string) {
+			g.edges = append(g.edges, graphEdge{From: key, To: next, Label: label})
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+
+		switch node.Type {
+		case api.DiscoveryGraphNodeTypeRouter:
+			for i, route := range node.Routes {
+				follow(route.NextNode, fmt.Sprintf("route %d: %s", i, describeRoute(route.Definition)))
+			}
+		case api.DiscoveryGraphNodeTypeSplitter:
+			for _, split := range node.Splits {
+				follow(split.NextNode, formatWeight(split.Weight))
+			}
+		case api.DiscoveryGraphNodeTypeResolver:
+			if node.Resolver == nil {
+				continue
+			}
+			g.edges = append(g.edges, graphEdge{From: key, To: addTarget(node.Resolver.Target)})
+			if node.Resolver.Failover != nil {
+				for i, target := range node.Resolver.Failover.Targets {
+					g.edges = append(g.edges, graphEdge{
+						From:     key,
+						To:       addTarget(target),
+						Label:    fmt.Sprintf("failover %d", i),
+						Failover: true,
+					})
+				}
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// describeRoute summarizes the match criteria of a route.
+func describeRoute(route *api.ServiceRoute) string {
+	if route == nil || route.Match == nil || route.Match.HTTP == nil {
+		return "default"
+	}
+	http := route.Match.HTTP
+
+	var parts []string
+	switch {
+	case http.PathExact != "":
+		parts = append(parts, "path="+http.PathExact)
+	case http.PathPrefix != "":
+		parts = append(parts, "prefix="+http.PathPrefix)
+	case http.PathRegex != "":
+		parts = append(parts, "regex="+http.PathRegex)
+	}
+	if len(http.Methods) > 0 {
+		parts = append(parts, "methods="+strings.Join(http.Methods, ","))
+	}
+	for _, h := range http.Header {
+		parts = append(parts, "header="+h.Name)
+	}
+	for _, q := range http.QueryParam {
+		parts = append(parts, "query="+q.Name)
+	}
+	if http.JWT != nil {
+		parts = append(parts, "jwt="+http.JWT.Provider)
+	}
+	if len(parts) == 0 {
+		return "default"
+	}
+	return strings.Join(parts, " ")
+}
+
+func formatWeight(weight float32) string {
+	return strconv.FormatFloat(float64(weight), 'f', -1, 32) + "%"
+}
+
+func formatSources(sources []api.DiscoveryGraphNodeSource) []string {
+	out := make([]string, 0, len(sources))
+	for _, source := range sources {
+		name := source.Name
+		if source.Namespace != "" || source.Partition != "" {
+			name = fmt.Sprintf("%s (namespace: %s, partition: %s)", name, source.Namespace, source.Partition)
+		}
+		s := fmt.Sprintf("%s/%s", source.Kind, name)
+		if source.Default {
+			s += " [default]"
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+type treeFormatter struct{}
+
+func (f *treeFormatter) FormatChain(chain *api.CompiledDiscoveryChain) (string, error) {
+	var buffer bytes.Buffer
+
+	buffer.WriteString(fmt.Sprintf("Discovery chain for %q (protocol: %s, datacenter: %s)\n", chain.ServiceName, chain.Protocol, chain.Datacenter))
+
+	var walk func(key, label, indent string, path map[string]bool) error
+	walk = func(key, label, indent string, path map[string]bool) error {
+		node, ok := chain.Nodes[key]
+		if !ok {
+			return fmt.Errorf("discovery chain references unknown node %q", key)
+		}
+		if label != "" {
+			label = "[" + label + "] "
+		}
+		buffer.WriteString(fmt.Sprintf("%s%s%s: %s\n", indent, label, node.Type, node.Name))
+		for _, source := range formatSources(node.Sources) {
+			buffer.WriteString(fmt.Sprintf("%s    from %s\n", indent, source))
+		}
+		if path[key] {
+			return fmt.Errorf("discovery chain contains a cycle at node %q", key)
+		}
+		path[key] = true
+		defer delete(path, key)
+
+		indent += "    "
+		switch node.Type {
+		case api.DiscoveryGraphNodeTypeRouter:
+			for i, route := range node.Routes {
+				if err := walk(route.NextNode, fmt.Sprintf("route %d: %s", i, describeRoute(route.Definition)), indent, path); err != nil {
+					return err
+				}
+			}
+		case api.DiscoveryGraphNodeTypeSplitter:
+			for _, split := range node.Splits {
+				if err := walk(split.NextNode, formatWeight(split.Weight), indent, path); err != nil {
+					return err
+				}
+			}
+		case api.DiscoveryGraphNodeTypeResolver:
+			if node.Resolver == nil {
+				return nil
+			}
+			buffer.WriteString(fmt.Sprintf("%starget: %s\n", indent, node.Resolver.Target))
+			if node.Resolver.Failover != nil {
+				for i, target := range node.Resolver.Failover.Targets {
+					buffer.WriteString(fmt.Sprintf("%s[failover %d] target: %s\n", indent, i, target))
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := walk(chain.StartNode, "", "", make(map[string]bool)); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+type dotFormatter struct{}
+
+func (f *dotFormatter) FormatChain(chain *api.CompiledDiscoveryChain) (string, error) {
+	g, err := buildGraph(chain)
+	if err != nil {
+		return "", err
+	}
+
+	quote := strconv.Quote
+
+	var buffer bytes.Buffer
+	buffer.WriteString(fmt.Sprintf("digraph %s {\n", quote(chain.ServiceName)))
+	buffer.WriteString("    rankdir=LR;\n")
+	for _, n := range g.nodes {
+		shape := "box"
+		if n.Target {
+			shape = "ellipse"
+		}
+		label := strings.Join(append([]string{n.Label}, formatSources(n.Sources)...), "\n")
+		buffer.WriteString(fmt.Sprintf("    %s [shape=%s, label=%s];\n", quote(n.ID), shape, quote(label)))
+	}
+	for _, e := range g.edges {
+		var attrs []string
+		if e.Label != "" {
+			attrs = append(attrs, "label="+quote(e.Label))
+		}
+		if e.Failover {
+			attrs = append(attrs, "style=dashed")
+		}
+		var suffix string
+		if len(attrs) > 0 {
+			suffix = " [" + strings.Join(attrs, ", ") + "]"
+		}
+		buffer.WriteString(fmt.Sprintf("    %s -> %s%s;\n", quote(e.From), quote(e.To), suffix))
+	}
+	buffer.WriteString("}\n")
+
+	return buffer.String(), nil
+}
+
+type mermaidFormatter struct{}
+
+func (f *mermaidFormatter) FormatChain(chain *api.CompiledDiscoveryChain) (string, error) {
+	g, err := buildGraph(chain)
+	if err != nil {
+		return "", err
+	}
+
+	// Mermaid node IDs must be simple identifiers, so number the nodes in the
+	// order they were visited.
+	ids := make(map[string]string, len(g.nodes))
+	for i, n := range g.nodes {
+		ids[n.ID] = "n" + strconv.Itoa(i)
+	}
+
+	escape := func(s string) string {
+		return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("flowchart LR\n")
+	for _, n := range g.nodes {
+		label := escape(strings.Join(append([]string{n.Label}, formatSources(n.Sources)...), "<br/>"))
+		if n.Target {
+			buffer.WriteString(fmt.Sprintf("    %s([%s])\n", ids[n.ID], label))
+		} else {
+			buffer.WriteString(fmt.Sprintf("    %s[%s]\n", ids[n.ID], label))
+		}
+	}
+	for _, e := range g.edges {
+		arrow := "-->"
+		if e.Failover {
+			arrow = "-.->"
+		}
+		if e.Label != "" {
+			arrow += "|" + escape(e.Label) + "|"
+		}
+		buffer.WriteString(fmt.Sprintf("    %s %s %s\n", ids[e.From], arrow, ids[e.To]))
+	}
+
+	return buffer.String(), nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package discoverychain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func testChain() *api.CompiledDiscoveryChain {
+	return &api.CompiledDiscoveryChain{
+		ServiceName: "web",
+		Datacenter:  "dc1",
+		Protocol:    "http",
+		StartNode:   "router:web.default.default",
+		Nodes: map[string]*api.DiscoveryGraphNode{
+			"router:web.default.default": {
+				Type: api.DiscoveryGraphNodeTypeRouter,
+				Name: "web.default.default",
+				Routes: []*api.DiscoveryRoute{
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{
+								HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/admin"},
+							},
+						},
+						NextNode: "resolver:admin.default.default.dc1",
+					},
+					{
+						Definition: &api.ServiceRoute{},
+						NextNode:   "splitter:web.default.default",
+					},
+				},
+				Sources: []api.DiscoveryGraphNodeSource{
+					{Kind: api.ServiceRouter, Name: "web"},
+				},
+			},
+			"splitter:web.default.default": {
+				Type: api.DiscoveryGraphNodeTypeSplitter,
+				Name: "web.default.default",
+				Splits: []*api.DiscoverySplit{
+					{Weight: 90, NextNode: "resolver:web.default.default.dc1"},
+					{Weight: 10, NextNode: "resolver:admin.default.default.dc1"},
+				},
+			},
+			"resolver:web.default.default.dc1": {
+				Type: api.DiscoveryGraphNodeTypeResolver,
+				Name: "web.default.default.dc1",
+				Resolver: &api.DiscoveryResolver{
+					Target: "web.default.default.dc1",
+					Failover: &api.DiscoveryFailover{
+						Targets: []string{"web.default.default.dc2"},
+					},
+				},
+			},
+			"resolver:admin.default.default.dc1": {
+				Type: api.DiscoveryGraphNodeTypeResolver,
+				Name: "admin.default.default.dc1",
+				Resolver: &api.DiscoveryResolver{
+					Default: true,
+					Target:  "admin.default.default.dc1",
+				},
+				Sources: []api.DiscoveryGraphNodeSource{
+					{Kind: api.ServiceResolver, Name: "admin", Default: true},
+				},
+			},
+		},
+	}
+}
+
+func TestFormatter_Tree(t *testing.T) {
+	formatter, err := NewFormatter(TreeFormat)
+	require.NoError(t, err)
+
+	out, err := formatter.FormatChain(testChain())
+	require.NoError(t, err)
+
+	expected := `Discovery chain for "web" (protocol: http, datacenter: dc1)
+router: web.default.default
+    from service-router/web
+    [route 0: prefix=/admin] resolver: admin.default.default.dc1
+        from service-resolver/admin [default]
+        target: admin.default.default.dc1
+    [route 1: default] splitter: web.default.default
+        [90%] resolver: web.default.default.dc1
+            target: web.default.default.dc1
+            [failover 0] target: web.default.default.dc2
+        [10%] resolver: admin.default.default.dc1
+            from service-resolver/admin [default]
+            target: admin.default.default.dc1
+`
+	require.Equal(t, expected, out)
+}
+
+func TestFormatter_DOT(t *testing.T) {
+	formatter, err := NewFormatter(DOTFormat)
+	require.NoError(t, err)
+
+	out, err := formatter.FormatChain(testChain())
+	require.NoError(t, err)
+
+	expected := `digraph "web" {
+    rankdir=LR;
+    "router:web.default.default" [shape=box, label="router: web.default.default\nservice-router/web"];
+    "resolver:admin.default.default.dc1" [shape=box, label="resolver: admin.default.default.dc1\nservice-resolver/admin [default]"];
+    "target:admin.default.default.dc1" [shape=ellipse, label="target: admin.default.default.dc1"];
+    "splitter:web.default.default" [shape=box, label="splitter: web.default.default"];
+    "resolver:web.default.default.dc1" [shape=box, label="resolver: web.default.default.dc1"];
+    "target:web.default.default.dc1" [shape=ellipse, label="target: web.default.default.dc1"];
+    "target:web.default.default.dc2" [shape=ellipse, label="target: web.default.default.dc2"];
+    "router:web.default.default" -> "resolver:admin.default.default.dc1" [label="route 0: prefix=/admin"];
+    "router:web.default.default" -> "splitter:web.default.default" [label="route 1: default"];
+    "resolver:admin.default.default.dc1" -> "target:admin.default.default.dc1";
+    "splitter:web.default.default" -> "resolver:web.default.default.dc1" [label="90%"];
+    "splitter:web.default.default" -> "resolver:admin.default.default.dc1" [label="10%"];
+    "resolver:web.default.default.dc1" -> "target:web.default.default.dc1";
+    "resolver:web.default.default.dc1" -> "target:web.default.default.dc2" [label="failover 0", style=dashed];
+}
+`
+	require.Equal(t, expected, out)
+}
+
+func TestFormatter_Mermaid(t *testing.T) {
+	formatter, err := NewFormatter(MermaidFormat)
+	require.NoError(t, err)
+
+	out, err := formatter.FormatChain(testChain())
+	require.NoError(t, err)
+
+	expected := `flowchart LR
+    n0["router: web.default.default<br/>service-router/web"]
+    n1["resolver: admin.default.default.dc1<br/>service-resolver/admin [default]"]
+    n2(["target: admin.default.default.dc1"])
+    n3["splitter: web.default.default"]
+    n4["resolver: web.default.default.dc1"]
+    n5(["target: web.default.default.dc1"])
+    n6(["target: web.default.default.dc2"])
+    n0 -->|"route 0: prefix=/admin"| n1
+    n0 -->|"route 1: default"| n3
+    n1 --> n2
+    n3 -->|"90%"| n4
+    n3 -->|"10%"| n1
+    n4 --> n5
+    n4 -.->|"failover 0"| n6
+`
+	require.Equal(t, expected, out)
+}
+
+func TestFormatter_Unknown(t *testing.T) {
+	_, err := NewFormatter("svg")
+	require.EqualError(t, err, "Unknown format: svg")
+}
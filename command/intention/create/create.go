@@ -5,9 +5,11 @@ package create
 
 import (
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 
 	"github.com/hashicorp/consul/api"
@@ -33,6 +35,7 @@ type cmd struct {
 	flagDeny    bool
 	flagFile    bool
 	flagReplace bool
+	flagDryRun  bool
 	flagMeta    map[string]string
 
 	// testStdin is the input for testing.
@@ -49,6 +52,9 @@ func (c *cmd) init() {
 		"Read intention data from one or more files.")
 	c.flags.BoolVar(&c.flagReplace, "replace", false,
 		"Replace matching intentions.")
+	c.flags.BoolVar(&c.flagDryRun, "dry-run", false,
+		"Report which source and destination pairs would change their "+
+			"authorization decision without creating the intentions.")
 	c.flags.Var((*flags.FlagMapValue)(&c.flagMeta), "meta",
 		"Metadata to set on the intention, formatted as key=value. This flag "+
 			"may be specified multiple times to set multiple meta fields.")
@@ -91,6 +97,10 @@ func (c *cmd) Run(args []string) int {
 		return 1
 	}
 
+	if c.flagDryRun {
+		return c.dryRun(client, ixns)
+	}
+
 	// Go through and create each intention
 	for _, ixn := range ixns {
 		// If replace is set to true, then perform an update operation.
@@ -142,6 +152,142 @@ func (c *cmd) Run(args []string) int {
 	return 0
 }
 
+// dryRun merges the intentions into the service-intentions config entries of
+// their destinations and asks the servers which authorization decisions
+// would change. Nothing is written.
+func (c *cmd) dryRun(client *api.Client, ixns []*api.Intention) int {
+	type destination struct {
+		partition, namespace, name string
+	}
+
+	var (
+		order   []destination
+		entries = make(map[destination]*api.ServiceIntentionsConfigEntry)
+	)
+	for _, ixn := range ixns {
+		dst := destination{ixn.DestinationPartition, ixn.DestinationNS, ixn.DestinationName}
+		entry, ok := entries[dst]
+		if !ok {
+			var err error
+			entry, err = getIntentionsEntry(client, dst.partition, dst.namespace, dst.name)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error reading intentions for destination %q: %s",
+					intention.FormatDestination(ixn), err))
+				return 1
+			}
+			entries[dst] = entry
+			order = append(order, dst)
+		}
+
+		src := &api.SourceIntention{
+			Name:        ixn.SourceName,
+			Peer:        ixn.SourcePeer,
+			Partition:   ixn.SourcePartition,
+			Namespace:   ixn.SourceNS,
+			Action:      ixn.Action,
+			Type:        ixn.SourceType,
+			Description: ixn.Description,
+		}
+
+		replaced := false
+		for i, existing := range entry.Sources {
+			if existing.Name != src.Name || existing.Peer != src.Peer ||
+				existing.Partition != src.Partition || existing.Namespace != src.Namespace {
+				continue
+			}
+			if !c.flagReplace {
+				c.UI.Error(fmt.Sprintf("Error creating intention %q: source is "+
+					"defined more than once for the destination; use -replace "+
+					"to replace it", ixn))
+				return 1
+			}
+			entry.Sources[i] = src
+			replaced = true
+		}
+		if !replaced {
+			entry.Sources = append(entry.Sources, src)
+		}
+	}
+
+	var (
+		evaluated int
+		changes   []api.IntentionDecisionChange
+	)
+	for _, dst := range order {
+		out, _, err := client.ConfigEntries().SetDryRun(entries[dst], &api.WriteOptions{
+			Partition: dst.partition,
+			Namespace: dst.namespace,
+		})
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error evaluating intentions for destination %q: %s",
+				partString(dst.namespace, dst.name), err))
+			return 1
+		}
+		evaluated += out.Evaluated
+		changes = append(changes, out.Changes...)
+	}
+
+	c.UI.Output(fmt.Sprintf("%d of %d source/destination pairs would change", len(changes), evaluated))
+	for _, change := range changes {
+		source := partString(change.SourceNS, change.SourceName)
+		if change.SourcePeer != "" {
+			source = change.SourcePeer + "/" + source
+		}
+		c.UI.Output(fmt.Sprintf("  %s => %s: %s -> %s",
+			source,
+			partString(change.DestinationNS, change.DestinationName),
+			decisionString(change.Before),
+			decisionString(change.After)))
+	}
+
+	return 0
+}
+
+// getIntentionsEntry returns the stored service-intentions config entry for
+// the destination, or an empty one if none exists yet.
+func getIntentionsEntry(client *api.Client, partition, namespace, name string) (*api.ServiceIntentionsConfigEntry, error) {
+	raw, _, err := client.ConfigEntries().Get(api.ServiceIntentions, name, &api.QueryOptions{
+		Partition: partition,
+		Namespace: namespace,
+	})
+	var statusE api.StatusError
+	if errors.As(err, &statusE) && statusE.Code == http.StatusNotFound {
+		return &api.ServiceIntentionsConfigEntry{
+			Kind:      api.ServiceIntentions,
+			Name:      name,
+			Partition: partition,
+			Namespace: namespace,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entry, ok := raw.(*api.ServiceIntentionsConfigEntry)
+	if !ok {
+		return nil, fmt.Errorf("unexpected config entry type %T", raw)
+	}
+	return entry, nil
+}
+
+func decisionString(d api.IntentionDecision) string {
+	switch {
+	case d.HasPermissions:
+		return "L7"
+	case d.Allowed:
+		return "allow"
+	default:
+		return "deny"
+	}
+}
+
+func partString(ns, n string) string {
+	if ns == "" {
+		return n
+	}
+	return ns + "/" + n
+}
+
 // ixnsFromArgs returns the set of intentions to create based on the arguments
 // given and the flags set. This will call ixnsFromFiles if the -file flag
 // was set.
@@ -258,6 +404,12 @@ Usage: consul intention create [options] -file FILE...
   Metadata and any other fields of the previous intention will not be
   preserved.
 
+  To preview the effect of the intentions without creating them, specify
+  the "-dry-run" flag. This reports every source and destination pair whose
+  authorization decision would change:
+
+      $ consul intention create -deny -dry-run '*' db
+
   Additional flags and more advanced use cases are detailed below.
 `
 )
@@ -324,3 +324,42 @@ func TestIntentionCreate_replace(t *testing.T) {
 		require.Equal(t, api.IntentionActionDeny, ixns[0].Action)
 	}
 }
+
+func TestIntentionCreate_dryRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	a := agent.NewTestAgent(t, ``)
+	defer a.Shutdown()
+	client := a.Client()
+
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+
+	for _, name := range []string{"foo", "bar", "baz"} {
+		require.NoError(t, client.Agent().ServiceRegister(&api.AgentServiceRegistration{Name: name}))
+	}
+
+	ui := cli.NewMockUi()
+	c := New(ui)
+
+	args := []string{
+		"-http-addr=" + a.HTTPAddr(),
+		"-deny",
+		"-dry-run",
+		"*", "bar",
+	}
+	require.Equal(t, 0, c.Run(args), ui.ErrorWriter.String())
+
+	output := ui.OutputWriter.String()
+	require.Contains(t, output, "2 of 2 source/destination pairs would change")
+	require.Contains(t, output, "baz => bar: allow -> deny")
+	require.Contains(t, output, "foo => bar: allow -> deny")
+
+	// Nothing should have been written.
+	ixns, _, err := client.Connect().Intentions(nil)
+	require.NoError(t, err)
+	require.Empty(t, ixns)
+}
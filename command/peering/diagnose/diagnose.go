@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package diagnose
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+	"github.com/hashicorp/consul/command/peering"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	name   string
+	format string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+
+	c.flags.StringVar(&c.name, "name", "", "(Required) The local name assigned to the peer cluster.")
+
+	c.flags.StringVar(
+		&c.format,
+		"format",
+		peering.PeeringFormatPretty,
+		fmt.Sprintf("Output format {%s} (default: %s)", strings.Join(peering.GetSupportedFormats(), "|"), peering.PeeringFormatPretty),
+	)
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.PartitionFlag())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	// The peer name may also be given as the only argument.
+	if c.name == "" && len(c.flags.Args()) == 1 {
+		c.name = c.flags.Arg(0)
+	}
+
+	if c.name == "" {
+		c.UI.Error("Missing the required -name flag")
+		return 1
+	}
+
+	if !peering.FormatIsValid(c.format) {
+		c.UI.Error(fmt.Sprintf("Invalid format, valid formats are {%s}", strings.Join(peering.GetSupportedFormats(), "|")))
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connect to Consul agent: %s", err))
+		return 1
+	}
+
+	res, _, err := client.Peerings().Diagnostics(context.Background(), c.name, &api.QueryOptions{})
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error reading peering diagnostics: %s", err))
+		return 1
+	}
+
+	if res == nil {
+		c.UI.Error(fmt.Sprintf("No peering with name %s found.", c.name))
+		return 1
+	}
+
+	if c.format == peering.PeeringFormatJSON {
+		output, err := json.Marshal(res)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error marshalling JSON: %s", err))
+			return 1
+		}
+		c.UI.Output(string(output))
+		return 0
+	}
+
+	c.UI.Output(formatDiagnostics(res))
+
+	return 0
+}
+
+func formatDiagnostics(d *api.PeeringDiagnostics) string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString(fmt.Sprintf("Name:         %s\n", d.Name))
+	buffer.WriteString(fmt.Sprintf("ID:           %s\n", d.ID))
+	buffer.WriteString(fmt.Sprintf("State:        %s\n", d.State))
+	buffer.WriteString(fmt.Sprintf("Connected:    %t\n", d.Connected))
+	if d.Dialer {
+		buffer.WriteString("Role:         dialer\n")
+	} else {
+		buffer.WriteString("Role:         acceptor\n")
+	}
+
+	buffer.WriteString("\n")
+	buffer.WriteString(fmt.Sprintf("Last Heartbeat:    %s\n", formatTime(d.LastHeartbeat)))
+	buffer.WriteString(fmt.Sprintf("Last Ack:          %s\n", formatTime(d.LastAck)))
+	buffer.WriteString(fmt.Sprintf("Last Nack:         %s\n", formatError(d.LastNack, d.LastNackMessage)))
+	buffer.WriteString(fmt.Sprintf("Last Send Error:   %s\n", formatError(d.LastSendError, d.LastSendErrorMessage)))
+	buffer.WriteString(fmt.Sprintf("Last Recv Error:   %s\n", formatError(d.LastRecvError, d.LastRecvErrorMessage)))
+
+	if dial := d.Dial; dial != nil {
+		buffer.WriteString("\n")
+		buffer.WriteString(fmt.Sprintf("Peer Server Name:            %s\n", dial.ServerName))
+		buffer.WriteString(fmt.Sprintf("Peer Through Mesh Gateways:  %t\n", dial.PeerThroughMeshGateways))
+		if dial.LastDialAddress != "" {
+			via := "peer server"
+			if dial.ViaMeshGateway {
+				via = "local mesh gateway"
+			}
+			buffer.WriteString(fmt.Sprintf("Last Dialed:                 %s (%s) at %s\n", dial.LastDialAddress, via, formatTime(dial.LastDialTime)))
+		}
+		if dial.Error != "" {
+			buffer.WriteString(fmt.Sprintf("Dial Error:                  %s\n", dial.Error))
+		}
+		if len(dial.GatewayAddresses) > 0 {
+			buffer.WriteString("Mesh Gateway Addresses:\n")
+			for _, addr := range dial.GatewayAddresses {
+				buffer.WriteString(fmt.Sprintf("    %s\n", addr))
+			}
+		}
+		if len(dial.ServerAddresses) > 0 {
+			buffer.WriteString("Peer Server Addresses:\n")
+			for _, addr := range dial.ServerAddresses {
+				buffer.WriteString(fmt.Sprintf("    %s\n", addr))
+			}
+		}
+	}
+
+	buffer.WriteString("\nStream History:\n")
+	if len(d.StreamEvents) == 0 {
+		buffer.WriteString("    No stream has been established\n")
+	}
+	for _, e := range d.StreamEvents {
+		event := "connected"
+		if !e.Connected {
+			event = "disconnected"
+		}
+		if e.ErrorMessage != "" {
+			event += ": " + e.ErrorMessage
+		}
+		buffer.WriteString(fmt.Sprintf("    %s  %s\n", e.Time.Format(time.RFC3339), event))
+	}
+
+	buffer.WriteString("\nReplicated Resources:\n")
+	if len(d.Resources) == 0 {
+		buffer.WriteString("    No resources have been sent to the peer\n")
+		return buffer.String()
+	}
+	tw := tabwriter.NewWriter(&buffer, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(tw, "    Type\tID\tLast Sent\tLast Ack\tLast Nack")
+	for _, r := range d.Resources {
+		fmt.Fprintf(tw, "    %s\t%s\t%s\t%s\t%s\n",
+			shortTypeURL(r.ResourceURL),
+			r.ResourceID,
+			formatTime(r.LastSent),
+			formatTime(r.LastAck),
+			formatError(r.LastNack, r.LastNackMessage),
+		)
+	}
+	tw.Flush()
+
+	return buffer.String()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
+
+func formatError(t *time.Time, msg string) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	if msg == "" {
+		return formatTime(t)
+	}
+	return fmt.Sprintf("%s (%s)", formatTime(t), msg)
+}
+
+// shortTypeURL strips the protobuf type URL prefix from a resource type.
+func shortTypeURL(typeURL string) string {
+	if idx := strings.LastIndex(typeURL, "."); idx != -1 {
+		return typeURL[idx+1:]
+	}
+	return typeURL
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Diagnose the replication stream of a peering connection"
+	help     = `
+Usage: consul peering diagnose [options] -name <peer name>
+
+  Print detailed information about the replication stream of a peering
+  connection: which resources were last sent to and acknowledged by the peer,
+  the most recent stream connections and disconnections, and, for the dialing
+  side, the addresses used to reach the peer's servers. The peer name may also
+  be given as the only argument.
+
+  Example:
+
+    $ consul peering diagnose -name west-dc
+`
+)
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package diagnose
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/testrpc"
+)
+
+func TestDiagnoseCommand_noTabs(t *testing.T) {
+	t.Parallel()
+
+	if strings.ContainsRune(New(cli.NewMockUi()).Help(), '\t') {
+		t.Fatal("help has tabs")
+	}
+}
+
+func TestDiagnoseCommand(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	t.Parallel()
+
+	acceptor := agent.NewTestAgent(t, ``)
+	t.Cleanup(func() { _ = acceptor.Shutdown() })
+
+	testrpc.WaitForTestAgent(t, acceptor.RPC, "dc1")
+
+	acceptingClient := acceptor.Client()
+
+	t.Run("no name flag", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		args := []string{
+			"-http-addr=" + acceptor.HTTPAddr(),
+		}
+
+		code := cmd.Run(args)
+		require.Equal(t, 1, code, "err: %s", ui.ErrorWriter.String())
+		require.Contains(t, ui.ErrorWriter.String(), "Missing the required -name flag")
+	})
+
+	t.Run("peering does not exist", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		args := []string{
+			"-http-addr=" + acceptor.HTTPAddr(),
+			"-name=foo",
+		}
+
+		code := cmd.Run(args)
+		require.Equal(t, 1, code, "err: %s", ui.ErrorWriter.String())
+		require.Contains(t, ui.ErrorWriter.String(), "No peering with name")
+	})
+
+	_, _, err := acceptingClient.Peerings().GenerateToken(context.Background(), api.PeeringGenerateTokenRequest{PeerName: "foo"}, &api.WriteOptions{})
+	require.NoError(t, err, "Could not generate peering token at acceptor for \"foo\"")
+
+	t.Run("diagnose with pretty print", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		args := []string{
+			"-http-addr=" + acceptor.HTTPAddr(),
+			"foo",
+		}
+
+		code := cmd.Run(args)
+		require.Equal(t, 0, code, "err: %s", ui.ErrorWriter.String())
+		output := ui.OutputWriter.String()
+
+		require.Contains(t, output, "foo")
+		require.Contains(t, output, api.PeeringStatePending)
+		require.Contains(t, output, "Role:         acceptor")
+		require.Contains(t, output, "No stream has been established")
+		require.Contains(t, output, "No resources have been sent to the peer")
+	})
+
+	t.Run("diagnose with json", func(t *testing.T) {
+		ui := cli.NewMockUi()
+		cmd := New(ui)
+
+		args := []string{
+			"-http-addr=" + acceptor.HTTPAddr(),
+			"-name=foo",
+			"-format=json",
+		}
+
+		code := cmd.Run(args)
+		require.Equal(t, 0, code, "err: %s", ui.ErrorWriter.String())
+
+		var out api.PeeringDiagnostics
+		require.NoError(t, json.Unmarshal(ui.OutputWriter.Bytes(), &out))
+		require.Equal(t, "foo", out.Name)
+		require.False(t, out.Dialer)
+		require.Nil(t, out.Dial)
+	})
+}
+
+func TestFormatDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	out := formatDiagnostics(&api.PeeringDiagnostics{
+		Name:     "west",
+		ID:       "11111111-2222-3333-4444-555555555555",
+		State:    api.PeeringStateFailing,
+		Dialer:   true,
+		LastNack: &ts,
+		Resources: []api.PeeringResourceStatus{
+			{
+				ResourceURL:     "type.googleapis.com/hashicorp.consul.internal.peerstream.ExportedService",
+				ResourceID:      "web",
+				LastSent:        &ts,
+				LastNack:        &ts,
+				LastNackMessage: "bad service",
+			},
+		},
+		StreamEvents: []api.PeeringStreamEvent{
+			{Time: ts, Connected: true},
+			{Time: ts, ErrorMessage: "heartbeat timeout"},
+		},
+		Dial: &api.PeeringDialDiagnostics{
+			ServerName:              "server.dc2.peering.11111111-2222-3333-4444-555555555555.consul",
+			ServerAddresses:         []string{"10.0.0.1:8502"},
+			PeerThroughMeshGateways: true,
+			GatewayAddresses:        []string{"10.0.1.1:8443"},
+			LastDialAddress:         "10.0.1.1:8443",
+			LastDialTime:            &ts,
+			ViaMeshGateway:          true,
+		},
+	})
+
+	require.Contains(t, out, "Role:         dialer")
+	require.Contains(t, out, "Last Dialed:                 10.0.1.1:8443 (local mesh gateway) at 2024-01-02T03:04:05Z")
+	require.Contains(t, out, "2024-01-02T03:04:05Z  disconnected: heartbeat timeout")
+	require.Contains(t, out, "ExportedService")
+	require.Contains(t, out, "2024-01-02T03:04:05Z (bad service)")
+}
@@ -64,6 +64,14 @@ Usage: consul peering <subcommand> [options] [args]
 
     $ consul peering read -name west-dc
 
+  Diagnose the replication stream of a peering connection:
+
+    $ consul peering diagnose -name west-dc
+
+  Rotate the stream secret of a peering connection:
+
+    $ consul peering rotate west-dc
+
   Lists services exported to a peering connection:
 
     $ consul peering exported-services -name west-dc
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rotate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/command/flags"
+)
+
+func New(ui cli.Ui) *cmd {
+	c := &cmd{UI: ui}
+	c.init()
+	return c
+}
+
+type cmd struct {
+	UI    cli.Ui
+	flags *flag.FlagSet
+	http  *flags.HTTPFlags
+	help  string
+
+	name string
+}
+
+func (c *cmd) init() {
+	c.flags = flag.NewFlagSet("", flag.ContinueOnError)
+
+	c.flags.StringVar(&c.name, "name", "", "(Required) The local name assigned to the peer cluster. "+
+		"May also be passed as the first positional argument.")
+
+	c.http = &flags.HTTPFlags{}
+	flags.Merge(c.flags, c.http.ClientFlags())
+	flags.Merge(c.flags, c.http.PartitionFlag())
+	c.help = flags.Usage(help, c.flags)
+}
+
+func (c *cmd) Run(args []string) int {
+	if err := c.flags.Parse(args); err != nil {
+		return 1
+	}
+
+	switch extra := c.flags.Args(); {
+	case len(extra) > 1:
+		c.UI.Error(fmt.Sprintf("Too many arguments (expected at most 1, got %d)", len(extra)))
+		return 1
+	case len(extra) == 1 && c.name != "" && c.name != extra[0]:
+		c.UI.Error("The peer name was given both as an argument and with the -name flag")
+		return 1
+	case len(extra) == 1:
+		c.name = extra[0]
+	}
+
+	if c.name == "" {
+		c.UI.Error("Missing the required -name flag")
+		return 1
+	}
+
+	client, err := c.http.APIClient()
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error connecting to Consul agent: %s", err))
+		return 1
+	}
+
+	peerings := client.Peerings()
+
+	_, err = peerings.RotateSecrets(context.Background(), c.name, &api.WriteOptions{})
+	if err != nil {
+		c.UI.Error(fmt.Sprintf("Error rotating secrets for peering %s: %v", c.name, err))
+		return 1
+	}
+
+	c.UI.Info(fmt.Sprintf("Successfully submitted stream secret rotation for peering connection, %s", c.name))
+	return 0
+}
+
+func (c *cmd) Synopsis() string {
+	return synopsis
+}
+
+func (c *cmd) Help() string {
+	return flags.Usage(c.help, nil)
+}
+
+const (
+	synopsis = "Rotate the stream secret of a peering connection"
+	help     = `
+Usage: consul peering rotate [options] <peer name>
+
+  Rotate the secret used by the peer cluster to authenticate its replication
+  stream. This command must be run in the cluster that generated the peering
+  token. The new secret is sent to the peer over the existing replication
+  stream, and the previous secret is invalidated once the peer acknowledges
+  it. The peering connection does not need to be re-established.
+
+  Example:
+
+    $ consul peering rotate west-dc
+`
+)
@@ -0,0 +1,438 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// Load balancing policies understood by Balancer. They match the policies
+// accepted in a service-resolver's LoadBalancer.
+const (
+	lbPolicyRandom       = "random"
+	lbPolicyRoundRobin   = "round_robin"
+	lbPolicyLeastRequest = "least_request"
+	lbPolicyRingHash     = "ring_hash"
+	lbPolicyMaglev       = "maglev"
+)
+
+const (
+	defaultLeastRequestChoiceCount = 2
+	defaultMinimumRingSize         = 1024
+	defaultMaximumRingSize         = 8 * 1024 * 1024
+
+	defaultMaxFailures        = 5
+	defaultBaseEjectionTime   = 30 * time.Second
+	maxEjectionTime           = 300 * time.Second
+	defaultMaxEjectionPercent = 10
+	defaultEnforcingPercent   = 100
+)
+
+type hashKeyContextKey struct{}
+
+// WithHashKey returns a context that carries the key used by hash based load
+// balancing policies to pick an instance. Dials made with the same key are
+// routed to the same instance while the set of instances is stable.
+func WithHashKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, hashKeyContextKey{}, key)
+}
+
+func hashKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(hashKeyContextKey{}).(string)
+	return key, ok && key != ""
+}
+
+// BalancerConfig configures a Balancer.
+type BalancerConfig struct {
+	// LoadBalancer is the load balancer configuration from the service-resolver
+	// of the service being dialed. A nil value picks instances at random.
+	LoadBalancer *api.LoadBalancer
+
+	// PassiveHealthCheck configures ejection of instances that repeatedly fail
+	// to accept connections or to present the expected certificate. A nil
+	// value disables ejection. Dials never see HTTP responses, so its
+	// EnforcingConsecutive5xx is ignored in favor of
+	// EnforcingConsecutiveGatewayFailure.
+	PassiveHealthCheck *api.PassiveHealthCheck
+
+	// EnforcingConsecutiveGatewayFailure is the % chance that an instance is
+	// actually ejected once it has failed MaxFailures consecutive dials. Like
+	// Envoy's gateway failures, these are failures to reach the instance
+	// rather than errors returned by it. Defaults to 100.
+	EnforcingConsecutiveGatewayFailure *uint32
+
+	// MetricLabels are added to every metric the Balancer emits.
+	MetricLabels []metrics.Label
+}
+
+// Balancer picks which service instance a ConsulResolver returns according to
+// a load balancing policy, and ejects instances that repeatedly fail to accept
+// connections or to present the expected certificate. Policies other than "random" rely on Service.Dial reporting the
+// outcome of each dial, so a Balancer should only be used with resolvers that
+// are dialed through a Service. A Balancer is safe for concurrent use and is
+// normally shared by every resolver for the same upstream target.
+type Balancer struct {
+	metricLabels []metrics.Label
+
+	lock      sync.Mutex
+	lb        *api.LoadBalancer
+	outlier   *api.PassiveHealthCheck
+	enforcing *uint32
+	next      uint64
+	instances map[string]*instanceStats
+	// lastSize is the number of instances passed to the last Pick, used to
+	// bound the percentage of instances that may be ejected.
+	lastSize int
+	ring     *hashRing
+
+	// now and randn are swapped out in tests.
+	now   func() time.Time
+	randn func(n int) int
+}
+
+// instanceStats is the state tracked for a single instance address.
+type instanceStats struct {
+	active              int
+	consecutiveFailures uint32
+	ejections           int
+	ejectedUntil        time.Time
+}
+
+func (s *instanceStats) empty() bool {
+	return s.active == 0 && s.consecutiveFailures == 0 && s.ejections == 0
+}
+
+// NewBalancer returns a Balancer with the given configuration.
+func NewBalancer(cfg BalancerConfig) *Balancer {
+	return &Balancer{
+		metricLabels: cfg.MetricLabels,
+		lb:           cfg.LoadBalancer,
+		outlier:      cfg.PassiveHealthCheck,
+		enforcing:    cfg.EnforcingConsecutiveGatewayFailure,
+		instances:    make(map[string]*instanceStats),
+		now:          time.Now,
+		randn:        rand.Intn,
+	}
+}
+
+// SetLoadBalancer replaces the load balancer configuration, for example when
+// the service-resolver changes.
+func (b *Balancer) SetLoadBalancer(lb *api.LoadBalancer) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.lb = lb
+	b.ring = nil
+}
+
+// SetPassiveHealthCheck replaces the outlier ejection configuration.
+func (b *Balancer) SetPassiveHealthCheck(phc *api.PassiveHealthCheck) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.outlier = phc
+}
+
+// Pick returns the index of the instance in addrs that should be dialed next.
+// Ejected instances are skipped unless every instance is ejected.
+func (b *Balancer) Pick(ctx context.Context, addrs []string) (int, error) {
+	if len(addrs) == 0 {
+		return 0, errors.New("no healthy instances found")
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.lastSize = len(addrs)
+	candidates := b.candidatesLocked(addrs)
+
+	policy := lbPolicyRandom
+	if b.lb != nil && b.lb.Policy != "" {
+		policy = b.lb.Policy
+	}
+
+	switch policy {
+	case lbPolicyRoundRobin:
+		// Sort so the rotation doesn't depend on the order instances were
+		// returned in.
+		sort.Slice(candidates, func(i, j int) bool { return addrs[candidates[i]] < addrs[candidates[j]] })
+		idx := candidates[b.next%uint64(len(candidates))]
+		b.next++
+		return idx, nil
+
+	case lbPolicyLeastRequest:
+		return b.pickLeastActiveLocked(addrs, candidates), nil
+
+	case lbPolicyRingHash, lbPolicyMaglev:
+		key, ok := hashKeyFromContext(ctx)
+		if !ok {
+			break
+		}
+		return b.pickHashLocked(addrs, candidates, key), nil
+	}
+
+	return candidates[b.randn(len(candidates))], nil
+}
+
+// candidatesLocked returns the indexes of the instances in addrs that are not
+// ejected. If ejecting every instance would leave nothing to dial, all of
+// them are returned.
+func (b *Balancer) candidatesLocked(addrs []string) []int {
+	now := b.now()
+	candidates := make([]int, 0, len(addrs))
+	for i, addr := range addrs {
+		if s, ok := b.instances[addr]; ok && now.Before(s.ejectedUntil) {
+			continue
+		}
+		candidates = append(candidates, i)
+	}
+	if len(candidates) == 0 {
+		for i := range addrs {
+			candidates = append(candidates, i)
+		}
+	}
+	return candidates
+}
+
+// pickLeastActiveLocked picks the candidate with the fewest active
+// connections out of a random sample of ChoiceCount candidates.
+func (b *Balancer) pickLeastActiveLocked(addrs []string, candidates []int) int {
+	choices := defaultLeastRequestChoiceCount
+	if b.lb.LeastRequestConfig != nil && b.lb.LeastRequestConfig.ChoiceCount > 0 {
+		choices = int(b.lb.LeastRequestConfig.ChoiceCount)
+	}
+
+	sample := candidates
+	if choices < len(candidates) {
+		sample = make([]int, 0, choices)
+		for _, i := range rand.Perm(len(candidates))[:choices] {
+			sample = append(sample, candidates[i])
+		}
+	}
+
+	best, bestActive := -1, 0
+	for _, idx := range sample {
+		active := 0
+		if s, ok := b.instances[addrs[idx]]; ok {
+			active = s.active
+		}
+		if best == -1 || active < bestActive {
+			best, bestActive = idx, active
+		}
+	}
+	return best
+}
+
+// pickHashLocked picks a candidate with a consistent hash ring built over the
+// candidates.
+func (b *Balancer) pickHashLocked(addrs []string, candidates []int, key string) int {
+	hosts := make([]string, 0, len(candidates))
+	for _, idx := range candidates {
+		hosts = append(hosts, addrs[idx])
+	}
+	sort.Strings(hosts)
+
+	if b.ring == nil || !b.ring.builtFor(hosts) {
+		minSize, maxSize := uint64(defaultMinimumRingSize), uint64(defaultMaximumRingSize)
+		if b.lb.RingHashConfig != nil {
+			if b.lb.RingHashConfig.MinimumRingSize > 0 {
+				minSize = b.lb.RingHashConfig.MinimumRingSize
+			}
+			if b.lb.RingHashConfig.MaximumRingSize > 0 {
+				maxSize = b.lb.RingHashConfig.MaximumRingSize
+			}
+		}
+		b.ring = newHashRing(hosts, minSize, maxSize)
+	}
+
+	host := b.ring.lookup(key)
+	for _, idx := range candidates {
+		if addrs[idx] == host {
+			return idx
+		}
+	}
+	// Unreachable since the ring was built from the candidates.
+	return candidates[0]
+}
+
+// dialed records the outcome of dialing addr. When the dial succeeded it
+// returns a func that must be called once the connection is closed.
+func (b *Balancer) dialed(addr string, err error) func() {
+	labels := append([]metrics.Label{{Name: "instance", Value: addr}}, b.metricLabels...)
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	s, ok := b.instances[addr]
+	if !ok {
+		s = &instanceStats{}
+		b.instances[addr] = s
+	}
+
+	if err != nil {
+		s.consecutiveFailures++
+		metrics.IncrCounterWithLabels([]string{"balancer", "dial_failures"}, 1, labels)
+		b.maybeEjectLocked(addr, s, labels)
+		return nil
+	}
+
+	s.consecutiveFailures = 0
+	// Gradually forget earlier ejections once the instance is healthy again.
+	if s.ejections > 0 && !b.now().Before(s.ejectedUntil) {
+		s.ejections--
+	}
+	s.active++
+	metrics.SetGaugeWithLabels([]string{"balancer", "active_conns"}, float32(s.active), labels)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.lock.Lock()
+			defer b.lock.Unlock()
+			s.active--
+			metrics.SetGaugeWithLabels([]string{"balancer", "active_conns"}, float32(s.active), labels)
+			if s.empty() && b.instances[addr] == s {
+				delete(b.instances, addr)
+			}
+		})
+	}
+}
+
+// maybeEjectLocked ejects addr if it has failed enough consecutive dials and
+// ejecting it wouldn't exceed the maximum ejection percentage.
+func (b *Balancer) maybeEjectLocked(addr string, s *instanceStats, labels []metrics.Label) {
+	if b.outlier == nil {
+		return
+	}
+
+	maxFailures := uint32(defaultMaxFailures)
+	if b.outlier.MaxFailures > 0 {
+		maxFailures = b.outlier.MaxFailures
+	}
+	now := b.now()
+	if s.consecutiveFailures < maxFailures || now.Before(s.ejectedUntil) {
+		return
+	}
+
+	enforcing := uint32(defaultEnforcingPercent)
+	if b.enforcing != nil {
+		enforcing = *b.enforcing
+	}
+	if uint32(b.randn(100)) >= enforcing {
+		return
+	}
+
+	maxPercent := uint32(defaultMaxEjectionPercent)
+	if b.outlier.MaxEjectionPercent != nil {
+		maxPercent = *b.outlier.MaxEjectionPercent
+	}
+	ejected := 0
+	for _, other := range b.instances {
+		if now.Before(other.ejectedUntil) {
+			ejected++
+		}
+	}
+	// Like Envoy, always allow at least one instance to be ejected.
+	total := b.lastSize
+	if total < len(b.instances) {
+		total = len(b.instances)
+	}
+	if ejected > 0 && uint32((ejected+1)*100/total) > maxPercent {
+		return
+	}
+
+	base := defaultBaseEjectionTime
+	if b.outlier.BaseEjectionTime != nil {
+		base = *b.outlier.BaseEjectionTime
+	}
+	s.ejections++
+	ejection := time.Duration(s.ejections) * base
+	if ejection > maxEjectionTime {
+		ejection = maxEjectionTime
+	}
+	s.ejectedUntil = now.Add(ejection)
+	s.consecutiveFailures = 0
+	metrics.IncrCounterWithLabels([]string{"balancer", "ejections"}, 1, labels)
+}
+
+// hashRing is a consistent hash ring over a set of hosts.
+type hashRing struct {
+	hosts  string
+	hashes []uint64
+	owners []string
+}
+
+func newHashRing(hosts []string, minSize, maxSize uint64) *hashRing {
+	size := minSize
+	if size < uint64(len(hosts)) {
+		size = uint64(len(hosts))
+	}
+	if size > maxSize {
+		size = maxSize
+	}
+	perHost := size / uint64(len(hosts))
+	if perHost == 0 {
+		perHost = 1
+	}
+
+	type entry struct {
+		hash  uint64
+		owner string
+	}
+	entries := make([]entry, 0, perHost*uint64(len(hosts)))
+	for _, host := range hosts {
+		for i := uint64(0); i < perHost; i++ {
+			entries = append(entries, entry{hash: hashString(host + "_" + strconv.FormatUint(i, 10)), owner: host})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].hash < entries[j].hash })
+
+	r := &hashRing{
+		hosts:  strings.Join(hosts, ","),
+		hashes: make([]uint64, len(entries)),
+		owners: make([]string, len(entries)),
+	}
+	for i, e := range entries {
+		r.hashes[i] = e.hash
+		r.owners[i] = e.owner
+	}
+	return r
+}
+
+func (r *hashRing) builtFor(hosts []string) bool {
+	return r.hosts == strings.Join(hosts, ",")
+}
+
+func (r *hashRing) lookup(key string) string {
+	h := hashString(key)
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+	return r.owners[i]
+}
+
+// hashString hashes s with FNV-1a followed by a 64 bit finalizer, since FNV
+// alone spreads keys that only differ in their last bytes poorly.
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package connect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func TestBalancer_RoundRobin(t *testing.T) {
+	b := NewBalancer(BalancerConfig{
+		LoadBalancer: &api.LoadBalancer{Policy: "round_robin"},
+	})
+
+	addrs := []string{"10.0.0.3:80", "10.0.0.1:80", "10.0.0.2:80"}
+	var got []string
+	for i := 0; i < 6; i++ {
+		idx, err := b.Pick(context.Background(), addrs)
+		require.NoError(t, err)
+		got = append(got, addrs[idx])
+	}
+	require.Equal(t, []string{
+		"10.0.0.1:80", "10.0.0.2:80", "10.0.0.3:80",
+		"10.0.0.1:80", "10.0.0.2:80", "10.0.0.3:80",
+	}, got)
+}
+
+func TestBalancer_LeastRequest(t *testing.T) {
+	b := NewBalancer(BalancerConfig{
+		LoadBalancer: &api.LoadBalancer{
+			Policy:             "least_request",
+			LeastRequestConfig: &api.LeastRequestConfig{ChoiceCount: 3},
+		},
+	})
+
+	addrs := []string{"10.0.0.1:80", "10.0.0.2:80", "10.0.0.3:80"}
+	// Open two conns to .1 and one to .3, leaving .2 the least loaded.
+	closeA := b.dialed(addrs[0], nil)
+	b.dialed(addrs[0], nil)
+	b.dialed(addrs[2], nil)
+
+	idx, err := b.Pick(context.Background(), addrs)
+	require.NoError(t, err)
+	require.Equal(t, 1, idx)
+
+	// Closing conns updates the counts.
+	b.dialed(addrs[1], nil)
+	b.dialed(addrs[1], nil)
+	closeA()
+	idx, err = b.Pick(context.Background(), addrs)
+	require.NoError(t, err)
+	require.Contains(t, []int{0, 2}, idx)
+}
+
+func TestBalancer_RingHash(t *testing.T) {
+	b := NewBalancer(BalancerConfig{
+		LoadBalancer: &api.LoadBalancer{
+			Policy:         "ring_hash",
+			RingHashConfig: &api.RingHashConfig{MinimumRingSize: 64},
+		},
+	})
+
+	addrs := []string{"10.0.0.1:80", "10.0.0.2:80", "10.0.0.3:80", "10.0.0.4:80"}
+	seen := make(map[int]bool)
+	for i := 0; i < 50; i++ {
+		ctx := WithHashKey(context.Background(), fmt.Sprintf("user-%d", i))
+		first, err := b.Pick(ctx, addrs)
+		require.NoError(t, err)
+		seen[first] = true
+
+		// The same key always picks the same instance, regardless of order.
+		reversed := []string{addrs[3], addrs[2], addrs[1], addrs[0]}
+		second, err := b.Pick(ctx, reversed)
+		require.NoError(t, err)
+		require.Equal(t, addrs[first], reversed[second])
+	}
+	require.Greater(t, len(seen), 1, "keys should spread across instances")
+}
+
+func TestBalancer_OutlierEjection(t *testing.T) {
+	now := time.Now()
+	base := 10 * time.Second
+	maxPercent := uint32(50)
+	b := NewBalancer(BalancerConfig{
+		LoadBalancer: &api.LoadBalancer{Policy: "round_robin"},
+		PassiveHealthCheck: &api.PassiveHealthCheck{
+			MaxFailures:        2,
+			BaseEjectionTime:   &base,
+			MaxEjectionPercent: &maxPercent,
+		},
+	})
+	b.now = func() time.Time { return now }
+
+	addrs := []string{"10.0.0.1:80", "10.0.0.2:80", "10.0.0.3:80", "10.0.0.4:80"}
+	_, err := b.Pick(context.Background(), addrs)
+	require.NoError(t, err)
+
+	dialErr := errors.New("connection refused")
+	pickAll := func() map[string]bool {
+		picked := make(map[string]bool)
+		for i := 0; i < len(addrs); i++ {
+			idx, err := b.Pick(context.Background(), addrs)
+			require.NoError(t, err)
+			picked[addrs[idx]] = true
+		}
+		return picked
+	}
+
+	// One failure isn't enough to eject.
+	b.dialed(addrs[0], dialErr)
+	require.True(t, pickAll()[addrs[0]])
+
+	// The second consecutive failure ejects the instance.
+	b.dialed(addrs[0], dialErr)
+	require.False(t, pickAll()[addrs[0]])
+
+	// A second instance may be ejected but not a third, which would exceed 50%.
+	b.dialed(addrs[1], dialErr)
+	b.dialed(addrs[1], dialErr)
+	b.dialed(addrs[2], dialErr)
+	b.dialed(addrs[2], dialErr)
+	picked := pickAll()
+	require.False(t, picked[addrs[0]])
+	require.False(t, picked[addrs[1]])
+	require.True(t, picked[addrs[2]])
+
+	// Instances return once the ejection time has passed.
+	now = now.Add(base)
+	require.Len(t, pickAll(), 4)
+}
+
+func TestBalancer_OutlierEjectionEnforcing(t *testing.T) {
+	never, always := uint32(0), uint32(100)
+	b := NewBalancer(BalancerConfig{
+		PassiveHealthCheck: &api.PassiveHealthCheck{
+			MaxFailures:             1,
+			EnforcingConsecutive5xx: &always,
+		},
+		EnforcingConsecutiveGatewayFailure: &never,
+	})
+
+	addrs := []string{"10.0.0.1:80", "10.0.0.2:80"}
+	_, err := b.Pick(context.Background(), addrs)
+	require.NoError(t, err)
+
+	// Dial failures are gated on the gateway failure enforcement rather than
+	// the 5xx one.
+	b.dialed(addrs[0], errors.New("connection refused"))
+	require.False(t, b.instances[addrs[0]].ejectedUntil.After(b.now()))
+}
+
+func TestBalancer_AllEjected(t *testing.T) {
+	maxPercent := uint32(100)
+	b := NewBalancer(BalancerConfig{
+		PassiveHealthCheck: &api.PassiveHealthCheck{
+			MaxFailures:        1,
+			MaxEjectionPercent: &maxPercent,
+		},
+	})
+
+	addrs := []string{"10.0.0.1:80"}
+	_, err := b.Pick(context.Background(), addrs)
+	require.NoError(t, err)
+	b.dialed(addrs[0], errors.New("connection refused"))
+
+	// With nothing left to dial the ejected instance is still returned.
+	idx, err := b.Pick(context.Background(), addrs)
+	require.NoError(t, err)
+	require.Equal(t, 0, idx)
+
+	_, err = b.Pick(context.Background(), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/lib/retry"
+)
+
+// watchDiscoveryChain calls onChange with the upstream's compiled discovery
+// chain each time it changes, until stopCh is closed.
+func watchDiscoveryChain(client *api.Client, cfg UpstreamConfig, logger hclog.Logger,
+	stopCh <-chan struct{}, onChange func(*api.CompiledDiscoveryChain)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	waiter := &retry.Waiter{MinFailures: 1, MinWait: time.Second, MaxWait: time.Minute}
+	var index uint64
+	for ctx.Err() == nil {
+		q := &api.QueryOptions{WaitIndex: index}
+		// applyDefaults fills in the default tenancy, which CE rejects if sent.
+		if cfg.DestinationNamespace != "default" {
+			q.Namespace = cfg.DestinationNamespace
+		}
+		if cfg.DestinationPartition != "default" {
+			q.Partition = cfg.DestinationPartition
+		}
+		resp, meta, err := client.DiscoveryChain().Get(cfg.DestinationName,
+			&api.DiscoveryChainOptions{EvaluateInDatacenter: cfg.Datacenter}, q.WithContext(ctx))
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Error("failed to fetch discovery chain", "upstream", cfg.DestinationName, "error", err)
+			if err := waiter.Wait(ctx); err != nil {
+				return
+			}
+			continue
+		}
+		waiter.Reset()
+
+		if meta.LastIndex < index {
+			index = 0
+		} else {
+			index = meta.LastIndex
+		}
+		onChange(resp.Chain)
+	}
+}
+
+// startLoadBalancer returns the load balancer configuration of the resolver
+// the chain starts with, which is where TCP chains always start.
+func startLoadBalancer(chain *api.CompiledDiscoveryChain) *api.LoadBalancer {
+	node, ok := chain.Nodes[chain.StartNode]
+	if !ok || node.Type != api.DiscoveryGraphNodeTypeResolver {
+		return nil
+	}
+	return node.LoadBalancer
+}
@@ -73,6 +73,12 @@ type PublicListenerConfig struct {
 	// handshake. Setting this low avoids DOS by malicious clients holding
 	// resources open. Defaults to 10000 (10s).
 	HandshakeTimeoutMs int `json:"handshake_timeout_ms" hcl:"handshake_timeout_ms" mapstructure:"handshake_timeout_ms"`
+
+	// Protocol is the protocol of the proxied application, normally set from
+	// its service-defaults. When it is "http" the listener terminates HTTP and
+	// enforces L7 intentions on each request, otherwise connections are
+	// proxied as opaque TCP.
+	Protocol string `json:"protocol" hcl:"protocol" mapstructure:"protocol"`
 }
 
 // applyDefaults sets zero-valued params to a reasonable default.
@@ -101,6 +107,38 @@ func (uc *UpstreamConfig) ConnectTimeout() time.Duration {
 	return 10000 * time.Millisecond
 }
 
+// Protocol returns the protocol field of the nested config struct, which the
+// agent sets from the upstream's service-defaults, or "tcp" if it is not set.
+func (uc *UpstreamConfig) Protocol() string {
+	if protocol, ok := uc.Config["protocol"].(string); ok && protocol != "" {
+		return protocol
+	}
+	return "tcp"
+}
+
+// PassiveHealthCheck returns the passive_health_check field of the nested
+// config struct, which the agent sets from the upstream config in
+// service-defaults, or nil if it is not set or can't be parsed.
+func (uc *UpstreamConfig) PassiveHealthCheck() *api.PassiveHealthCheck {
+	raw, ok := uc.Config["passive_health_check"]
+	if !ok || raw == nil {
+		return nil
+	}
+	var phc api.PassiveHealthCheck
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           &phc,
+	})
+	if err != nil {
+		return nil
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return nil
+	}
+	return &phc
+}
+
 // applyDefaults sets zero-valued params to a reasonable default.
 func (uc *UpstreamConfig) applyDefaults() {
 	if uc.DestinationType == "" {
@@ -86,6 +86,28 @@ func TestUpstreamResolverFuncFromClient(t *testing.T) {
 	}
 }
 
+func TestUpstreamConfig_PassiveHealthCheck(t *testing.T) {
+	uc := UpstreamConfig{}
+	require.Nil(t, uc.PassiveHealthCheck())
+
+	// The agent API delivers durations as nanoseconds decoded from JSON.
+	uc.Config = map[string]interface{}{
+		"passive_health_check": map[string]interface{}{
+			"MaxFailures":        float64(3),
+			"BaseEjectionTime":   float64(5 * time.Second),
+			"MaxEjectionPercent": float64(20),
+		},
+	}
+	phc := uc.PassiveHealthCheck()
+	require.NotNil(t, phc)
+	require.Equal(t, uint32(3), phc.MaxFailures)
+	require.Equal(t, 5*time.Second, *phc.BaseEjectionTime)
+	require.Equal(t, uint32(20), *phc.MaxEjectionPercent)
+
+	uc.Config["passive_health_check"] = map[string]interface{}{"BaseEjectionTime": "10s"}
+	require.Equal(t, 10*time.Second, *uc.PassiveHealthCheck().BaseEjectionTime)
+}
+
 func TestAgentConfigWatcherSidecarProxy(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	// denialReportInterval is how often denied requests are reported to the
+	// local agent.
+	denialReportInterval = 10 * time.Second
+
+	// maxPendingDenials bounds the number of denied requests queued between
+	// two reports. Denials queued while the limit is reached are dropped.
+	maxPendingDenials = 256
+)
+
+// denialReporter reports the requests denied by intentions to the local
+// agent, which aggregates them for /v1/connect/intentions/denials.
+type denialReporter struct {
+	client  *api.Client
+	logger  hclog.Logger
+	pending chan *api.IntentionDenial
+}
+
+func newDenialReporter(client *api.Client, logger hclog.Logger) *denialReporter {
+	return &denialReporter{
+		client:  client,
+		logger:  logger,
+		pending: make(chan *api.IntentionDenial, maxPendingDenials),
+	}
+}
+
+// Report queues a denial without blocking.
+func (r *denialReporter) Report(denial *api.IntentionDenial) {
+	if denial.Timestamp.IsZero() {
+		denial.Timestamp = time.Now()
+	}
+	select {
+	case r.pending <- denial:
+	default:
+		r.logger.Debug("dropping intention denial, too many are pending")
+	}
+}
+
+// run reports the queued denials until stopCh is closed.
+func (r *denialReporter) run(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(denialReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.flush()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (r *denialReporter) flush() {
+	var denials []*api.IntentionDenial
+	for {
+		select {
+		case denial := <-r.pending:
+			denials = append(denials, denial)
+			continue
+		default:
+		}
+		break
+	}
+	if len(denials) == 0 {
+		return
+	}
+
+	if _, err := r.client.Connect().IntentionReportDenials(denials, nil); err != nil {
+		r.logger.Error("failed to report intention denials", "error", err)
+	}
+}
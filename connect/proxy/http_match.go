@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// isHTTPProtocol reports whether the built-in proxy should terminate HTTP for
+// the given protocol. http2 and grpc are still proxied as opaque TCP.
+func isHTTPProtocol(protocol string) bool {
+	return protocol == "http"
+}
+
+// headerMatch holds the fields shared by intention and service-router header
+// matches.
+type headerMatch struct {
+	Name    string
+	Present bool
+	Exact   string
+	Prefix  string
+	Suffix  string
+	Regex   string
+	Invert  bool
+}
+
+// matches evaluates the header match against the request headers. A match with
+// no criteria other than the name matches when the header is present.
+func (m headerMatch) matches(h http.Header, re regexps) bool {
+	values, present := h[http.CanonicalHeaderKey(m.Name)]
+	value := strings.Join(values, ",")
+
+	var ok bool
+	switch {
+	case m.Exact != "":
+		ok = present && value == m.Exact
+	case m.Prefix != "":
+		ok = present && strings.HasPrefix(value, m.Prefix)
+	case m.Suffix != "":
+		ok = present && strings.HasSuffix(value, m.Suffix)
+	case m.Regex != "":
+		ok = present && re.fullMatch(m.Regex, value, false)
+	default:
+		ok = present
+	}
+
+	if m.Invert {
+		return !ok
+	}
+	return ok
+}
+
+// pathMatches evaluates the exact, prefix and regex path criteria. An empty
+// criteria set matches every path.
+func pathMatches(exact, prefix, regex string, caseInsensitive bool, path string, re regexps) bool {
+	if caseInsensitive {
+		path = strings.ToLower(path)
+		exact = strings.ToLower(exact)
+		prefix = strings.ToLower(prefix)
+	}
+	switch {
+	case exact != "":
+		return path == exact
+	case prefix != "":
+		return strings.HasPrefix(path, prefix)
+	case regex != "":
+		return re.fullMatch(regex, path, caseInsensitive)
+	}
+	return true
+}
+
+// methodMatches reports whether method is in methods. An empty list matches
+// every method.
+func methodMatches(methods []string, method string) bool {
+	if len(methods) == 0 {
+		return true
+	}
+	for _, m := range methods {
+		if strings.EqualFold(m, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// regexps holds the regular expressions of a loaded config, compiled once so
+// that requests don't have to. Expressions are anchored to match Envoy's RE2
+// full match semantics.
+type regexps map[string]*regexp.Regexp
+
+func regexpKey(expr string, caseInsensitive bool) string {
+	if caseInsensitive {
+		return "(?i)" + expr
+	}
+	return expr
+}
+
+// add compiles expr unless it is empty or was already added. Invalid
+// expressions are stored as nil and never match since config entry validation
+// already rejects them.
+func (r regexps) add(expr string, caseInsensitive bool) {
+	if expr == "" {
+		return
+	}
+	key := regexpKey(expr, caseInsensitive)
+	if _, ok := r[key]; ok {
+		return
+	}
+	re, err := regexp.Compile("^(?:" + key + ")$")
+	if err != nil {
+		re = nil
+	}
+	r[key] = re
+}
+
+// fullMatch reports whether the whole of s matches expr. Expressions that were
+// not added never match.
+func (r regexps) fullMatch(expr, s string, caseInsensitive bool) bool {
+	re := r[regexpKey(expr, caseInsensitive)]
+	return re != nil && re.MatchString(s)
+}
+
+// intentionRegexps compiles the regular expressions of the L7 permissions of
+// intentions.
+func intentionRegexps(ixns []*api.Intention) regexps {
+	re := make(regexps)
+	for _, ixn := range ixns {
+		for _, perm := range ixn.Permissions {
+			if perm == nil || perm.HTTP == nil {
+				continue
+			}
+			re.add(perm.HTTP.PathRegex, false)
+			for _, h := range perm.HTTP.Header {
+				re.add(h.Regex, false)
+			}
+		}
+	}
+	return re
+}
+
+// chainRegexps compiles the regular expressions of the service-router route
+// matches of a discovery chain.
+func chainRegexps(chain *api.CompiledDiscoveryChain) regexps {
+	re := make(regexps)
+	for _, node := range chain.Nodes {
+		for _, r := range node.Routes {
+			if r.Definition == nil || r.Definition.Match == nil || r.Definition.Match.HTTP == nil {
+				continue
+			}
+			m := r.Definition.Match.HTTP
+			re.add(m.PathRegex, m.CaseInsensitive)
+			for _, h := range m.Header {
+				re.add(h.Regex, false)
+			}
+			for _, q := range m.QueryParam {
+				re.add(q.Regex, false)
+			}
+		}
+	}
+	return re
+}
+
+// intentionPermissionMatches reports whether the HTTP criteria of an L7
+// intention permission match the request. Permissions that require a JWT never
+// match because the built-in proxy does not validate tokens.
+func intentionPermissionMatches(perm *api.IntentionPermission, req *http.Request, re regexps) bool {
+	if perm == nil || perm.JWT != nil {
+		return false
+	}
+	if perm.HTTP == nil {
+		return true
+	}
+	p := perm.HTTP
+	if !pathMatches(p.PathExact, p.PathPrefix, p.PathRegex, false, req.URL.Path, re) {
+		return false
+	}
+	for _, h := range p.Header {
+		if !headerMatch(h).matches(req.Header, re) {
+			return false
+		}
+	}
+	return methodMatches(p.Methods, req.Method)
+}
+
+// routeMatches reports whether a service-router route matches the request. A
+// route without HTTP criteria matches every request.
+func routeMatches(match *api.ServiceRouteMatch, req *http.Request, re regexps) bool {
+	if match == nil || match.HTTP == nil {
+		return true
+	}
+	m := match.HTTP
+	if !pathMatches(m.PathExact, m.PathPrefix, m.PathRegex, m.CaseInsensitive, req.URL.Path, re) {
+		return false
+	}
+	for _, h := range m.Header {
+		if !headerMatch(h).matches(req.Header, re) {
+			return false
+		}
+	}
+	query := req.URL.Query()
+	for _, q := range m.QueryParam {
+		values, present := query[q.Name]
+		switch {
+		case q.Exact != "":
+			if !present || values[0] != q.Exact {
+				return false
+			}
+		case q.Regex != "":
+			if !present || !re.fullMatch(q.Regex, values[0], false) {
+				return false
+			}
+		default:
+			if !present {
+				return false
+			}
+		}
+	}
+	return methodMatches(m.Methods, req.Method)
+}
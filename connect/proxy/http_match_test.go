@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func TestIntentionPermissionMatches(t *testing.T) {
+	cases := map[string]struct {
+		perm   *api.IntentionPermission
+		method string
+		target string
+		header map[string]string
+		expect bool
+	}{
+		"no http criteria": {
+			perm:   &api.IntentionPermission{Action: api.IntentionActionAllow},
+			method: "GET",
+			target: "/anything",
+			expect: true,
+		},
+		"path prefix": {
+			perm: &api.IntentionPermission{
+				HTTP: &api.IntentionHTTPPermission{PathPrefix: "/admin"},
+			},
+			method: "GET",
+			target: "/admin/users",
+			expect: true,
+		},
+		"path prefix mismatch": {
+			perm: &api.IntentionPermission{
+				HTTP: &api.IntentionHTTPPermission{PathPrefix: "/admin"},
+			},
+			method: "GET",
+			target: "/users",
+		},
+		"path exact": {
+			perm: &api.IntentionPermission{
+				HTTP: &api.IntentionHTTPPermission{PathExact: "/health"},
+			},
+			method: "GET",
+			target: "/health/deep",
+		},
+		"path regex is anchored": {
+			perm: &api.IntentionPermission{
+				HTTP: &api.IntentionHTTPPermission{PathRegex: "/v[0-9]+"},
+			},
+			method: "GET",
+			target: "/v1/x",
+		},
+		"path regex": {
+			perm: &api.IntentionPermission{
+				HTTP: &api.IntentionHTTPPermission{PathRegex: "/v[0-9]+/.*"},
+			},
+			method: "GET",
+			target: "/v12/x",
+			expect: true,
+		},
+		"header regex": {
+			perm: &api.IntentionPermission{
+				HTTP: &api.IntentionHTTPPermission{
+					Header: []api.IntentionHTTPHeaderPermission{{Name: "x-env", Regex: "prod|staging"}},
+				},
+			},
+			method: "GET",
+			target: "/",
+			header: map[string]string{"X-Env": "staging"},
+			expect: true,
+		},
+		"method": {
+			perm: &api.IntentionPermission{
+				HTTP: &api.IntentionHTTPPermission{Methods: []string{"POST", "PUT"}},
+			},
+			method: "PUT",
+			target: "/",
+			expect: true,
+		},
+		"method mismatch": {
+			perm: &api.IntentionPermission{
+				HTTP: &api.IntentionHTTPPermission{Methods: []string{"POST", "PUT"}},
+			},
+			method: "GET",
+			target: "/",
+		},
+		"header exact": {
+			perm: &api.IntentionPermission{
+				HTTP: &api.IntentionHTTPPermission{
+					Header: []api.IntentionHTTPHeaderPermission{{Name: "x-env", Exact: "prod"}},
+				},
+			},
+			method: "GET",
+			target: "/",
+			header: map[string]string{"X-Env": "prod"},
+			expect: true,
+		},
+		"header inverted": {
+			perm: &api.IntentionPermission{
+				HTTP: &api.IntentionHTTPPermission{
+					Header: []api.IntentionHTTPHeaderPermission{{Name: "x-env", Exact: "prod", Invert: true}},
+				},
+			},
+			method: "GET",
+			target: "/",
+			header: map[string]string{"X-Env": "prod"},
+		},
+		"header present": {
+			perm: &api.IntentionPermission{
+				HTTP: &api.IntentionHTTPPermission{
+					Header: []api.IntentionHTTPHeaderPermission{{Name: "authorization", Present: true}},
+				},
+			},
+			method: "GET",
+			target: "/",
+		},
+		"jwt never matches": {
+			perm: &api.IntentionPermission{
+				JWT: &api.IntentionJWTRequirement{},
+			},
+			method: "GET",
+			target: "/",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.target, nil)
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			re := intentionRegexps([]*api.Intention{{Permissions: []*api.IntentionPermission{tc.perm}}})
+			require.Equal(t, tc.expect, intentionPermissionMatches(tc.perm, req, re))
+		})
+	}
+}
+
+func TestRouteRequest(t *testing.T) {
+	chain := &api.CompiledDiscoveryChain{
+		ServiceName: "web",
+		StartNode:   "router:web",
+		Nodes: map[string]*api.DiscoveryGraphNode{
+			"router:web": {
+				Type: api.DiscoveryGraphNodeTypeRouter,
+				Name: "web",
+				Routes: []*api.DiscoveryRoute{
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{HTTP: &api.ServiceRouteHTTPMatch{
+								PathPrefix:      "/ADMIN",
+								CaseInsensitive: true,
+							}},
+							Destination: &api.ServiceRouteDestination{Service: "admin"},
+						},
+						NextNode: "resolver:admin",
+					},
+					{
+						Definition: &api.ServiceRoute{
+							Match: &api.ServiceRouteMatch{HTTP: &api.ServiceRouteHTTPMatch{
+								QueryParam: []api.ServiceRouteHTTPMatchQueryParam{{Name: "canary", Exact: "1"}},
+							}},
+						},
+						NextNode: "splitter:web",
+					},
+					{
+						Definition: &api.ServiceRoute{},
+						NextNode:   "resolver:web",
+					},
+				},
+			},
+			"splitter:web": {
+				Type: api.DiscoveryGraphNodeTypeSplitter,
+				Name: "web",
+				Splits: []*api.DiscoverySplit{
+					{Weight: 100, NextNode: "resolver:web-v2"},
+				},
+			},
+			"resolver:admin":  {Type: api.DiscoveryGraphNodeTypeResolver, Resolver: &api.DiscoveryResolver{Target: "admin"}},
+			"resolver:web":    {Type: api.DiscoveryGraphNodeTypeResolver, Resolver: &api.DiscoveryResolver{Target: "web"}},
+			"resolver:web-v2": {Type: api.DiscoveryGraphNodeTypeResolver, Resolver: &api.DiscoveryResolver{Target: "web-v2"}},
+		},
+		Targets: map[string]*api.DiscoveryTarget{
+			"admin":  {ID: "admin"},
+			"web":    {ID: "web"},
+			"web-v2": {ID: "web-v2"},
+		},
+	}
+
+	cases := map[string]struct {
+		target       string
+		expectTarget string
+		expectRoute  string
+	}{
+		"case insensitive prefix": {target: "/admin/users", expectTarget: "admin", expectRoute: "admin"},
+		"query param to splitter": {target: "/?canary=1", expectTarget: "web-v2"},
+		"default route":           {target: "/", expectTarget: "web"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			route, node, err := routeRequest(chain, chainRegexps(chain), httptest.NewRequest("GET", tc.target, nil))
+			require.NoError(t, err)
+			require.Equal(t, tc.expectTarget, node.Resolver.Target)
+			require.NotNil(t, route)
+			if tc.expectRoute != "" {
+				require.Equal(t, tc.expectRoute, route.Destination.Service)
+			}
+		})
+	}
+
+	t.Run("missing node", func(t *testing.T) {
+		_, _, err := routeRequest(&api.CompiledDiscoveryChain{StartNode: "nope"}, nil, httptest.NewRequest("GET", "/", nil))
+		require.Error(t, err)
+	})
+}
+
+func TestPickSplit(t *testing.T) {
+	splits := []*api.DiscoverySplit{
+		{Weight: 90, NextNode: "a"},
+		{Weight: 10, NextNode: "b"},
+	}
+	require.Equal(t, "a", pickSplit(splits, 0))
+	require.Equal(t, "a", pickSplit(splits, 89.9))
+	require.Equal(t, "b", pickSplit(splits, 90))
+	require.Equal(t, "b", pickSplit(splits, 100))
+}
+
+func TestRewritePrefix(t *testing.T) {
+	route := &api.ServiceRoute{
+		Match: &api.ServiceRouteMatch{HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/v2/"}},
+		Destination: &api.ServiceRouteDestination{
+			PrefixRewrite: "/",
+		},
+	}
+	req := httptest.NewRequest("GET", "/v2/users?x=1", nil)
+	rewritePrefix(req, route)
+	require.Equal(t, "/users", req.URL.Path)
+	require.Equal(t, "x=1", req.URL.RawQuery)
+}
+
+func TestRequestHashKey(t *testing.T) {
+	req := httptest.NewRequest("GET", "/?user=alice", nil)
+	req.RemoteAddr = "10.0.0.9:51234"
+	req.Header.Set("X-Tenant", "acme")
+
+	require.Equal(t, "acme", requestHashKey([]api.HashPolicy{
+		{Field: "header", FieldValue: "x-tenant"},
+	}, req))
+	require.Equal(t, "10.0.0.9", requestHashKey([]api.HashPolicy{{SourceIP: true}}, req))
+
+	// Missing values are skipped and evaluation stops at a terminal policy.
+	require.Equal(t, "alice", requestHashKey([]api.HashPolicy{
+		{Field: "cookie", FieldValue: "session"},
+		{Field: "query_parameter", FieldValue: "user", Terminal: true},
+		{Field: "header", FieldValue: "x-tenant"},
+	}, req))
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransport(t *testing.T) {
+	dest := &api.ServiceRouteDestination{
+		NumRetries:            2,
+		RetryOnConnectFailure: true,
+		RetryOnStatusCodes:    []uint32{503},
+	}
+
+	run := func(method string, result func() (*http.Response, error)) int {
+		var attempts int
+		rt := &retryTransport{
+			base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+				attempts++
+				return result()
+			}),
+			dest: dest,
+		}
+		resp, _ := rt.RoundTrip(httptest.NewRequest(method, "/", nil))
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return attempts
+	}
+	unavailable := func() (*http.Response, error) {
+		return &http.Response{StatusCode: 503, Body: http.NoBody}, nil
+	}
+	connectFailure := func() (*http.Response, error) {
+		return nil, &connectFailureError{err: errors.New("connection refused")}
+	}
+	reset := func() (*http.Response, error) {
+		return nil, errors.New("connection reset by peer")
+	}
+
+	require.Equal(t, 3, run("GET", unavailable))
+	require.Equal(t, 1, run("POST", unavailable))
+
+	require.Equal(t, 3, run("GET", connectFailure))
+	require.Equal(t, 3, run("POST", connectFailure))
+
+	require.Equal(t, 3, run("GET", reset))
+	require.Equal(t, 1, run("POST", reset))
+}
+
+func TestFailoverTransport(t *testing.T) {
+	var attempts []string
+	target := func(name string, err error) http.RoundTripper {
+		return roundTripFunc(func(*http.Request) (*http.Response, error) {
+			attempts = append(attempts, name)
+			if err != nil {
+				return nil, err
+			}
+			return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+		})
+	}
+	connectFailure := &connectFailureError{err: errors.New("connection refused")}
+
+	rt := failoverTransport{target("primary", connectFailure), target("failover", nil)}
+	resp, err := rt.RoundTrip(httptest.NewRequest("POST", "/", nil))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, []string{"primary", "failover"}, attempts)
+
+	// Requests that may have reached the target are not sent to another one.
+	attempts = nil
+	rt = failoverTransport{target("primary", errors.New("connection reset by peer")), target("failover", nil)}
+	_, err = rt.RoundTrip(httptest.NewRequest("POST", "/", nil))
+	require.Error(t, err)
+	require.Equal(t, []string{"primary"}, attempts)
+}
+
+func TestUpstreamHTTPHandler_Targets(t *testing.T) {
+	h := &upstreamHTTPHandler{
+		cfg:     UpstreamConfig{DestinationName: "web"},
+		logger:  hclog.NewNullLogger(),
+		readyCh: make(chan struct{}),
+		targets: make(map[string]*upstreamTarget),
+	}
+
+	chain := func(connectTimeout time.Duration) *api.CompiledDiscoveryChain {
+		return &api.CompiledDiscoveryChain{
+			ServiceName: "web",
+			StartNode:   "resolver:web.default.default.dc1",
+			Nodes: map[string]*api.DiscoveryGraphNode{
+				"resolver:web.default.default.dc1": {
+					Type: api.DiscoveryGraphNodeTypeResolver,
+					Name: "web.default.default.dc1",
+					Resolver: &api.DiscoveryResolver{
+						Target:   "web.default.default.dc1",
+						Failover: &api.DiscoveryFailover{Targets: []string{"web.default.default.external.peer1"}},
+					},
+				},
+			},
+			Targets: map[string]*api.DiscoveryTarget{
+				"web.default.default.dc1": {
+					ID:             "web.default.default.dc1",
+					Service:        "web",
+					Namespace:      "default",
+					Partition:      "default",
+					Datacenter:     "dc1",
+					ConnectTimeout: connectTimeout,
+				},
+				"web.default.default.external.peer1": {
+					ID:        "web.default.default.external.peer1",
+					Service:   "web",
+					Namespace: "default",
+					Partition: "default",
+					Peer:      "peer1",
+				},
+			},
+		}
+	}
+
+	c := chain(5 * time.Second)
+	h.setChain(c)
+	cfgs := h.targetConfigs(c)
+
+	target, err := h.target(c.Targets["web.default.default.dc1"], cfgs["web.default.default.dc1"])
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, target.cfg.connectTimeout)
+
+	_, err = h.target(c.Targets["web.default.default.external.peer1"], cfgs["web.default.default.external.peer1"])
+	require.ErrorContains(t, err, `is in cluster peer "peer1"`)
+
+	// Targets are kept while their config is unchanged.
+	h.setChain(chain(5 * time.Second))
+	require.Contains(t, h.targets, "web.default.default.dc1")
+
+	// A changed connect timeout recreates the target's connections.
+	h.setChain(chain(time.Second))
+	require.NotContains(t, h.targets, "web.default.default.dc1")
+}
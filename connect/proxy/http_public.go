@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+
+	agConnect "github.com/hashicorp/consul/agent/connect"
+	"github.com/hashicorp/consul/agent/structs"
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/connect"
+	"github.com/hashicorp/consul/ipaddr"
+)
+
+// NewPublicHTTPListener returns a Listener setup to listen for public mTLS
+// connections and serve them as HTTP. Every request is authorized against the
+// proxied service's intentions, including L7 permissions, before it is proxied
+// to the configured local application.
+func NewPublicHTTPListener(svc *connect.Service, client *api.Client,
+	cfg PublicListenerConfig, logger hclog.Logger) *Listener {
+	bindAddr := ipaddr.FormatAddressPort(cfg.BindAddress, cfg.BindPort)
+	logger = logger.Named(publicListenerPrefix)
+	metricLabels := []metrics.Label{{Name: "dst", Value: svc.Name()}}
+
+	local := httputil.NewSingleHostReverseProxy(&url.URL{
+		Scheme: "http",
+		Host:   cfg.LocalServiceAddress,
+	})
+	local.Transport = &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: time.Duration(cfg.LocalConnectTimeoutMs) * time.Millisecond,
+		}).DialContext,
+	}
+	local.ErrorLog = logger.StandardLogger(&hclog.StandardLoggerOptions{InferLevels: true})
+
+	h := &publicHTTPHandler{
+		authz: &httpAuthorizer{
+			client:  client,
+			service: svc.Name(),
+		},
+		denials:      newDenialReporter(client, logger),
+		local:        local,
+		logger:       logger,
+		metricLabels: metricLabels,
+	}
+
+	l := &Listener{
+		Service:           svc,
+		handler:           h,
+		readHeaderTimeout: time.Duration(cfg.HandshakeTimeoutMs) * time.Millisecond,
+		bindAddr:          bindAddr,
+		stopChan:          make(chan struct{}),
+		listeningChan:     make(chan struct{}),
+		logger:            logger,
+		metricPrefix:      publicListenerPrefix,
+		metricLabels:      metricLabels,
+	}
+	l.listenFunc = func() (net.Listener, error) {
+		ln, err := tls.Listen("tcp", bindAddr, svc.ServerTLSConfigWithoutAuthz())
+		if err != nil {
+			return nil, err
+		}
+		go h.denials.run(l.stopChan)
+		return ln, nil
+	}
+	return l
+}
+
+// publicHTTPHandler authorizes inbound requests and proxies the allowed ones to
+// the local application.
+type publicHTTPHandler struct {
+	authz        *httpAuthorizer
+	denials      *denialReporter
+	local        http.Handler
+	logger       hclog.Logger
+	metricLabels []metrics.Label
+}
+
+func (h *publicHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	src, err := sourceFromRequest(req)
+	if err != nil {
+		h.logger.Error("invalid client certificate", "error", err)
+		http.Error(w, "RBAC: access denied", http.StatusForbidden)
+		return
+	}
+
+	allowed, reason, denial, err := h.authz.authorize(src, req)
+	if err != nil {
+		h.logger.Error("authz failed", "source", src.URI().String(), "error", err)
+		http.Error(w, "failed to authorize request", http.StatusServiceUnavailable)
+		return
+	}
+	if !allowed {
+		h.logger.Debug("request denied",
+			"source", src.URI().String(),
+			"method", req.Method,
+			"path", req.URL.Path,
+			"reason", reason,
+		)
+		metrics.IncrCounterWithLabels([]string{publicListenerPrefix, "denied"}, 1, h.metricLabels)
+		if denial != nil {
+			h.denials.Report(denial)
+		}
+		http.Error(w, "RBAC: access denied", http.StatusForbidden)
+		return
+	}
+
+	metrics.IncrCounterWithLabels([]string{publicListenerPrefix, "requests"}, 1, h.metricLabels)
+	h.local.ServeHTTP(w, req)
+}
+
+// sourceFromRequest returns the service identity of the client certificate
+// presented on the request's connection.
+func sourceFromRequest(req *http.Request) (*agConnect.SpiffeIDService, error) {
+	if req.TLS == nil || len(req.TLS.PeerCertificates) == 0 {
+		return nil, errors.New("no client certificate")
+	}
+	leaf := req.TLS.PeerCertificates[0]
+	if len(leaf.URIs) < 1 {
+		return nil, errors.New("client certificate has no URIs set")
+	}
+	certURI, err := agConnect.ParseCertURI(leaf.URIs[0])
+	if err != nil {
+		return nil, err
+	}
+	svc, ok := certURI.(*agConnect.SpiffeIDService)
+	if !ok {
+		return nil, fmt.Errorf("client certificate URI %q does not identify a service", certURI.URI())
+	}
+	return svc, nil
+}
+
+// httpAuthorizer evaluates the intentions of a destination service against
+// individual HTTP requests. Intentions are read through the local agent's
+// cache so a lookup per request is cheap.
+type httpAuthorizer struct {
+	client  *api.Client
+	service string
+
+	// regexps holds the regular expressions of the intentions as of index,
+	// the raft index the intentions were last read at.
+	lock    sync.Mutex
+	index   uint64
+	regexps regexps
+}
+
+// authorize returns whether src may make req to the service. The first
+// intention matching the source decides: an L4 intention applies its action
+// and an L7 intention applies the action of its first permission matching the
+// request. Requests that match no intention or no permission fall back to the
+// default intention policy.
+//
+// A denied request is also returned as a denial to report, unless the local
+// agent already recorded it while evaluating the default intention policy.
+func (a *httpAuthorizer) authorize(src *agConnect.SpiffeIDService, req *http.Request) (bool, string, *api.IntentionDenial, error) {
+	matches, meta, err := a.client.Connect().IntentionMatch(&api.IntentionMatch{
+		By:    api.IntentionMatchDestination,
+		Names: []string{a.service},
+	}, &api.QueryOptions{UseCache: true})
+	if err != nil {
+		return false, "", nil, fmt.Errorf("failed getting intention match: %w", err)
+	}
+	ixns := matches[a.service]
+	re := a.intentionRegexps(meta.LastIndex, ixns)
+
+	// matchedL7 is set when an L7 intention matched the source but none of
+	// its permissions matched the request. The agent treats such intentions
+	// as denying and so does not record the default policy's decision.
+	var matchedL7 bool
+	for _, ixn := range ixns {
+		if !intentionSourceMatches(ixn, src) {
+			continue
+		}
+		if len(ixn.Permissions) == 0 {
+			allowed := ixn.Action == api.IntentionActionAllow
+			return allowed, fmt.Sprintf("Matched L4 intention: %s", ixn.String()),
+				a.denial(allowed, src, ixn), nil
+		}
+		for _, perm := range ixn.Permissions {
+			if intentionPermissionMatches(perm, req, re) {
+				allowed := perm.Action == api.IntentionActionAllow
+				return allowed, fmt.Sprintf("Matched L7 intention: %s", ixn.String()),
+					a.denial(allowed, src, ixn), nil
+			}
+		}
+		matchedL7 = true
+		break
+	}
+
+	resp, err := a.client.Agent().ConnectAuthorize(&api.AgentAuthorizeParams{
+		Target:        a.service,
+		ClientCertURI: src.URI().String(),
+	})
+	if err != nil {
+		return false, "", nil, fmt.Errorf("authz call failed: %w", err)
+	}
+	var denial *api.IntentionDenial
+	if matchedL7 {
+		denial = a.denial(resp.DefaultAllow, src, nil)
+	}
+	return resp.DefaultAllow, "Default intention policy", denial, nil
+}
+
+// intentionRegexps returns the compiled regular expressions of ixns, which were
+// read at index. They are only compiled again once the intentions change.
+func (a *httpAuthorizer) intentionRegexps(index uint64, ixns []*api.Intention) regexps {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.regexps == nil || index != a.index {
+		a.index = index
+		a.regexps = intentionRegexps(ixns)
+	}
+	return a.regexps
+}
+
+// denial returns the denial to report for a request from src, or nil if the
+// request was allowed. ixn is the intention that denied it, or nil if the
+// default intention policy did.
+func (a *httpAuthorizer) denial(allowed bool, src *agConnect.SpiffeIDService, ixn *api.Intention) *api.IntentionDenial {
+	if allowed {
+		return nil
+	}
+	denial := &api.IntentionDenial{
+		SourceURI:       src.URI().String(),
+		DestinationName: a.service,
+	}
+	if ixn != nil {
+		entMeta := structs.NewEnterpriseMetaWithPartition(ixn.SourcePartition, ixn.SourceNS)
+		source := structs.NewServiceName(ixn.SourceName, &entMeta)
+		denial.PolicyID = structs.IntentionDenyPolicyID(source, ixn.SourcePeer)
+	}
+	return denial
+}
+
+// intentionSourceMatches reports whether the intention's source selects the
+// local service src. Peered and sameness group sources never match since
+// local certificates only identify services in this cluster.
+func intentionSourceMatches(ixn *api.Intention, src *agConnect.SpiffeIDService) bool {
+	if ixn.SourcePeer != "" || ixn.SourceSamenessGroup != "" {
+		return false
+	}
+	if ixn.SourceType != "" && ixn.SourceType != api.IntentionSourceConsul {
+		return false
+	}
+	if ixn.SourceName != "*" && ixn.SourceName != src.Service {
+		return false
+	}
+	if ixn.SourceNS != "*" && orDefault(ixn.SourceNS) != orDefault(src.Namespace) {
+		return false
+	}
+	return orDefault(ixn.SourcePartition) == orDefault(src.Partition)
+}
+
+func orDefault(s string) string {
+	if s == "" {
+		return "default"
+	}
+	return s
+}
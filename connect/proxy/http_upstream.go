@@ -0,0 +1,532 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/consul/connect"
+	"github.com/hashicorp/consul/ipaddr"
+)
+
+// NewUpstreamHTTPListener returns a Listener setup to listen locally for plain
+// HTTP requests that are routed through the upstream's compiled discovery
+// chain. Service-router routes, service-splitter splits and the route's
+// timeout and retry settings are applied before the request is proxied over
+// mTLS to an instance of the chosen target.
+func NewUpstreamHTTPListener(svc *connect.Service, client *api.Client,
+	cfg UpstreamConfig, logger hclog.Logger) *Listener {
+	bindAddr := ipaddr.FormatAddressPort(cfg.LocalBindAddress, cfg.LocalBindPort)
+	logger = logger.Named(upstreamListenerPrefix)
+	metricLabels := []metrics.Label{
+		{Name: "src", Value: svc.Name()},
+		{Name: "dst_type", Value: string(cfg.DestinationType)},
+		{Name: "dst", Value: cfg.DestinationName},
+	}
+
+	l := &Listener{
+		Service:       svc,
+		bindAddr:      bindAddr,
+		stopChan:      make(chan struct{}),
+		listeningChan: make(chan struct{}),
+		logger:        logger,
+		metricPrefix:  upstreamListenerPrefix,
+		metricLabels:  metricLabels,
+	}
+
+	h := &upstreamHTTPHandler{
+		svc:          svc,
+		client:       client,
+		cfg:          cfg,
+		logger:       logger,
+		metricLabels: metricLabels,
+		readyCh:      make(chan struct{}),
+		targets:      make(map[string]*upstreamTarget),
+	}
+	l.handler = h
+	l.listenFunc = func() (net.Listener, error) {
+		ln, err := net.Listen("tcp", bindAddr)
+		if err != nil {
+			return nil, err
+		}
+		go watchDiscoveryChain(client, cfg, logger, l.stopChan, h.setChain)
+		return ln, nil
+	}
+	return l
+}
+
+// upstreamHTTPHandler routes local HTTP requests using the upstream's
+// discovery chain, which it keeps up to date with a blocking query.
+type upstreamHTTPHandler struct {
+	svc          *connect.Service
+	client       *api.Client
+	cfg          UpstreamConfig
+	logger       hclog.Logger
+	metricLabels []metrics.Label
+
+	// readyCh is closed once the first chain has been loaded.
+	readyCh   chan struct{}
+	readyOnce sync.Once
+
+	lock    sync.RWMutex
+	chain   *api.CompiledDiscoveryChain
+	regexps regexps
+	targets map[string]*upstreamTarget
+}
+
+// upstreamTarget holds the connections to the instances of a single target.
+type upstreamTarget struct {
+	transport *http.Transport
+	balancer  *connect.Balancer
+	cfg       targetConfig
+}
+
+// targetConfig is the configuration that the connections to a target are
+// created with.
+type targetConfig struct {
+	lb             *api.LoadBalancer
+	connectTimeout time.Duration
+}
+
+func (h *upstreamHTTPHandler) setChain(chain *api.CompiledDiscoveryChain) {
+	re := chainRegexps(chain)
+
+	for _, target := range chain.Targets {
+		if target.Peer != "" {
+			h.logger.Warn("requests routed to cluster peers are not supported and will fail",
+				"upstream", h.cfg.DestinationName, "target", target.ID, "peer", target.Peer)
+		}
+	}
+
+	h.lock.Lock()
+	h.chain = chain
+	h.regexps = re
+	// Drop targets that are no longer in the chain. Targets whose config
+	// changed are recreated on next use so their transports match it.
+	cfgs := h.targetConfigs(chain)
+	for id, t := range h.targets {
+		if cfg, ok := cfgs[id]; !ok || !reflect.DeepEqual(cfg, t.cfg) {
+			t.transport.CloseIdleConnections()
+			delete(h.targets, id)
+		}
+	}
+	h.lock.Unlock()
+
+	h.readyOnce.Do(func() { close(h.readyCh) })
+}
+
+func (h *upstreamHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	select {
+	case <-h.readyCh:
+	case <-req.Context().Done():
+		return
+	case <-time.After(h.cfg.ConnectTimeout()):
+		http.Error(w, "no healthy upstream", http.StatusServiceUnavailable)
+		return
+	}
+
+	h.lock.RLock()
+	chain, re := h.chain, h.regexps
+	h.lock.RUnlock()
+
+	route, node, err := routeRequest(chain, re, req)
+	if err != nil {
+		h.logger.Error("failed to route request", "upstream", h.cfg.DestinationName, "error", err)
+		http.Error(w, "no healthy upstream", http.StatusServiceUnavailable)
+		return
+	}
+	// The resolver's target is used unless no connection to it can be
+	// established, in which case its failover targets are tried in order.
+	resolver := node.Resolver
+	targetIDs := []string{resolver.Target}
+	if resolver.Failover != nil {
+		targetIDs = append(targetIDs, resolver.Failover.Targets...)
+	}
+	cfgs := h.targetConfigs(chain)
+	var transports []http.RoundTripper
+	for _, id := range targetIDs {
+		target, ok := chain.Targets[id]
+		if !ok {
+			continue
+		}
+		t, err := h.target(target, cfgs[id])
+		if err != nil {
+			h.logger.Error("failed to route request", "upstream", h.cfg.DestinationName, "error", err)
+			continue
+		}
+		transports = append(transports, t.transport)
+	}
+	if len(transports) == 0 {
+		http.Error(w, "no healthy upstream", http.StatusServiceUnavailable)
+		return
+	}
+	target := chain.Targets[resolver.Target]
+
+	var dest *api.ServiceRouteDestination
+	if route != nil {
+		dest = route.Destination
+	}
+
+	if dest != nil && dest.RequestTimeout > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), dest.RequestTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	if node.LoadBalancer != nil && len(node.LoadBalancer.HashPolicies) > 0 {
+		if key := requestHashKey(node.LoadBalancer.HashPolicies, req); key != "" {
+			req = req.WithContext(connect.WithHashKey(req.Context(), key))
+		}
+	}
+
+	p := &httputil.ReverseProxy{
+		Director: func(out *http.Request) {
+			out.URL.Scheme = "https"
+			out.URL.Host = target.ID
+			if route != nil {
+				rewritePrefix(out, route)
+			}
+			if dest != nil {
+				applyHeaderModifiers(out.Header, dest.RequestHeaders)
+			}
+		},
+		Transport: &retryTransport{
+			base: failoverTransport(transports),
+			dest: dest,
+		},
+		ModifyResponse: func(resp *http.Response) error {
+			if dest != nil {
+				applyHeaderModifiers(resp.Header, dest.ResponseHeaders)
+			}
+			return nil
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			h.logger.Error("failed to proxy request", "target", target.ID, "error", err)
+			if r.Context().Err() == context.DeadlineExceeded {
+				http.Error(w, "upstream request timeout", http.StatusGatewayTimeout)
+				return
+			}
+			http.Error(w, "upstream connect error", http.StatusServiceUnavailable)
+		},
+	}
+
+	metrics.IncrCounterWithLabels([]string{upstreamListenerPrefix, "requests"}, 1, h.metricLabels)
+	p.ServeHTTP(w, req)
+}
+
+// target returns the connections for target, creating them on first use.
+// Connections are dialed over mTLS with the proxy's service identity and
+// balanced across the target's instances. Targets in cluster peers are not
+// supported since their instances are only reachable through mesh gateways.
+func (h *upstreamHTTPHandler) target(target *api.DiscoveryTarget, cfg targetConfig) (*upstreamTarget, error) {
+	if target.Peer != "" {
+		return nil, fmt.Errorf("target %q is in cluster peer %q, which the built-in proxy does not support", target.ID, target.Peer)
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if t, ok := h.targets[target.ID]; ok {
+		return t, nil
+	}
+
+	lb, connectTimeout := cfg.lb, cfg.connectTimeout
+	balancer := connect.NewBalancer(connect.BalancerConfig{
+		LoadBalancer:       lb,
+		PassiveHealthCheck: h.cfg.PassiveHealthCheck(),
+		MetricLabels:       append([]metrics.Label{{Name: "target", Value: target.ID}}, h.metricLabels...),
+	})
+	resolver := &connect.ConsulResolver{
+		Client:     h.client,
+		Namespace:  target.Namespace,
+		Partition:  target.Partition,
+		Name:       target.Service,
+		Type:       connect.ConsulResolverTypeService,
+		Datacenter: target.Datacenter,
+		Filter:     target.Subset.Filter,
+		Balancer:   balancer,
+	}
+	t := &upstreamTarget{
+		transport: &http.Transport{
+			DialTLSContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				ctx, cancel := context.WithTimeout(ctx, connectTimeout)
+				defer cancel()
+				conn, err := h.svc.Dial(ctx, resolver)
+				if err != nil {
+					return nil, &connectFailureError{err: err}
+				}
+				return conn, nil
+			},
+			MaxIdleConnsPerHost: 16,
+			IdleConnTimeout:     90 * time.Second,
+			// Hash based policies pick an instance per dial, so reusing a
+			// connection would send the request to the wrong instance.
+			DisableKeepAlives: lb != nil && len(lb.HashPolicies) > 0,
+		},
+		balancer: balancer,
+		cfg:      cfg,
+	}
+	h.targets[target.ID] = t
+	return t, nil
+}
+
+// targetConfigs returns the config of the connections to each target in the
+// chain. Load balancer config comes from the resolver of the target, and the
+// connect timeout from the target itself so that it applies to failover
+// targets as well.
+func (h *upstreamHTTPHandler) targetConfigs(chain *api.CompiledDiscoveryChain) map[string]targetConfig {
+	cfgs := make(map[string]targetConfig, len(chain.Targets))
+	for id, target := range chain.Targets {
+		cfg := targetConfig{connectTimeout: h.cfg.ConnectTimeout()}
+		if target.ConnectTimeout > 0 {
+			cfg.connectTimeout = target.ConnectTimeout
+		}
+		cfgs[id] = cfg
+	}
+	for _, node := range chain.Nodes {
+		if node.Type != api.DiscoveryGraphNodeTypeResolver || node.Resolver == nil {
+			continue
+		}
+		if cfg, ok := cfgs[node.Resolver.Target]; ok {
+			cfg.lb = node.LoadBalancer
+			cfgs[node.Resolver.Target] = cfg
+		}
+	}
+	return cfgs
+}
+
+// requestHashKey returns the key that hash based load balancing policies use
+// for req. Cookies are only hashed if the client sent them.
+func requestHashKey(policies []api.HashPolicy, req *http.Request) string {
+	var parts []string
+	for _, p := range policies {
+		var value string
+		switch {
+		case p.SourceIP:
+			value, _, _ = net.SplitHostPort(req.RemoteAddr)
+		case p.Field == "header":
+			value = req.Header.Get(p.FieldValue)
+		case p.Field == "cookie":
+			if c, err := req.Cookie(p.FieldValue); err == nil {
+				value = c.Value
+			}
+		case p.Field == "query_parameter":
+			value = req.URL.Query().Get(p.FieldValue)
+		}
+		if value == "" {
+			continue
+		}
+		parts = append(parts, value)
+		if p.Terminal {
+			break
+		}
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// routeRequest walks the discovery chain for req and returns the matched
+// service-router route, if any, and the resolver node that selects the target.
+// re must hold the chain's regular expressions.
+func routeRequest(chain *api.CompiledDiscoveryChain, re regexps, req *http.Request) (*api.ServiceRoute, *api.DiscoveryGraphNode, error) {
+	var route *api.ServiceRoute
+
+	name := chain.StartNode
+	// Bound the walk so a malformed chain can't loop forever.
+	for i := 0; i <= len(chain.Nodes); i++ {
+		node, ok := chain.Nodes[name]
+		if !ok {
+			return nil, nil, fmt.Errorf("discovery chain node %q not found", name)
+		}
+
+		switch node.Type {
+		case api.DiscoveryGraphNodeTypeRouter:
+			name = ""
+			for _, r := range node.Routes {
+				if routeMatches(r.Definition.Match, req, re) {
+					route = r.Definition
+					name = r.NextNode
+					break
+				}
+			}
+			if name == "" {
+				return nil, nil, fmt.Errorf("no route matched in router %q", node.Name)
+			}
+
+		case api.DiscoveryGraphNodeTypeSplitter:
+			if len(node.Splits) == 0 {
+				return nil, nil, fmt.Errorf("splitter %q has no splits", node.Name)
+			}
+			name = pickSplit(node.Splits, rand.Float32()*100)
+
+		case api.DiscoveryGraphNodeTypeResolver:
+			if node.Resolver == nil {
+				return nil, nil, fmt.Errorf("resolver node %q has no resolver", node.Name)
+			}
+			if _, ok := chain.Targets[node.Resolver.Target]; !ok {
+				return nil, nil, fmt.Errorf("discovery chain target %q not found", node.Resolver.Target)
+			}
+			return route, node, nil
+
+		default:
+			return nil, nil, fmt.Errorf("unknown discovery chain node type %q", node.Type)
+		}
+	}
+	return nil, nil, fmt.Errorf("discovery chain for %q does not end in a resolver", chain.ServiceName)
+}
+
+// pickSplit returns the next node of the split that the point n, between 0 and
+// 100, falls into.
+func pickSplit(splits []*api.DiscoverySplit, n float32) string {
+	var total float32
+	for _, split := range splits {
+		total += split.Weight
+		if n < total {
+			return split.NextNode
+		}
+	}
+	return splits[len(splits)-1].NextNode
+}
+
+// rewritePrefix applies the route's PrefixRewrite to the path matched by its
+// PathPrefix, or replaces the whole path for other matches.
+func rewritePrefix(req *http.Request, route *api.ServiceRoute) {
+	if route.Destination == nil || route.Destination.PrefixRewrite == "" {
+		return
+	}
+	rewrite := route.Destination.PrefixRewrite
+
+	var prefix string
+	if route.Match != nil && route.Match.HTTP != nil {
+		prefix = route.Match.HTTP.PathPrefix
+		if prefix == "" {
+			prefix = route.Match.HTTP.PathExact
+		}
+	}
+	if prefix == "" {
+		prefix = "/"
+	}
+
+	path := req.URL.Path
+	if len(path) >= len(prefix) && strings.EqualFold(path[:len(prefix)], prefix) {
+		req.URL.Path = rewrite + path[len(prefix):]
+		req.URL.RawPath = ""
+	}
+}
+
+func applyHeaderModifiers(h http.Header, mods *api.HTTPHeaderModifiers) {
+	if mods == nil {
+		return
+	}
+	for k, v := range mods.Add {
+		h.Add(k, v)
+	}
+	for k, v := range mods.Set {
+		h.Set(k, v)
+	}
+	for _, k := range mods.Remove {
+		h.Del(k)
+	}
+}
+
+// connectFailureError is returned when no connection to an instance of the
+// target could be established, so the request was never sent.
+type connectFailureError struct {
+	err error
+}
+
+func (e *connectFailureError) Error() string {
+	return fmt.Sprintf("connect failure: %v", e.err)
+}
+
+func (e *connectFailureError) Unwrap() error {
+	return e.err
+}
+
+// failoverTransport sends requests to the first target that a connection can
+// be established to. Requests are never sent when the connection fails, so
+// they can be sent to the next target whatever their method.
+type failoverTransport []http.RoundTripper
+
+func (t failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var (
+		resp *http.Response
+		err  error
+	)
+	for _, rt := range t {
+		resp, err = rt.RoundTrip(req)
+		var connectErr *connectFailureError
+		if err == nil || !errors.As(err, &connectErr) || req.Context().Err() != nil {
+			return resp, err
+		}
+	}
+	return resp, err
+}
+
+// retryTransport retries requests according to a service-router destination.
+// Only requests without a body are retried since the body can't be replayed.
+// Requests that may have reached the upstream are only retried if their
+// method is idempotent, so connect failures are the only retry for others.
+type retryTransport struct {
+	base http.RoundTripper
+	dest *api.ServiceRouteDestination
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	retries := 0
+	if t.dest != nil && (req.Body == nil || req.Body == http.NoBody) {
+		retries = int(t.dest.NumRetries)
+	}
+	idempotent := isIdempotent(req.Method)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if attempt >= retries || req.Context().Err() != nil {
+			return resp, err
+		}
+		if err != nil {
+			var connectErr *connectFailureError
+			if !t.dest.RetryOnConnectFailure || !(errors.As(err, &connectErr) || idempotent) {
+				return resp, err
+			}
+			continue
+		}
+		if !idempotent || !retryableStatus(t.dest.RetryOnStatusCodes, resp.StatusCode) {
+			return resp, nil
+		}
+		resp.Body.Close()
+	}
+}
+
+// isIdempotent reports whether requests with method can be sent more than
+// once without changing their effect.
+func isIdempotent(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace,
+		http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func retryableStatus(codes []uint32, status int) bool {
+	for _, code := range codes {
+		if int(code) == status {
+			return true
+		}
+	}
+	return false
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/tls"
 	"errors"
 	"net"
+	"net/http"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -37,6 +38,12 @@ type Listener struct {
 	dialFunc   func() (net.Conn, error)
 	bindAddr   string
 
+	// handler is set by the HTTP constructors. When it is set, accepted
+	// connections are served as HTTP by handler instead of being proxied
+	// byte-for-byte through dialFunc.
+	handler           http.Handler
+	readHeaderTimeout time.Duration
+
 	stopFlag int32
 	stopChan chan struct{}
 
@@ -49,9 +56,10 @@ type Listener struct {
 	// this is cheap and correct.
 	listeningChan chan struct{}
 
-	// listenerLock guards access to the listener field
+	// listenerLock guards access to the listener and httpServer fields
 	listenerLock sync.Mutex
 	listener     net.Listener
+	httpServer   *http.Server
 
 	logger hclog.Logger
 
@@ -95,10 +103,42 @@ func NewPublicListener(svc *connect.Service, cfg PublicListenerConfig,
 
 // NewUpstreamListener returns a Listener setup to listen locally for TCP
 // connections that are proxied to a discovered Connect service instance.
+// Instances are picked with the load balancing policy of the upstream's
+// service-resolver, and instances that repeatedly fail to accept connections
+// are ejected according to the upstream's passive health check.
 func NewUpstreamListener(svc *connect.Service, client *api.Client,
 	cfg UpstreamConfig, logger hclog.Logger) *Listener {
-	return newUpstreamListenerWithResolver(svc, cfg,
-		UpstreamResolverFuncFromClient(client), logger)
+	balancer := connect.NewBalancer(connect.BalancerConfig{
+		PassiveHealthCheck: cfg.PassiveHealthCheck(),
+		MetricLabels: []metrics.Label{
+			{Name: "src", Value: svc.Name()},
+			{Name: "dst_type", Value: string(cfg.DestinationType)},
+			{Name: "dst", Value: cfg.DestinationName},
+		},
+	})
+	resolverFunc := UpstreamResolverFuncFromClient(client)
+	l := newUpstreamListenerWithResolver(svc, cfg, func(cfg UpstreamConfig) (connect.Resolver, error) {
+		r, err := resolverFunc(cfg)
+		if cr, ok := r.(*connect.ConsulResolver); ok {
+			cr.Balancer = balancer
+		}
+		return r, err
+	}, logger)
+
+	if cfg.DestinationType == "service" {
+		listen := l.listenFunc
+		l.listenFunc = func() (net.Listener, error) {
+			ln, err := listen()
+			if err != nil {
+				return nil, err
+			}
+			go watchDiscoveryChain(client, cfg, l.logger, l.stopChan, func(chain *api.CompiledDiscoveryChain) {
+				balancer.SetLoadBalancer(startLoadBalancer(chain))
+			})
+			return ln, nil
+		}
+	}
+	return l
 }
 
 func newUpstreamListenerWithResolver(svc *connect.Service, cfg UpstreamConfig,
@@ -153,6 +193,10 @@ func (l *Listener) Serve() error {
 
 	close(l.listeningChan)
 
+	if l.handler != nil {
+		return l.serveHTTP(listener)
+	}
+
 	for {
 		conn, err := listener.Accept()
 		if err != nil {
@@ -167,6 +211,42 @@ func (l *Listener) Serve() error {
 	}
 }
 
+// serveHTTP serves HTTP requests on listener with the listener's handler until
+// the listener is closed.
+func (l *Listener) serveHTTP(listener net.Listener) error {
+	var conns sync.Map
+	srv := &http.Server{
+		Handler:           l.handler,
+		ReadHeaderTimeout: l.readHeaderTimeout,
+		ErrorLog:          l.logger.StandardLogger(&hclog.StandardLoggerOptions{InferLevels: true}),
+		ConnState: func(conn net.Conn, state http.ConnState) {
+			switch state {
+			case http.StateNew:
+				conns.Store(conn, l.trackConn())
+			case http.StateClosed, http.StateHijacked:
+				if done, ok := conns.LoadAndDelete(conn); ok {
+					done.(func())()
+				}
+			}
+		},
+	}
+
+	l.listenerLock.Lock()
+	l.httpServer = srv
+	l.listenerLock.Unlock()
+
+	// Close may have raced with us before the server was stored.
+	if atomic.LoadInt32(&l.stopFlag) == 1 {
+		return nil
+	}
+
+	err := srv.Serve(listener)
+	if atomic.LoadInt32(&l.stopFlag) == 1 || errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
 // handleConn is the internal connection handler goroutine.
 func (l *Listener) handleConn(src net.Conn) {
 	defer func() {
@@ -263,6 +343,14 @@ func (l *Listener) Close() error {
 		listener.Close()
 	}
 
+	// Close any HTTP connections still open.
+	l.listenerLock.Lock()
+	srv := l.httpServer
+	l.listenerLock.Unlock()
+	if srv != nil {
+		srv.Close()
+	}
+
 	// Stop outstanding requests.
 	close(l.stopChan)
 
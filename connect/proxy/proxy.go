@@ -84,7 +84,12 @@ func (p *Proxy) Serve() error {
 					// the configuration to disable our public listener.
 					if newCfg.PublicListener.BindPort != 0 {
 						newCfg.PublicListener.applyDefaults()
-						l := NewPublicListener(p.service, newCfg.PublicListener, p.logger)
+						var l *Listener
+						if isHTTPProtocol(newCfg.PublicListener.Protocol) {
+							l = NewPublicHTTPListener(p.service, p.client, newCfg.PublicListener, p.logger)
+						} else {
+							l = NewPublicListener(p.service, newCfg.PublicListener, p.logger)
+						}
 						err = p.startListener("public listener", l)
 						if err != nil {
 							// This should probably be fatal.
@@ -114,7 +119,12 @@ func (p *Proxy) Serve() error {
 					continue
 				}
 
-				l := NewUpstreamListener(p.service, p.client, uc, p.logger)
+				var l *Listener
+				if uc.DestinationType == "service" && isHTTPProtocol(uc.Protocol()) {
+					l = NewUpstreamHTTPListener(p.service, p.client, uc, p.logger)
+				} else {
+					l = NewUpstreamListener(p.service, p.client, uc, p.logger)
+				}
 				err := p.startListener(uc.String(), l)
 				if err != nil {
 					p.logger.Error("failed to start upstream",
@@ -6,7 +6,11 @@ package proxy
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
+	"io"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"runtime"
 	"strconv"
@@ -129,3 +133,131 @@ func TestProxy_public(t *testing.T) {
 		require.NoFileExists(t, unixSocket)
 	})
 }
+
+func TestProxy_http(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	ports := freeport.GetN(t, 2)
+
+	a := agent.NewTestAgent(t, "")
+	defer a.Shutdown()
+	testrpc.WaitForTestAgent(t, a.RPC, "dc1")
+	client := a.Client()
+
+	// The backend echoes the path and route header it received.
+	testApp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Route", r.Header.Get("X-Route"))
+		fmt.Fprint(w, r.URL.Path)
+	}))
+	defer testApp.Close()
+
+	entries := []api.ConfigEntry{
+		&api.ServiceConfigEntry{
+			Kind:     api.ServiceDefaults,
+			Name:     "echo",
+			Protocol: "http",
+		},
+		&api.ServiceIntentionsConfigEntry{
+			Kind: api.ServiceIntentions,
+			Name: "echo",
+			Sources: []*api.SourceIntention{
+				{
+					Name: "web",
+					Permissions: []*api.IntentionPermission{
+						{
+							Action: api.IntentionActionDeny,
+							HTTP:   &api.IntentionHTTPPermission{PathPrefix: "/admin"},
+						},
+					},
+				},
+			},
+		},
+		&api.ServiceRouterConfigEntry{
+			Kind: api.ServiceRouter,
+			Name: "echo",
+			Routes: []api.ServiceRoute{
+				{
+					Match: &api.ServiceRouteMatch{HTTP: &api.ServiceRouteHTTPMatch{PathPrefix: "/v2/"}},
+					Destination: &api.ServiceRouteDestination{
+						PrefixRewrite:  "/",
+						RequestHeaders: &api.HTTPHeaderModifiers{Set: map[string]string{"X-Route": "v2"}},
+					},
+				},
+			},
+		},
+	}
+	for _, entry := range entries {
+		_, _, err := client.ConfigEntries().Set(entry, nil)
+		require.NoError(t, err)
+	}
+
+	// Register the sidecar so the upstream can discover the public listener.
+	_, err := client.Catalog().Register(&api.CatalogRegistration{
+		Datacenter: "dc1",
+		Node:       "local",
+		Address:    "127.0.0.1",
+		Service: &api.AgentService{
+			Kind:    api.ServiceKindConnectProxy,
+			Service: "echo-sidecar-proxy",
+			Port:    ports[0],
+			Proxy:   &api.AgentServiceConnectProxyConfig{DestinationServiceName: "echo"},
+		},
+	}, nil)
+	require.NoError(t, err)
+
+	echo, err := New(client, NewStaticConfigWatcher(&Config{
+		ProxiedServiceName: "echo",
+		PublicListener: PublicListenerConfig{
+			BindAddress:         "127.0.0.1",
+			BindPort:            ports[0],
+			LocalServiceAddress: testApp.Listener.Addr().String(),
+			Protocol:            "http",
+		},
+	}), testutil.Logger(t))
+	require.NoError(t, err)
+	defer echo.Close()
+	go echo.Serve()
+
+	web, err := New(client, NewStaticConfigWatcher(&Config{
+		ProxiedServiceName: "web",
+		Upstreams: []UpstreamConfig{
+			{
+				DestinationName: "echo",
+				LocalBindPort:   ports[1],
+				Config:          map[string]interface{}{"protocol": "http"},
+			},
+		},
+	}), testutil.Logger(t))
+	require.NoError(t, err)
+	defer web.Close()
+	go web.Serve()
+
+	get := func(r *retry.R, path string) (int, string, string) {
+		resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d%s", ports[1], path))
+		require.NoError(r, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(r, err)
+		return resp.StatusCode, string(body), resp.Header.Get("X-Route")
+	}
+
+	retry.Run(t, func(r *retry.R) {
+		code, body, _ := get(r, "/hello")
+		require.Equal(r, http.StatusOK, code)
+		require.Equal(r, "/hello", body)
+	})
+
+	retry.Run(t, func(r *retry.R) {
+		code, body, route := get(r, "/v2/hello")
+		require.Equal(r, http.StatusOK, code)
+		require.Equal(r, "/hello", body)
+		require.Equal(r, "v2", route)
+	})
+
+	retry.Run(t, func(r *retry.R) {
+		code, _, _ := get(r, "/admin/users")
+		require.Equal(r, http.StatusForbidden, code)
+	})
+}
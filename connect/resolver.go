@@ -84,6 +84,27 @@ type ConsulResolver struct {
 
 	// Specifies the expression used to filter the queries results prior to returning the data.
 	Filter string
+
+	// Balancer picks which healthy instance is returned. If it is nil an
+	// instance is picked at random.
+	Balancer *Balancer
+}
+
+// dialObserver may be implemented by a Resolver to observe the outcome of
+// Service.Dial calls to the instances it resolved.
+type dialObserver interface {
+	// dialed is called with the result of dialing addr. If the dial succeeded
+	// and the returned func is not nil it is called once the connection is
+	// closed.
+	dialed(addr string, err error) func()
+}
+
+// dialed implements dialObserver by reporting to the Balancer, if any.
+func (cr *ConsulResolver) dialed(addr string, err error) func() {
+	if cr.Balancer == nil {
+		return nil
+	}
+	return cr.Balancer.dialed(addr, err)
 }
 
 // Resolve performs service discovery against the local Consul agent and returns
@@ -111,12 +132,10 @@ func (cr *ConsulResolver) resolveService(ctx context.Context) (string, connect.C
 		return "", nil, fmt.Errorf("no healthy instances found")
 	}
 
-	// Services are not shuffled by HTTP API, pick one at (pseudo) random.
-	idx := 0
-	if len(svcs) > 1 {
-		idx = rand.Intn(len(svcs))
+	idx, err := cr.pick(ctx, len(svcs), func(i int) *api.ServiceEntry { return svcs[i] })
+	if err != nil {
+		return "", nil, err
 	}
-
 	return cr.resolveServiceEntry(svcs[idx])
 }
 
@@ -131,22 +150,40 @@ func (cr *ConsulResolver) resolveQuery(ctx context.Context) (string, connect.Cer
 		return "", nil, fmt.Errorf("no healthy instances found")
 	}
 
-	// Services are not shuffled by HTTP API, pick one at (pseudo) random.
-	idx := 0
-	if len(svcs) > 1 {
-		idx = rand.Intn(len(svcs))
+	idx, err := cr.pick(ctx, len(svcs), func(i int) *api.ServiceEntry { return &svcs[i] })
+	if err != nil {
+		return "", nil, err
 	}
-
 	return cr.resolveServiceEntry(&svcs[idx])
 }
 
-func (cr *ConsulResolver) resolveServiceEntry(entry *api.ServiceEntry) (string, connect.CertURI, error) {
+// pick returns the index of the instance to dial out of n resolved entries.
+func (cr *ConsulResolver) pick(ctx context.Context, n int, entry func(int) *api.ServiceEntry) (int, error) {
+	if cr.Balancer == nil {
+		// Services are not shuffled by HTTP API, pick one at (pseudo) random.
+		if n > 1 {
+			return rand.Intn(n), nil
+		}
+		return 0, nil
+	}
+
+	addrs := make([]string, n)
+	for i := range addrs {
+		addrs[i] = serviceEntryAddr(entry(i))
+	}
+	return cr.Balancer.Pick(ctx, addrs)
+}
+
+// serviceEntryAddr returns the address to dial for the instance.
+func serviceEntryAddr(entry *api.ServiceEntry) string {
 	addr := entry.Service.Address
 	if addr == "" {
 		addr = entry.Node.Address
 	}
-	port := entry.Service.Port
+	return ipaddr.FormatAddressPort(addr, entry.Service.Port)
+}
 
+func (cr *ConsulResolver) resolveServiceEntry(entry *api.ServiceEntry) (string, connect.CertURI, error) {
 	service := entry.Service.Proxy.DestinationServiceName
 	if entry.Service.Connect != nil && entry.Service.Connect.Native {
 		service = entry.Service.Service
@@ -162,12 +199,15 @@ func (cr *ConsulResolver) resolveServiceEntry(entry *api.ServiceEntry) (string,
 		// No host since we don't validate trust domain here (we rely on x509 to
 		// prove trust).
 		Namespace:  "default",
+		Partition:  cr.Partition,
 		Datacenter: entry.Node.Datacenter,
 		Service:    service,
-		// NOTE: this only handles the default implicit partition currently.
+	}
+	if cr.Namespace != "" {
+		certURI.Namespace = cr.Namespace
 	}
 
-	return ipaddr.FormatAddressPort(addr, port), certURI, nil
+	return serviceEntryAddr(entry), certURI, nil
 }
 
 func (cr *ConsulResolver) queryOptions(ctx context.Context) *api.QueryOptions {
@@ -181,6 +221,13 @@ func (cr *ConsulResolver) queryOptions(ctx context.Context) *api.QueryOptions {
 		Connect: true,
 		Filter:  cr.Filter,
 	}
+	// The default tenancy is left implicit since CE rejects it when sent.
+	if cr.Namespace != "default" {
+		q.Namespace = cr.Namespace
+	}
+	if cr.Partition != "default" {
+		q.Partition = cr.Partition
+	}
 	return q.WithContext(ctx)
 }
 
@@ -182,6 +182,15 @@ func (s *Service) ServerTLSConfig() *tls.Config {
 	return s.tlsCfg.Get(newServerSideVerifier(s.logger, s.client, s.service))
 }
 
+// ServerTLSConfigWithoutAuthz returns a *tls.Config like ServerTLSConfig that
+// verifies the client certificate but does not authorize the connection. It is
+// meant for listeners that authorize each request themselves, for example to
+// enforce L7 intentions. Callers are responsible for denying any request they
+// have not authorized.
+func (s *Service) ServerTLSConfigWithoutAuthz() *tls.Config {
+	return s.tlsCfg.Get(newServerSideChainVerifier(s.logger))
+}
+
 // Dial connects to a remote Connect-enabled server. The passed Resolver is used
 // to discover a single candidate instance which will be dialed and have it's
 // TLS certificate verified against the expected identity. Failures are returned
@@ -202,13 +211,25 @@ func (s *Service) Dial(ctx context.Context, resolver Resolver) (net.Conn, error)
 		"address", addr,
 		"identity", certURI.URI(),
 	)
+	observer, _ := resolver.(dialObserver)
+	observe := func(err error) func() {
+		if observer == nil {
+			return nil
+		}
+		return observer.dialed(addr, err)
+	}
+
 	var dialer net.Dialer
 	tcpConn, err := dialer.DialContext(ctx, "tcp", addr)
 	if err != nil {
+		observe(err)
 		return nil, err
 	}
+	// The TCP connection is wrapped rather than the TLS one so that callers
+	// still get a *tls.Conn.
+	conn := &observedConn{Conn: tcpConn}
 
-	tlsConn := tls.Client(tcpConn, s.tlsCfg.Get(clientSideVerifier))
+	tlsConn := tls.Client(conn, s.tlsCfg.Get(clientSideVerifier))
 	// Set deadline for Handshake to complete.
 	deadline, ok := ctx.Deadline()
 	if ok {
@@ -217,6 +238,7 @@ func (s *Service) Dial(ctx context.Context, resolver Resolver) (net.Conn, error)
 	// Perform handshake
 	if err = tlsConn.Handshake(); err != nil {
 		tlsConn.Close()
+		observe(err)
 		return nil, err
 	}
 	// Clear deadline since that was only for connection. Caller can set their own
@@ -228,15 +250,32 @@ func (s *Service) Dial(ctx context.Context, resolver Resolver) (net.Conn, error)
 		certURI)
 	if err != nil {
 		tlsConn.Close()
+		observe(err)
 		return nil, err
 	}
 	s.logger.Debug("successfully connected to service instance",
 		"address", addr,
 		"identity", certURI.URI(),
 	)
+	conn.closed = observe(nil)
 	return tlsConn, nil
 }
 
+// observedConn is a connection that notifies its resolver when it is closed.
+type observedConn struct {
+	net.Conn
+	closed func()
+}
+
+// Close implements net.Conn.
+func (c *observedConn) Close() error {
+	err := c.Conn.Close()
+	if c.closed != nil {
+		c.closed()
+	}
+	return err
+}
+
 // HTTPDialTLS is compatible with http.Transport.DialTLS. It expects the addr
 // hostname to be specified using Consul DNS query syntax, e.g.
 // "web.service.consul". It converts that into the equivalent ConsulResolver and
@@ -131,6 +131,66 @@ func TestService_Dial(t *testing.T) {
 	}
 }
 
+// observingResolver is a StaticResolver that counts the connections dialed
+// to it and closed.
+type observingResolver struct {
+	*StaticResolver
+	dials, failures, closes int
+}
+
+func (r *observingResolver) dialed(_ string, err error) func() {
+	r.dials++
+	if err != nil {
+		r.failures++
+		return nil
+	}
+	return func() { r.closes++ }
+}
+
+func TestService_DialObserved(t *testing.T) {
+	if testing.Short() {
+		t.Skip("too slow for testing.Short")
+	}
+
+	ca := connect.TestCA(t, nil)
+	s := TestService(t, "web", ca)
+
+	testSvr := NewTestServer(t, "db", ca)
+	go func() {
+		require.NoError(t, testSvr.Serve())
+	}()
+	<-testSvr.Listening
+	defer testSvr.Close()
+
+	resolver := &observingResolver{StaticResolver: &StaticResolver{
+		Addr:    testSvr.Addr,
+		CertURI: connect.TestSpiffeIDService(t, "db"),
+	}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	conn, err := s.Dial(ctx, resolver)
+	require.NoError(t, err)
+
+	// Observed connections are still TLS connections.
+	tlsConn, ok := conn.(*tls.Conn)
+	require.True(t, ok)
+	require.NotEmpty(t, tlsConn.ConnectionState().PeerCertificates)
+	require.Equal(t, 1, resolver.dials)
+	require.Equal(t, 0, resolver.closes)
+
+	require.NoError(t, conn.Close())
+	require.Equal(t, 1, resolver.closes)
+
+	// A server presenting the wrong identity is recorded as a failed dial.
+	resolver.CertURI = connect.TestSpiffeIDService(t, "cache")
+	_, err = s.Dial(ctx, resolver)
+	require.Error(t, err)
+	require.Equal(t, 2, resolver.dials)
+	require.Equal(t, 1, resolver.failures)
+	require.Equal(t, 1, resolver.closes)
+}
+
 func TestService_ServerTLSConfig(t *testing.T) {
 	if testing.Short() {
 		t.Skip("too slow for testing.Short")
@@ -113,11 +113,14 @@ func devTLSConfigFromFiles(caFile, certFile,
 }
 
 // CertURIFromConn is a helper to extract the service identifier URI from a
-// net.Conn. If the net.Conn is not a *tls.Conn then an error is always
-// returned. If the *tls.Conn didn't present a valid connect certificate, or is
-// not yet past the handshake, an error is returned.
+// net.Conn. If the net.Conn is not a *tls.Conn, or a conn returned by
+// Service.Dial, then an error is always returned. If the *tls.Conn didn't
+// present a valid connect certificate, or is not yet past the handshake, an
+// error is returned.
 func CertURIFromConn(conn net.Conn) (connect.CertURI, error) {
-	tc, ok := conn.(*tls.Conn)
+	tc, ok := conn.(interface {
+		ConnectionState() tls.ConnectionState
+	})
 	if !ok {
 		return nil, fmt.Errorf("invalid non-TLS connect client")
 	}
@@ -180,24 +183,11 @@ func verifyServerCertMatchesURI(certs []*x509.Certificate, expected connect.Cert
 // for the Authorization.
 func newServerSideVerifier(logger hclog.Logger, client *api.Client, serviceName string) verifierFunc {
 	return func(tlsCfg *tls.Config, rawCerts [][]byte) error {
-		leaf, err := verifyChain(tlsCfg, rawCerts, false)
+		leaf, certURI, err := verifyClientLeaf(logger, tlsCfg, rawCerts)
 		if err != nil {
-			logger.Error("failed TLS verification", "error", err)
 			return err
 		}
 
-		// Check leaf is a cert we understand
-		if len(leaf.URIs) < 1 {
-			logger.Error("invalid leaf certificate: no URIs set")
-			return errors.New("connect: invalid leaf certificate")
-		}
-
-		certURI, err := connect.ParseCertURI(leaf.URIs[0])
-		if err != nil {
-			logger.Error("invalid leaf certificate URI", "error", err)
-			return errors.New("connect: invalid leaf certificate URI")
-		}
-
 		// No AuthZ if there is no client.
 		if client == nil {
 			logger.Info("nil client provided")
@@ -223,6 +213,39 @@ func newServerSideVerifier(logger hclog.Logger, client *api.Client, serviceName
 	}
 }
 
+// newServerSideChainVerifier returns a verifierFunc that verifies the TLS chain
+// and the client's certificate URI for the server end of the connection but
+// leaves authorization to the caller.
+func newServerSideChainVerifier(logger hclog.Logger) verifierFunc {
+	return func(tlsCfg *tls.Config, rawCerts [][]byte) error {
+		_, _, err := verifyClientLeaf(logger, tlsCfg, rawCerts)
+		return err
+	}
+}
+
+// verifyClientLeaf verifies the chain presented by a client and parses the
+// Connect identity from its leaf certificate.
+func verifyClientLeaf(logger hclog.Logger, tlsCfg *tls.Config, rawCerts [][]byte) (*x509.Certificate, connect.CertURI, error) {
+	leaf, err := verifyChain(tlsCfg, rawCerts, false)
+	if err != nil {
+		logger.Error("failed TLS verification", "error", err)
+		return nil, nil, err
+	}
+
+	// Check leaf is a cert we understand
+	if len(leaf.URIs) < 1 {
+		logger.Error("invalid leaf certificate: no URIs set")
+		return nil, nil, errors.New("connect: invalid leaf certificate")
+	}
+
+	certURI, err := connect.ParseCertURI(leaf.URIs[0])
+	if err != nil {
+		logger.Error("invalid leaf certificate URI", "error", err)
+		return nil, nil, errors.New("connect: invalid leaf certificate URI")
+	}
+	return leaf, certURI, nil
+}
+
 // clientSideVerifier is a verifierFunc that performs verification of certificates
 // on the client end of the connection. For now it is just basic TLS
 // verification since the identity check needs additional state and becomes
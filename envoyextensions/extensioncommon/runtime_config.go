@@ -58,8 +58,8 @@ type RuntimeConfig struct {
 	// See UpstreamEnvoyExtender for the code that applies RuntimeConfig with this flag set.
 	IsSourcedFromUpstream bool
 
-	// Kind is mode the local Envoy proxy is running in. For now, only connect proxy and
-	// terminating gateways are supported.
+	// Kind is mode the local Envoy proxy is running in. For now, only connect proxies,
+	// terminating gateways and ingress gateways are supported.
 	Kind api.ServiceKind
 
 	// Protocol is the protocol configured for the local service. It may be empty which implies tcp.
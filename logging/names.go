@@ -28,6 +28,7 @@ const (
 	FederationState       string = "federation_state"
 	FSM                   string = "fsm"
 	APIGatewayController  string = "api_gateway_controller"
+	ACMECertificate       string = "acme_certificate"
 	GatewayLocator        string = "gateway_locator"
 	HTTP                  string = "http"
 	HTTPRouteController   string = "http_route_controller"
@@ -4,6 +4,68 @@ package pbconfigentry
 
 import "github.com/hashicorp/consul/agent/structs"
 
+func ACMECertificateToStructs(s *ACMECertificate, t *structs.ACMECertificateConfigEntry) {
+	if s == nil {
+		return
+	}
+	t.DirectoryURL = s.DirectoryURL
+	t.Email = s.Email
+	t.Hostnames = s.Hostnames
+	t.RenewBefore = structs.DurationFromProto(s.RenewBefore)
+	{
+		t.Challenges = make([]structs.ACMEChallenge, len(s.Challenges))
+		for i := range s.Challenges {
+			if s.Challenges[i] != nil {
+				ACMEChallengeToStructs(s.Challenges[i], &t.Challenges[i])
+			}
+		}
+	}
+	if s.Status != nil {
+		StatusToStructs(s.Status, &t.Status)
+	}
+	t.Meta = s.Meta
+	t.Hash = s.Hash
+}
+func ACMECertificateFromStructs(t *structs.ACMECertificateConfigEntry, s *ACMECertificate) {
+	if s == nil {
+		return
+	}
+	s.DirectoryURL = t.DirectoryURL
+	s.Email = t.Email
+	s.Hostnames = t.Hostnames
+	s.RenewBefore = structs.DurationToProto(t.RenewBefore)
+	{
+		s.Challenges = make([]*ACMEChallenge, len(t.Challenges))
+		for i := range t.Challenges {
+			{
+				var x ACMEChallenge
+				ACMEChallengeFromStructs(&t.Challenges[i], &x)
+				s.Challenges[i] = &x
+			}
+		}
+	}
+	{
+		var x Status
+		StatusFromStructs(&t.Status, &x)
+		s.Status = &x
+	}
+	s.Meta = t.Meta
+	s.Hash = t.Hash
+}
+func ACMEChallengeToStructs(s *ACMEChallenge, t *structs.ACMEChallenge) {
+	if s == nil {
+		return
+	}
+	t.Token = s.Token
+	t.KeyAuthorization = s.KeyAuthorization
+}
+func ACMEChallengeFromStructs(t *structs.ACMEChallenge, s *ACMEChallenge) {
+	if s == nil {
+		return
+	}
+	s.Token = t.Token
+	s.KeyAuthorization = t.KeyAuthorization
+}
 func APIGatewayToStructs(s *APIGateway, t *structs.APIGatewayConfigEntry) {
 	if s == nil {
 		return
@@ -117,6 +179,7 @@ func APIGatewayTLSConfigurationToStructs(s *APIGatewayTLSConfiguration, t *struc
 	t.MaxVersion = tlsVersionToStructs(s.MaxVersion)
 	t.MinVersion = tlsVersionToStructs(s.MinVersion)
 	t.CipherSuites = cipherSuitesToStructs(s.CipherSuites)
+	t.Mode = apiGatewayTLSModeToStructs(s.Mode)
 }
 func APIGatewayTLSConfigurationFromStructs(t *structs.APIGatewayTLSConfiguration, s *APIGatewayTLSConfiguration) {
 	if s == nil {
@@ -135,6 +198,73 @@ func APIGatewayTLSConfigurationFromStructs(t *structs.APIGatewayTLSConfiguration
 	s.MaxVersion = tlsVersionFromStructs(t.MaxVersion)
 	s.MinVersion = tlsVersionFromStructs(t.MinVersion)
 	s.CipherSuites = cipherSuitesFromStructs(t.CipherSuites)
+	s.Mode = apiGatewayTLSModeFromStructs(t.Mode)
+}
+func ActiveHealthCheckToStructs(s *ActiveHealthCheck, t *structs.ActiveHealthCheck) {
+	if s == nil {
+		return
+	}
+	t.Interval = structs.DurationFromProto(s.Interval)
+	t.Timeout = structs.DurationFromProto(s.Timeout)
+	t.UnhealthyThreshold = s.UnhealthyThreshold
+	t.HealthyThreshold = s.HealthyThreshold
+	if s.HTTP != nil {
+		var x structs.ActiveHealthCheckHTTP
+		ActiveHealthCheckHTTPToStructs(s.HTTP, &x)
+		t.HTTP = &x
+	}
+	if s.GRPC != nil {
+		var x structs.ActiveHealthCheckGRPC
+		ActiveHealthCheckGRPCToStructs(s.GRPC, &x)
+		t.GRPC = &x
+	}
+}
+func ActiveHealthCheckFromStructs(t *structs.ActiveHealthCheck, s *ActiveHealthCheck) {
+	if s == nil {
+		return
+	}
+	s.Interval = structs.DurationToProto(t.Interval)
+	s.Timeout = structs.DurationToProto(t.Timeout)
+	s.UnhealthyThreshold = t.UnhealthyThreshold
+	s.HealthyThreshold = t.HealthyThreshold
+	if t.HTTP != nil {
+		var x ActiveHealthCheckHTTP
+		ActiveHealthCheckHTTPFromStructs(t.HTTP, &x)
+		s.HTTP = &x
+	}
+	if t.GRPC != nil {
+		var x ActiveHealthCheckGRPC
+		ActiveHealthCheckGRPCFromStructs(t.GRPC, &x)
+		s.GRPC = &x
+	}
+}
+func ActiveHealthCheckGRPCToStructs(s *ActiveHealthCheckGRPC, t *structs.ActiveHealthCheckGRPC) {
+	if s == nil {
+		return
+	}
+	t.ServiceName = s.ServiceName
+	t.Authority = s.Authority
+}
+func ActiveHealthCheckGRPCFromStructs(t *structs.ActiveHealthCheckGRPC, s *ActiveHealthCheckGRPC) {
+	if s == nil {
+		return
+	}
+	s.ServiceName = t.ServiceName
+	s.Authority = t.Authority
+}
+func ActiveHealthCheckHTTPToStructs(s *ActiveHealthCheckHTTP, t *structs.ActiveHealthCheckHTTP) {
+	if s == nil {
+		return
+	}
+	t.Path = s.Path
+	t.Host = s.Host
+}
+func ActiveHealthCheckHTTPFromStructs(t *structs.ActiveHealthCheckHTTP, s *ActiveHealthCheckHTTP) {
+	if s == nil {
+		return
+	}
+	s.Path = t.Path
+	s.Host = t.Host
 }
 func BoundAPIGatewayToStructs(s *BoundAPIGateway, t *structs.BoundAPIGatewayConfigEntry) {
 	if s == nil {
@@ -218,6 +348,30 @@ func BoundAPIGatewayListenerFromStructs(t *structs.BoundAPIGatewayListener, s *B
 		}
 	}
 }
+func CORSPolicyToStructs(s *CORSPolicy, t *structs.CORSPolicy) {
+	if s == nil {
+		return
+	}
+	t.AllowOrigins = s.AllowOrigins
+	t.AllowOriginRegexes = s.AllowOriginRegexes
+	t.AllowMethods = s.AllowMethods
+	t.AllowHeaders = s.AllowHeaders
+	t.ExposeHeaders = s.ExposeHeaders
+	t.MaxAge = structs.DurationFromProto(s.MaxAge)
+	t.AllowCredentials = s.AllowCredentials
+}
+func CORSPolicyFromStructs(t *structs.CORSPolicy, s *CORSPolicy) {
+	if s == nil {
+		return
+	}
+	s.AllowOrigins = t.AllowOrigins
+	s.AllowOriginRegexes = t.AllowOriginRegexes
+	s.AllowMethods = t.AllowMethods
+	s.AllowHeaders = t.AllowHeaders
+	s.ExposeHeaders = t.ExposeHeaders
+	s.MaxAge = structs.DurationToProto(t.MaxAge)
+	s.AllowCredentials = t.AllowCredentials
+}
 func ConditionToStructs(s *Condition, t *structs.Condition) {
 	if s == nil {
 		return
@@ -278,6 +432,20 @@ func DestinationConfigFromStructs(t *structs.DestinationConfig, s *DestinationCo
 	s.Addresses = t.Addresses
 	s.Port = int32(t.Port)
 }
+func DirectResponseFilterToStructs(s *DirectResponseFilter, t *structs.DirectResponseFilter) {
+	if s == nil {
+		return
+	}
+	t.StatusCode = int(s.StatusCode)
+	t.Body = s.Body
+}
+func DirectResponseFilterFromStructs(t *structs.DirectResponseFilter, s *DirectResponseFilter) {
+	if s == nil {
+		return
+	}
+	s.StatusCode = int32(t.StatusCode)
+	s.Body = t.Body
+}
 func ExportedServicesToStructs(s *ExportedServices, t *structs.ExportedServicesConfigEntry) {
 	if s == nil {
 		return
@@ -408,6 +576,84 @@ func ExposePathFromStructs(t *structs.ExposePath, s *ExposePath) {
 	s.Protocol = t.Protocol
 	s.ParsedFromCheck = t.ParsedFromCheck
 }
+func FaultInjectionToStructs(s *FaultInjection, t *structs.FaultInjection) {
+	if s == nil {
+		return
+	}
+	if s.Delay != nil {
+		var x structs.FaultInjectionDelay
+		FaultInjectionDelayToStructs(s.Delay, &x)
+		t.Delay = &x
+	}
+	if s.Abort != nil {
+		var x structs.FaultInjectionAbort
+		FaultInjectionAbortToStructs(s.Abort, &x)
+		t.Abort = &x
+	}
+	{
+		t.Headers = make([]structs.ServiceRouteHTTPMatchHeader, len(s.Headers))
+		for i := range s.Headers {
+			if s.Headers[i] != nil {
+				ServiceRouteHTTPMatchHeaderToStructs(s.Headers[i], &t.Headers[i])
+			}
+		}
+	}
+}
+func FaultInjectionFromStructs(t *structs.FaultInjection, s *FaultInjection) {
+	if s == nil {
+		return
+	}
+	if t.Delay != nil {
+		var x FaultInjectionDelay
+		FaultInjectionDelayFromStructs(t.Delay, &x)
+		s.Delay = &x
+	}
+	if t.Abort != nil {
+		var x FaultInjectionAbort
+		FaultInjectionAbortFromStructs(t.Abort, &x)
+		s.Abort = &x
+	}
+	{
+		s.Headers = make([]*ServiceRouteHTTPMatchHeader, len(t.Headers))
+		for i := range t.Headers {
+			{
+				var x ServiceRouteHTTPMatchHeader
+				ServiceRouteHTTPMatchHeaderFromStructs(&t.Headers[i], &x)
+				s.Headers[i] = &x
+			}
+		}
+	}
+}
+func FaultInjectionAbortToStructs(s *FaultInjectionAbort, t *structs.FaultInjectionAbort) {
+	if s == nil {
+		return
+	}
+	t.HTTPStatus = s.HTTPStatus
+	t.GRPCStatus = s.GRPCStatus
+	t.Percent = s.Percent
+}
+func FaultInjectionAbortFromStructs(t *structs.FaultInjectionAbort, s *FaultInjectionAbort) {
+	if s == nil {
+		return
+	}
+	s.HTTPStatus = t.HTTPStatus
+	s.GRPCStatus = t.GRPCStatus
+	s.Percent = t.Percent
+}
+func FaultInjectionDelayToStructs(s *FaultInjectionDelay, t *structs.FaultInjectionDelay) {
+	if s == nil {
+		return
+	}
+	t.FixedDelay = structs.DurationFromProto(s.FixedDelay)
+	t.Percent = s.Percent
+}
+func FaultInjectionDelayFromStructs(t *structs.FaultInjectionDelay, s *FaultInjectionDelay) {
+	if s == nil {
+		return
+	}
+	s.FixedDelay = structs.DurationToProto(t.FixedDelay)
+	s.Percent = t.Percent
+}
 func FileSystemCertificateToStructs(s *FileSystemCertificate, t *structs.FileSystemCertificateConfigEntry) {
 	if s == nil {
 		return
@@ -426,6 +672,180 @@ func FileSystemCertificateFromStructs(t *structs.FileSystemCertificateConfigEntr
 	s.Meta = t.Meta
 	s.Hash = t.Hash
 }
+func GRPCMatchToStructs(s *GRPCMatch, t *structs.GRPCMatch) {
+	if s == nil {
+		return
+	}
+	if s.Method != nil {
+		GRPCMethodMatchToStructs(s.Method, &t.Method)
+	}
+	{
+		t.Headers = make([]structs.HTTPHeaderMatch, len(s.Headers))
+		for i := range s.Headers {
+			if s.Headers[i] != nil {
+				HTTPHeaderMatchToStructs(s.Headers[i], &t.Headers[i])
+			}
+		}
+	}
+}
+func GRPCMatchFromStructs(t *structs.GRPCMatch, s *GRPCMatch) {
+	if s == nil {
+		return
+	}
+	{
+		var x GRPCMethodMatch
+		GRPCMethodMatchFromStructs(&t.Method, &x)
+		s.Method = &x
+	}
+	{
+		s.Headers = make([]*HTTPHeaderMatch, len(t.Headers))
+		for i := range t.Headers {
+			{
+				var x HTTPHeaderMatch
+				HTTPHeaderMatchFromStructs(&t.Headers[i], &x)
+				s.Headers[i] = &x
+			}
+		}
+	}
+}
+func GRPCMethodMatchToStructs(s *GRPCMethodMatch, t *structs.GRPCMethodMatch) {
+	if s == nil {
+		return
+	}
+	t.Match = grpcMethodMatchToStructs(s.Match)
+	t.Service = s.Service
+	t.Method = s.Method
+}
+func GRPCMethodMatchFromStructs(t *structs.GRPCMethodMatch, s *GRPCMethodMatch) {
+	if s == nil {
+		return
+	}
+	s.Match = grpcMethodMatchFromStructs(t.Match)
+	s.Service = t.Service
+	s.Method = t.Method
+}
+func GRPCRouteToStructs(s *GRPCRoute, t *structs.GRPCRouteConfigEntry) {
+	if s == nil {
+		return
+	}
+	{
+		t.Parents = make([]structs.ResourceReference, len(s.Parents))
+		for i := range s.Parents {
+			if s.Parents[i] != nil {
+				ResourceReferenceToStructs(s.Parents[i], &t.Parents[i])
+			}
+		}
+	}
+	{
+		t.Rules = make([]structs.GRPCRouteRule, len(s.Rules))
+		for i := range s.Rules {
+			if s.Rules[i] != nil {
+				GRPCRouteRuleToStructs(s.Rules[i], &t.Rules[i])
+			}
+		}
+	}
+	t.Hostnames = s.Hostnames
+	t.Meta = s.Meta
+	if s.Status != nil {
+		StatusToStructs(s.Status, &t.Status)
+	}
+	t.Hash = s.Hash
+}
+func GRPCRouteFromStructs(t *structs.GRPCRouteConfigEntry, s *GRPCRoute) {
+	if s == nil {
+		return
+	}
+	{
+		s.Parents = make([]*ResourceReference, len(t.Parents))
+		for i := range t.Parents {
+			{
+				var x ResourceReference
+				ResourceReferenceFromStructs(&t.Parents[i], &x)
+				s.Parents[i] = &x
+			}
+		}
+	}
+	{
+		s.Rules = make([]*GRPCRouteRule, len(t.Rules))
+		for i := range t.Rules {
+			{
+				var x GRPCRouteRule
+				GRPCRouteRuleFromStructs(&t.Rules[i], &x)
+				s.Rules[i] = &x
+			}
+		}
+	}
+	s.Hostnames = t.Hostnames
+	s.Meta = t.Meta
+	{
+		var x Status
+		StatusFromStructs(&t.Status, &x)
+		s.Status = &x
+	}
+	s.Hash = t.Hash
+}
+func GRPCRouteRuleToStructs(s *GRPCRouteRule, t *structs.GRPCRouteRule) {
+	if s == nil {
+		return
+	}
+	if s.Filters != nil {
+		HTTPFiltersToStructs(s.Filters, &t.Filters)
+	}
+	if s.ResponseFilters != nil {
+		HTTPResponseFiltersToStructs(s.ResponseFilters, &t.ResponseFilters)
+	}
+	{
+		t.Matches = make([]structs.GRPCMatch, len(s.Matches))
+		for i := range s.Matches {
+			if s.Matches[i] != nil {
+				GRPCMatchToStructs(s.Matches[i], &t.Matches[i])
+			}
+		}
+	}
+	{
+		t.Services = make([]structs.HTTPService, len(s.Services))
+		for i := range s.Services {
+			if s.Services[i] != nil {
+				HTTPServiceToStructs(s.Services[i], &t.Services[i])
+			}
+		}
+	}
+}
+func GRPCRouteRuleFromStructs(t *structs.GRPCRouteRule, s *GRPCRouteRule) {
+	if s == nil {
+		return
+	}
+	{
+		var x HTTPFilters
+		HTTPFiltersFromStructs(&t.Filters, &x)
+		s.Filters = &x
+	}
+	{
+		var x HTTPResponseFilters
+		HTTPResponseFiltersFromStructs(&t.ResponseFilters, &x)
+		s.ResponseFilters = &x
+	}
+	{
+		s.Matches = make([]*GRPCMatch, len(t.Matches))
+		for i := range t.Matches {
+			{
+				var x GRPCMatch
+				GRPCMatchFromStructs(&t.Matches[i], &x)
+				s.Matches[i] = &x
+			}
+		}
+	}
+	{
+		s.Services = make([]*HTTPService, len(t.Services))
+		for i := range t.Services {
+			{
+				var x HTTPService
+				HTTPServiceFromStructs(&t.Services[i], &x)
+				s.Services[i] = &x
+			}
+		}
+	}
+}
 func GatewayServiceTLSConfigToStructs(s *GatewayServiceTLSConfig, t *structs.GatewayServiceTLSConfig) {
 	if s == nil {
 		return
@@ -516,6 +936,16 @@ func HTTPFiltersToStructs(s *HTTPFilters, t *structs.HTTPFilters) {
 		t.TimeoutFilter = &x
 	}
 	t.JWT = routeJWTFilterToStructs(s.JWT)
+	if s.RequestRedirect != nil {
+		var x structs.RequestRedirectFilter
+		RequestRedirectFilterToStructs(s.RequestRedirect, &x)
+		t.RequestRedirect = &x
+	}
+	if s.DirectResponse != nil {
+		var x structs.DirectResponseFilter
+		DirectResponseFilterToStructs(s.DirectResponse, &x)
+		t.DirectResponse = &x
+	}
 }
 func HTTPFiltersFromStructs(t *structs.HTTPFilters, s *HTTPFilters) {
 	if s == nil {
@@ -547,6 +977,16 @@ func HTTPFiltersFromStructs(t *structs.HTTPFilters, s *HTTPFilters) {
 		s.TimeoutFilter = &x
 	}
 	s.JWT = routeJWTFilterFromStructs(t.JWT)
+	if t.RequestRedirect != nil {
+		var x RequestRedirectFilter
+		RequestRedirectFilterFromStructs(t.RequestRedirect, &x)
+		s.RequestRedirect = &x
+	}
+	if t.DirectResponse != nil {
+		var x DirectResponseFilter
+		DirectResponseFilterFromStructs(t.DirectResponse, &x)
+		s.DirectResponse = &x
+	}
 }
 func HTTPHeaderFilterToStructs(s *HTTPHeaderFilter, t *structs.HTTPHeaderFilter) {
 	if s == nil {
@@ -796,6 +1236,11 @@ func HTTPRouteRuleToStructs(s *HTTPRouteRule, t *structs.HTTPRouteRule) {
 			}
 		}
 	}
+	if s.CORS != nil {
+		var x structs.CORSPolicy
+		CORSPolicyToStructs(s.CORS, &x)
+		t.CORS = &x
+	}
 }
 func HTTPRouteRuleFromStructs(t *structs.HTTPRouteRule, s *HTTPRouteRule) {
 	if s == nil {
@@ -831,6 +1276,11 @@ func HTTPRouteRuleFromStructs(t *structs.HTTPRouteRule, s *HTTPRouteRule) {
 			}
 		}
 	}
+	if t.CORS != nil {
+		var x CORSPolicy
+		CORSPolicyFromStructs(t.CORS, &x)
+		s.CORS = &x
+	}
 }
 func HTTPServiceToStructs(s *HTTPService, t *structs.HTTPService) {
 	if s == nil {
@@ -961,6 +1411,13 @@ func IngressListenerToStructs(s *IngressListener, t *structs.IngressListener) {
 			}
 		}
 	}
+	if s.RateLimit != nil {
+		var x structs.LocalRateLimit
+		LocalRateLimitToStructs(s.RateLimit, &x)
+		t.RateLimit = &x
+	}
+	t.MaxRequestBytes = s.MaxRequestBytes
+	t.MaxRequestHeadersKB = s.MaxRequestHeadersKB
 }
 func IngressListenerFromStructs(t *structs.IngressListener, s *IngressListener) {
 	if s == nil {
@@ -983,6 +1440,13 @@ func IngressListenerFromStructs(t *structs.IngressListener, s *IngressListener)
 			}
 		}
 	}
+	if t.RateLimit != nil {
+		var x LocalRateLimit
+		LocalRateLimitFromStructs(t.RateLimit, &x)
+		s.RateLimit = &x
+	}
+	s.MaxRequestBytes = t.MaxRequestBytes
+	s.MaxRequestHeadersKB = t.MaxRequestHeadersKB
 }
 func IngressServiceToStructs(s *IngressService, t *structs.IngressService) {
 	if s == nil {
@@ -1013,6 +1477,17 @@ func IngressServiceToStructs(s *IngressService, t *structs.IngressService) {
 		PassiveHealthCheckToStructs(s.PassiveHealthCheck, &x)
 		t.PassiveHealthCheck = &x
 	}
+	if s.CORS != nil {
+		var x structs.CORSPolicy
+		CORSPolicyToStructs(s.CORS, &x)
+		t.CORS = &x
+	}
+	if s.RateLimit != nil {
+		var x structs.LocalRateLimit
+		LocalRateLimitToStructs(s.RateLimit, &x)
+		t.RateLimit = &x
+	}
+	t.MaxRequestBytes = s.MaxRequestBytes
 	t.Meta = s.Meta
 	t.EnterpriseMeta = enterpriseMetaToStructs(s.EnterpriseMeta)
 }
@@ -1045,6 +1520,17 @@ func IngressServiceFromStructs(t *structs.IngressService, s *IngressService) {
 		PassiveHealthCheckFromStructs(t.PassiveHealthCheck, &x)
 		s.PassiveHealthCheck = &x
 	}
+	if t.CORS != nil {
+		var x CORSPolicy
+		CORSPolicyFromStructs(t.CORS, &x)
+		s.CORS = &x
+	}
+	if t.RateLimit != nil {
+		var x LocalRateLimit
+		LocalRateLimitFromStructs(t.RateLimit, &x)
+		s.RateLimit = &x
+	}
+	s.MaxRequestBytes = t.MaxRequestBytes
 	s.Meta = t.Meta
 	s.EnterpriseMeta = enterpriseMetaFromStructs(t.EnterpriseMeta)
 }
@@ -1702,6 +2188,94 @@ func LocalJWKSFromStructs(t *structs.LocalJWKS, s *LocalJWKS) {
 	s.JWKS = t.JWKS
 	s.Filename = t.Filename
 }
+func LocalRateLimitToStructs(s *LocalRateLimit, t *structs.LocalRateLimit) {
+	if s == nil {
+		return
+	}
+	t.RequestsPerSecond = s.RequestsPerSecond
+	t.RequestsMaxBurst = s.RequestsMaxBurst
+	{
+		t.Routes = make([]structs.LocalRateLimitRoute, len(s.Routes))
+		for i := range s.Routes {
+			if s.Routes[i] != nil {
+				LocalRateLimitRouteToStructs(s.Routes[i], &t.Routes[i])
+			}
+		}
+	}
+	{
+		t.Descriptors = make([]structs.LocalRateLimitDescriptor, len(s.Descriptors))
+		for i := range s.Descriptors {
+			if s.Descriptors[i] != nil {
+				LocalRateLimitDescriptorToStructs(s.Descriptors[i], &t.Descriptors[i])
+			}
+		}
+	}
+}
+func LocalRateLimitFromStructs(t *structs.LocalRateLimit, s *LocalRateLimit) {
+	if s == nil {
+		return
+	}
+	s.RequestsPerSecond = t.RequestsPerSecond
+	s.RequestsMaxBurst = t.RequestsMaxBurst
+	{
+		s.Routes = make([]*LocalRateLimitRoute, len(t.Routes))
+		for i := range t.Routes {
+			{
+				var x LocalRateLimitRoute
+				LocalRateLimitRouteFromStructs(&t.Routes[i], &x)
+				s.Routes[i] = &x
+			}
+		}
+	}
+	{
+		s.Descriptors = make([]*LocalRateLimitDescriptor, len(t.Descriptors))
+		for i := range t.Descriptors {
+			{
+				var x LocalRateLimitDescriptor
+				LocalRateLimitDescriptorFromStructs(&t.Descriptors[i], &x)
+				s.Descriptors[i] = &x
+			}
+		}
+	}
+}
+func LocalRateLimitDescriptorToStructs(s *LocalRateLimitDescriptor, t *structs.LocalRateLimitDescriptor) {
+	if s == nil {
+		return
+	}
+	t.Header = s.Header
+	t.Value = s.Value
+	t.RequestsPerSecond = s.RequestsPerSecond
+	t.RequestsMaxBurst = s.RequestsMaxBurst
+}
+func LocalRateLimitDescriptorFromStructs(t *structs.LocalRateLimitDescriptor, s *LocalRateLimitDescriptor) {
+	if s == nil {
+		return
+	}
+	s.Header = t.Header
+	s.Value = t.Value
+	s.RequestsPerSecond = t.RequestsPerSecond
+	s.RequestsMaxBurst = t.RequestsMaxBurst
+}
+func LocalRateLimitRouteToStructs(s *LocalRateLimitRoute, t *structs.LocalRateLimitRoute) {
+	if s == nil {
+		return
+	}
+	t.PathExact = s.PathExact
+	t.PathPrefix = s.PathPrefix
+	t.PathRegex = s.PathRegex
+	t.RequestsPerSecond = s.RequestsPerSecond
+	t.RequestsMaxBurst = s.RequestsMaxBurst
+}
+func LocalRateLimitRouteFromStructs(t *structs.LocalRateLimitRoute, s *LocalRateLimitRoute) {
+	if s == nil {
+		return
+	}
+	s.PathExact = t.PathExact
+	s.PathPrefix = t.PathPrefix
+	s.PathRegex = t.PathRegex
+	s.RequestsPerSecond = t.RequestsPerSecond
+	s.RequestsMaxBurst = t.RequestsMaxBurst
+}
 func MeshConfigToStructs(s *MeshConfig, t *structs.MeshConfigEntry) {
 	if s == nil {
 		return
@@ -1916,6 +2490,26 @@ func RemoteJWKSFromStructs(t *structs.RemoteJWKS, s *RemoteJWKS) {
 		s.JWKSCluster = &x
 	}
 }
+func RequestRedirectFilterToStructs(s *RequestRedirectFilter, t *structs.RequestRedirectFilter) {
+	if s == nil {
+		return
+	}
+	t.Scheme = s.Scheme
+	t.Hostname = s.Hostname
+	t.Port = s.Port
+	t.Path = s.Path
+	t.StatusCode = int(s.StatusCode)
+}
+func RequestRedirectFilterFromStructs(t *structs.RequestRedirectFilter, s *RequestRedirectFilter) {
+	if s == nil {
+		return
+	}
+	s.Scheme = t.Scheme
+	s.Hostname = t.Hostname
+	s.Port = t.Port
+	s.Path = t.Path
+	s.StatusCode = int32(t.StatusCode)
+}
 func ResourceReferenceToStructs(s *ResourceReference, t *structs.ResourceReference) {
 	if s == nil {
 		return
@@ -2070,6 +2664,16 @@ func ServiceDefaultsToStructs(s *ServiceDefaults, t *structs.ServiceConfigEntry)
 		RateLimitsToStructs(s.RateLimits, &x)
 		t.RateLimits = &x
 	}
+	if s.FaultInjection != nil {
+		var x structs.FaultInjection
+		FaultInjectionToStructs(s.FaultInjection, &x)
+		t.FaultInjection = &x
+	}
+	if s.RateLimit != nil {
+		var x structs.LocalRateLimit
+		LocalRateLimitToStructs(s.RateLimit, &x)
+		t.RateLimit = &x
+	}
 	t.EnvoyExtensions = EnvoyExtensionsToStructs(s.EnvoyExtensions)
 	t.Meta = s.Meta
 	t.Hash = s.Hash
@@ -2116,6 +2720,16 @@ func ServiceDefaultsFromStructs(t *structs.ServiceConfigEntry, s *ServiceDefault
 		RateLimitsFromStructs(t.RateLimits, &x)
 		s.RateLimits = &x
 	}
+	if t.FaultInjection != nil {
+		var x FaultInjection
+		FaultInjectionFromStructs(t.FaultInjection, &x)
+		s.FaultInjection = &x
+	}
+	if t.RateLimit != nil {
+		var x LocalRateLimit
+		LocalRateLimitFromStructs(t.RateLimit, &x)
+		s.RateLimit = &x
+	}
 	s.EnvoyExtensions = EnvoyExtensionsFromStructs(t.EnvoyExtensions)
 	s.Meta = t.Meta
 	s.Hash = t.Hash
@@ -2206,6 +2820,19 @@ func ServiceResolverToStructs(s *ServiceResolver, t *structs.ServiceResolverConf
 		LoadBalancerToStructs(s.LoadBalancer, &x)
 		t.LoadBalancer = &x
 	}
+	if s.OutlierDetection != nil {
+		var x structs.PassiveHealthCheck
+		PassiveHealthCheckToStructs(s.OutlierDetection, &x)
+		t.OutlierDetection = &x
+	}
+	{
+		t.HealthChecks = make([]structs.ActiveHealthCheck, len(s.HealthChecks))
+		for i := range s.HealthChecks {
+			if s.HealthChecks[i] != nil {
+				ActiveHealthCheckToStructs(s.HealthChecks[i], &t.HealthChecks[i])
+			}
+		}
+	}
 	t.Meta = s.Meta
 	t.Hash = s.Hash
 }
@@ -2255,6 +2882,21 @@ func ServiceResolverFromStructs(t *structs.ServiceResolverConfigEntry, s *Servic
 		LoadBalancerFromStructs(t.LoadBalancer, &x)
 		s.LoadBalancer = &x
 	}
+	if t.OutlierDetection != nil {
+		var x PassiveHealthCheck
+		PassiveHealthCheckFromStructs(t.OutlierDetection, &x)
+		s.OutlierDetection = &x
+	}
+	{
+		s.HealthChecks = make([]*ActiveHealthCheck, len(t.HealthChecks))
+		for i := range t.HealthChecks {
+			{
+				var x ActiveHealthCheck
+				ActiveHealthCheckFromStructs(&t.HealthChecks[i], &x)
+				s.HealthChecks[i] = &x
+			}
+		}
+	}
 	s.Meta = t.Meta
 	s.Hash = t.Hash
 }
@@ -2347,12 +2989,14 @@ func ServiceResolverPrioritizeByLocalityToStructs(s *ServiceResolverPrioritizeBy
 		return
 	}
 	t.Mode = s.Mode
+	t.MinClusterSize = s.MinClusterSize
 }
 func ServiceResolverPrioritizeByLocalityFromStructs(t *structs.ServiceResolverPrioritizeByLocality, s *ServiceResolverPrioritizeByLocality) {
 	if s == nil {
 		return
 	}
 	s.Mode = t.Mode
+	s.MinClusterSize = t.MinClusterSize
 }
 func ServiceResolverRedirectToStructs(s *ServiceResolverRedirect, t *structs.ServiceResolverRedirect) {
 	if s == nil {
@@ -2392,6 +3036,30 @@ func ServiceResolverSubsetFromStructs(t *structs.ServiceResolverSubset, s *Servi
 	s.Filter = t.Filter
 	s.OnlyPassing = t.OnlyPassing
 }
+func ServiceRouteHTTPMatchHeaderToStructs(s *ServiceRouteHTTPMatchHeader, t *structs.ServiceRouteHTTPMatchHeader) {
+	if s == nil {
+		return
+	}
+	t.Name = s.Name
+	t.Present = s.Present
+	t.Exact = s.Exact
+	t.Prefix = s.Prefix
+	t.Suffix = s.Suffix
+	t.Regex = s.Regex
+	t.Invert = s.Invert
+}
+func ServiceRouteHTTPMatchHeaderFromStructs(t *structs.ServiceRouteHTTPMatchHeader, s *ServiceRouteHTTPMatchHeader) {
+	if s == nil {
+		return
+	}
+	s.Name = t.Name
+	s.Present = t.Present
+	s.Exact = t.Exact
+	s.Prefix = t.Prefix
+	s.Suffix = t.Suffix
+	s.Regex = t.Regex
+	s.Invert = t.Invert
+}
 func SourceIntentionToStructs(s *SourceIntention, t *structs.SourceIntention) {
 	if s == nil {
 		return
@@ -2532,6 +3200,66 @@ func TCPRouteFromStructs(t *structs.TCPRouteConfigEntry, s *TCPRoute) {
 	}
 	s.Hash = t.Hash
 }
+func TLSRouteToStructs(s *TLSRoute, t *structs.TLSRouteConfigEntry) {
+	if s == nil {
+		return
+	}
+	{
+		t.Parents = make([]structs.ResourceReference, len(s.Parents))
+		for i := range s.Parents {
+			if s.Parents[i] != nil {
+				ResourceReferenceToStructs(s.Parents[i], &t.Parents[i])
+			}
+		}
+	}
+	t.Hostnames = s.Hostnames
+	{
+		t.Services = make([]structs.TCPService, len(s.Services))
+		for i := range s.Services {
+			if s.Services[i] != nil {
+				TCPServiceToStructs(s.Services[i], &t.Services[i])
+			}
+		}
+	}
+	t.Meta = s.Meta
+	if s.Status != nil {
+		StatusToStructs(s.Status, &t.Status)
+	}
+	t.Hash = s.Hash
+}
+func TLSRouteFromStructs(t *structs.TLSRouteConfigEntry, s *TLSRoute) {
+	if s == nil {
+		return
+	}
+	{
+		s.Parents = make([]*ResourceReference, len(t.Parents))
+		for i := range t.Parents {
+			{
+				var x ResourceReference
+				ResourceReferenceFromStructs(&t.Parents[i], &x)
+				s.Parents[i] = &x
+			}
+		}
+	}
+	s.Hostnames = t.Hostnames
+	{
+		s.Services = make([]*TCPService, len(t.Services))
+		for i := range t.Services {
+			{
+				var x TCPService
+				TCPServiceFromStructs(&t.Services[i], &x)
+				s.Services[i] = &x
+			}
+		}
+	}
+	s.Meta = t.Meta
+	{
+		var x Status
+		StatusFromStructs(&t.Status, &x)
+		s.Status = &x
+	}
+	s.Hash = t.Hash
+}
 func TCPServiceToStructs(s *TCPService, t *structs.TCPService) {
 	if s == nil {
 		return
@@ -77,6 +77,14 @@ func ConfigEntryToStructs(s *ConfigEntry) structs.ConfigEntry {
 		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
 		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
 		return &target
+	case Kind_KindTLSRoute:
+		var target structs.TLSRouteConfigEntry
+		target.Name = s.Name
+
+		TLSRouteToStructs(s.GetTLSRoute(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
 	case Kind_KindHTTPRoute:
 		var target structs.HTTPRouteConfigEntry
 		target.Name = s.Name
@@ -85,6 +93,14 @@ func ConfigEntryToStructs(s *ConfigEntry) structs.ConfigEntry {
 		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
 		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
 		return &target
+	case Kind_KindGRPCRoute:
+		var target structs.GRPCRouteConfigEntry
+		target.Name = s.Name
+
+		GRPCRouteToStructs(s.GetGRPCRoute(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
 	case Kind_KindFileSystemCertificate:
 		var target structs.FileSystemCertificateConfigEntry
 		target.Name = s.Name
@@ -101,6 +117,14 @@ func ConfigEntryToStructs(s *ConfigEntry) structs.ConfigEntry {
 		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
 		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
 		return &target
+	case Kind_KindACMECertificate:
+		var target structs.ACMECertificateConfigEntry
+		target.Name = s.Name
+
+		ACMECertificateToStructs(s.GetACMECertificate(), &target)
+		pbcommon.RaftIndexToStructs(s.RaftIndex, &target.RaftIndex)
+		pbcommon.EnterpriseMetaToStructs(s.EnterpriseMeta, &target.EnterpriseMeta)
+		return &target
 	case Kind_KindServiceDefaults:
 		var target structs.ServiceConfigEntry
 		target.Name = s.Name
@@ -213,6 +237,14 @@ func ConfigEntryFromStructs(s structs.ConfigEntry) *ConfigEntry {
 		configEntry.Entry = &ConfigEntry_TCPRoute{
 			TCPRoute: &route,
 		}
+	case *structs.TLSRouteConfigEntry:
+		var route TLSRoute
+		TLSRouteFromStructs(v, &route)
+
+		configEntry.Kind = Kind_KindTLSRoute
+		configEntry.Entry = &ConfigEntry_TLSRoute{
+			TLSRoute: &route,
+		}
 	case *structs.HTTPRouteConfigEntry:
 		var route HTTPRoute
 		HTTPRouteFromStructs(v, &route)
@@ -221,6 +253,14 @@ func ConfigEntryFromStructs(s structs.ConfigEntry) *ConfigEntry {
 		configEntry.Entry = &ConfigEntry_HTTPRoute{
 			HTTPRoute: &route,
 		}
+	case *structs.GRPCRouteConfigEntry:
+		var route GRPCRoute
+		GRPCRouteFromStructs(v, &route)
+
+		configEntry.Kind = Kind_KindGRPCRoute
+		configEntry.Entry = &ConfigEntry_GRPCRoute{
+			GRPCRoute: &route,
+		}
 	case *structs.FileSystemCertificateConfigEntry:
 		var cert FileSystemCertificate
 		FileSystemCertificateFromStructs(v, &cert)
@@ -237,6 +277,14 @@ func ConfigEntryFromStructs(s structs.ConfigEntry) *ConfigEntry {
 		configEntry.Entry = &ConfigEntry_InlineCertificate{
 			InlineCertificate: &cert,
 		}
+	case *structs.ACMECertificateConfigEntry:
+		var cert ACMECertificate
+		ACMECertificateFromStructs(v, &cert)
+
+		configEntry.Kind = Kind_KindACMECertificate
+		configEntry.Entry = &ConfigEntry_ACMECertificate{
+			ACMECertificate: &cert,
+		}
 	case *structs.SamenessGroupConfigEntry:
 		var sg SamenessGroup
 		SamenessGroupFromStructs(v, &sg)
@@ -275,6 +323,14 @@ func tlsVersionFromStructs(t types.TLSVersion) string {
 	return t.String()
 }
 
+func apiGatewayTLSModeToStructs(s string) structs.APIGatewayTLSMode {
+	return structs.APIGatewayTLSMode(s)
+}
+
+func apiGatewayTLSModeFromStructs(t structs.APIGatewayTLSMode) string {
+	return string(t)
+}
+
 func cipherSuitesToStructs(cs []string) []types.TLSCipherSuite {
 	cipherSuites := make([]types.TLSCipherSuite, len(cs))
 	for idx, suite := range cs {
@@ -580,6 +636,28 @@ func httpPathMatchToStructs(a HTTPPathMatchType) structs.HTTPPathMatchType {
 	}
 }
 
+func grpcMethodMatchFromStructs(a structs.GRPCMethodMatchType) GRPCMethodMatchType {
+	switch a {
+	case structs.GRPCMethodMatchExact:
+		return GRPCMethodMatchType_GRPCMethodMatchExact
+	case structs.GRPCMethodMatchRegularExpression:
+		return GRPCMethodMatchType_GRPCMethodMatchRegularExpression
+	default:
+		return GRPCMethodMatchType_GRPCMethodMatchExact
+	}
+}
+
+func grpcMethodMatchToStructs(a GRPCMethodMatchType) structs.GRPCMethodMatchType {
+	switch a {
+	case GRPCMethodMatchType_GRPCMethodMatchExact:
+		return structs.GRPCMethodMatchExact
+	case GRPCMethodMatchType_GRPCMethodMatchRegularExpression:
+		return structs.GRPCMethodMatchRegularExpression
+	default:
+		return structs.GRPCMethodMatchExact
+	}
+}
+
 func httpQueryMatchFromStructs(a structs.HTTPQueryMatchType) HTTPQueryMatchType {
 	switch a {
 	case structs.HTTPQueryMatchExact:
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package pbconfigentry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/consul/agent/structs"
+)
+
+func TestServiceDefaults_RoundTrip(t *testing.T) {
+	in := &structs.ServiceConfigEntry{
+		Kind: structs.ServiceDefaults,
+		Name: "web",
+		FaultInjection: &structs.FaultInjection{
+			Delay: &structs.FaultInjectionDelay{
+				FixedDelay: 5 * time.Second,
+				Percent:    12.5,
+			},
+			Abort: &structs.FaultInjectionAbort{
+				HTTPStatus: 503,
+				Percent:    50,
+			},
+			Headers: []structs.ServiceRouteHTTPMatchHeader{
+				{Name: "x-fault", Exact: "true"},
+				{Name: "x-debug", Present: true, Invert: true},
+			},
+		},
+		RateLimit: &structs.LocalRateLimit{
+			RequestsPerSecond: 100,
+			RequestsMaxBurst:  200,
+			Routes: []structs.LocalRateLimitRoute{
+				{PathPrefix: "/admin", RequestsPerSecond: 5},
+			},
+			Descriptors: []structs.LocalRateLimitDescriptor{
+				{Header: "x-tenant", Value: "free", RequestsPerSecond: 10, RequestsMaxBurst: 10},
+			},
+		},
+	}
+
+	out, ok := ConfigEntryToStructs(ConfigEntryFromStructs(in)).(*structs.ServiceConfigEntry)
+	require.True(t, ok)
+	require.Equal(t, in.FaultInjection, out.FaultInjection)
+	require.Equal(t, in.RateLimit, out.RateLimit)
+}
+
+func TestHTTPRoute_RoundTrip(t *testing.T) {
+	in := &structs.HTTPRouteConfigEntry{
+		Kind: structs.HTTPRoute,
+		Name: "web",
+		Rules: []structs.HTTPRouteRule{
+			{
+				CORS: &structs.CORSPolicy{
+					AllowOrigins:       []string{"https://example.com"},
+					AllowOriginRegexes: []string{`https://.*\.example\.com`},
+					AllowMethods:       []string{"GET", "POST"},
+					AllowHeaders:       []string{"content-type"},
+					ExposeHeaders:      []string{"x-request-id"},
+					MaxAge:             10 * time.Minute,
+					AllowCredentials:   true,
+				},
+			},
+		},
+	}
+
+	out, ok := ConfigEntryToStructs(ConfigEntryFromStructs(in)).(*structs.HTTPRouteConfigEntry)
+	require.True(t, ok)
+	require.Len(t, out.Rules, 1)
+	require.Equal(t, in.Rules[0].CORS, out.Rules[0].CORS)
+}
+
+func TestIngressGateway_RoundTrip(t *testing.T) {
+	in := &structs.IngressGatewayConfigEntry{
+		Kind: structs.IngressGateway,
+		Name: "ingress",
+		Listeners: []structs.IngressListener{
+			{
+				Port:     8080,
+				Protocol: "http",
+				Services: []structs.IngressService{
+					{
+						Name: "web",
+						CORS: &structs.CORSPolicy{
+							AllowOrigins: []string{"*"},
+							MaxAge:       time.Hour,
+						},
+						RateLimit: &structs.LocalRateLimit{
+							RequestsPerSecond: 50,
+							Routes: []structs.LocalRateLimitRoute{
+								{PathExact: "/login", RequestsPerSecond: 1},
+							},
+							Descriptors: []structs.LocalRateLimitDescriptor{
+								{Header: "x-tenant", Value: "free", RequestsPerSecond: 5},
+							},
+						},
+						MaxRequestBytes: 1 << 20,
+					},
+				},
+				RateLimit: &structs.LocalRateLimit{
+					RequestsPerSecond: 100,
+					RequestsMaxBurst:  150,
+					Routes: []structs.LocalRateLimitRoute{
+						{PathPrefix: "/api", RequestsPerSecond: 20},
+					},
+					Descriptors: []structs.LocalRateLimitDescriptor{
+						{Header: "x-tenant", Value: "free", RequestsPerSecond: 10},
+					},
+				},
+				MaxRequestBytes:     4 << 20,
+				MaxRequestHeadersKB: 96,
+			},
+		},
+	}
+
+	out, ok := ConfigEntryToStructs(ConfigEntryFromStructs(in)).(*structs.IngressGatewayConfigEntry)
+	require.True(t, ok)
+	require.Len(t, out.Listeners, 1)
+	require.Equal(t, in.Listeners[0].RateLimit, out.Listeners[0].RateLimit)
+	require.Equal(t, in.Listeners[0].MaxRequestBytes, out.Listeners[0].MaxRequestBytes)
+	require.Equal(t, in.Listeners[0].MaxRequestHeadersKB, out.Listeners[0].MaxRequestHeadersKB)
+
+	require.Len(t, out.Listeners[0].Services, 1)
+	require.Equal(t, in.Listeners[0].Services[0].CORS, out.Listeners[0].Services[0].CORS)
+	require.Equal(t, in.Listeners[0].Services[0].RateLimit, out.Listeners[0].Services[0].RateLimit)
+	require.Equal(t, in.Listeners[0].Services[0].MaxRequestBytes, out.Listeners[0].Services[0].MaxRequestBytes)
+}
+
+func TestServiceResolver_RoundTrip(t *testing.T) {
+	enforcingConsecutive5xx, maxEjectionPercent := uint32(100), uint32(50)
+	in := &structs.ServiceResolverConfigEntry{
+		Kind: structs.ServiceResolver,
+		Name: "web",
+		PrioritizeByLocality: &structs.ServiceResolverPrioritizeByLocality{
+			Mode:           structs.PrioritizeByLocalityModeZoneAware,
+			MinClusterSize: 10,
+		},
+		OutlierDetection: &structs.PassiveHealthCheck{
+			Interval:                10 * time.Second,
+			MaxFailures:             5,
+			EnforcingConsecutive5xx: &enforcingConsecutive5xx,
+			MaxEjectionPercent:      &maxEjectionPercent,
+		},
+		HealthChecks: []structs.ActiveHealthCheck{
+			{
+				Interval:           5 * time.Second,
+				Timeout:            time.Second,
+				UnhealthyThreshold: 3,
+				HealthyThreshold:   2,
+				HTTP:               &structs.ActiveHealthCheckHTTP{Path: "/health", Host: "web.internal"},
+			},
+			{
+				GRPC: &structs.ActiveHealthCheckGRPC{ServiceName: "web.v1.Health"},
+			},
+		},
+	}
+
+	out, ok := ConfigEntryToStructs(ConfigEntryFromStructs(in)).(*structs.ServiceResolverConfigEntry)
+	require.True(t, ok)
+	require.Equal(t, in.PrioritizeByLocality, out.PrioritizeByLocality)
+	require.Equal(t, in.OutlierDetection, out.OutlierDetection)
+	require.Equal(t, in.HealthChecks, out.HealthChecks)
+}
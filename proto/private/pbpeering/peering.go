@@ -210,6 +210,10 @@ func (s *SecretsWriteRequest) Validate() error {
 		if r != nil && r.PromotePending.GetActiveStreamSecret() != "" {
 			return nil
 		}
+	case *SecretsWriteRequest_RotateSecret:
+		if r != nil && r.RotateSecret.GetPendingStreamSecret() != "" {
+			return nil
+		}
 	default:
 		return fmt.Errorf("unexpected request type %T", s.Request)
 	}
@@ -262,6 +266,61 @@ func (resp *PeeringListResponse) ToAPI() []*api.Peering {
 	return list
 }
 
+// TODO consider using mog for this
+func (d *PeeringDiagnostics) ToAPI() *api.PeeringDiagnostics {
+	if d == nil {
+		return nil
+	}
+	t := &api.PeeringDiagnostics{
+		Name:                 d.Name,
+		ID:                   d.ID,
+		State:                PeeringStateToAPI(d.State),
+		Connected:            d.Connected,
+		Dialer:               d.Dialer,
+		LastHeartbeat:        TimePtrFromProto(d.LastHeartbeat),
+		LastAck:              TimePtrFromProto(d.LastAck),
+		LastNack:             TimePtrFromProto(d.LastNack),
+		LastNackMessage:      d.LastNackMessage,
+		LastSendError:        TimePtrFromProto(d.LastSendError),
+		LastSendErrorMessage: d.LastSendErrorMessage,
+		LastRecvError:        TimePtrFromProto(d.LastRecvError),
+		LastRecvErrorMessage: d.LastRecvErrorMessage,
+		Resources:            make([]api.PeeringResourceStatus, len(d.Resources)),
+		StreamEvents:         make([]api.PeeringStreamEvent, len(d.StreamEvents)),
+	}
+	for i, r := range d.Resources {
+		t.Resources[i] = api.PeeringResourceStatus{
+			ResourceURL:     r.ResourceURL,
+			ResourceID:      r.ResourceID,
+			LastSent:        TimePtrFromProto(r.LastSent),
+			LastSentNonce:   r.LastSentNonce,
+			LastAck:         TimePtrFromProto(r.LastAck),
+			LastNack:        TimePtrFromProto(r.LastNack),
+			LastNackMessage: r.LastNackMessage,
+		}
+	}
+	for i, e := range d.StreamEvents {
+		t.StreamEvents[i] = api.PeeringStreamEvent{
+			Time:         e.Time.AsTime(),
+			Connected:    e.Connected,
+			ErrorMessage: e.ErrorMessage,
+		}
+	}
+	if dial := d.Dial; dial != nil {
+		t.Dial = &api.PeeringDialDiagnostics{
+			ServerName:              dial.ServerName,
+			ServerAddresses:         dial.ServerAddresses,
+			PeerThroughMeshGateways: dial.PeerThroughMeshGateways,
+			GatewayAddresses:        dial.GatewayAddresses,
+			LastDialAddress:         dial.LastDialAddress,
+			LastDialTime:            TimePtrFromProto(dial.LastDialTime),
+			ViaMeshGateway:          dial.ViaMeshGateway,
+			Error:                   dial.Error,
+		}
+	}
+	return t
+}
+
 // TODO consider using mog for this
 func (resp *GenerateTokenResponse) ToAPI() *api.PeeringGenerateTokenResponse {
 	var t api.PeeringGenerateTokenResponse
@@ -24,8 +24,10 @@ const _ = grpc.SupportPackageIsVersion7
 type PeeringServiceClient interface {
 	GenerateToken(ctx context.Context, in *GenerateTokenRequest, opts ...grpc.CallOption) (*GenerateTokenResponse, error)
 	Establish(ctx context.Context, in *EstablishRequest, opts ...grpc.CallOption) (*EstablishResponse, error)
+	RotateSecrets(ctx context.Context, in *RotateSecretsRequest, opts ...grpc.CallOption) (*RotateSecretsResponse, error)
 	PeeringRead(ctx context.Context, in *PeeringReadRequest, opts ...grpc.CallOption) (*PeeringReadResponse, error)
 	PeeringList(ctx context.Context, in *PeeringListRequest, opts ...grpc.CallOption) (*PeeringListResponse, error)
+	PeeringDiagnostics(ctx context.Context, in *PeeringDiagnosticsRequest, opts ...grpc.CallOption) (*PeeringDiagnosticsResponse, error)
 	PeeringDelete(ctx context.Context, in *PeeringDeleteRequest, opts ...grpc.CallOption) (*PeeringDeleteResponse, error)
 	// TODO(peering): As of writing, this method is only used in tests to set up Peerings in the state store.
 	// Consider removing if we can find another way to populate state store in peering_endpoint_test.go
@@ -61,6 +63,15 @@ func (c *peeringServiceClient) Establish(ctx context.Context, in *EstablishReque
 	return out, nil
 }
 
+func (c *peeringServiceClient) RotateSecrets(ctx context.Context, in *RotateSecretsRequest, opts ...grpc.CallOption) (*RotateSecretsResponse, error) {
+	out := new(RotateSecretsResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.peering.PeeringService/RotateSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *peeringServiceClient) PeeringRead(ctx context.Context, in *PeeringReadRequest, opts ...grpc.CallOption) (*PeeringReadResponse, error) {
 	out := new(PeeringReadResponse)
 	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.peering.PeeringService/PeeringRead", in, out, opts...)
@@ -79,6 +90,15 @@ func (c *peeringServiceClient) PeeringList(ctx context.Context, in *PeeringListR
 	return out, nil
 }
 
+func (c *peeringServiceClient) PeeringDiagnostics(ctx context.Context, in *PeeringDiagnosticsRequest, opts ...grpc.CallOption) (*PeeringDiagnosticsResponse, error) {
+	out := new(PeeringDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.peering.PeeringService/PeeringDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *peeringServiceClient) PeeringDelete(ctx context.Context, in *PeeringDeleteRequest, opts ...grpc.CallOption) (*PeeringDeleteResponse, error) {
 	out := new(PeeringDeleteResponse)
 	err := c.cc.Invoke(ctx, "/hashicorp.consul.internal.peering.PeeringService/PeeringDelete", in, out, opts...)
@@ -121,8 +141,10 @@ func (c *peeringServiceClient) TrustBundleRead(ctx context.Context, in *TrustBun
 type PeeringServiceServer interface {
 	GenerateToken(context.Context, *GenerateTokenRequest) (*GenerateTokenResponse, error)
 	Establish(context.Context, *EstablishRequest) (*EstablishResponse, error)
+	RotateSecrets(context.Context, *RotateSecretsRequest) (*RotateSecretsResponse, error)
 	PeeringRead(context.Context, *PeeringReadRequest) (*PeeringReadResponse, error)
 	PeeringList(context.Context, *PeeringListRequest) (*PeeringListResponse, error)
+	PeeringDiagnostics(context.Context, *PeeringDiagnosticsRequest) (*PeeringDiagnosticsResponse, error)
 	PeeringDelete(context.Context, *PeeringDeleteRequest) (*PeeringDeleteResponse, error)
 	// TODO(peering): As of writing, this method is only used in tests to set up Peerings in the state store.
 	// Consider removing if we can find another way to populate state store in peering_endpoint_test.go
@@ -142,12 +164,18 @@ func (UnimplementedPeeringServiceServer) GenerateToken(context.Context, *Generat
 func (UnimplementedPeeringServiceServer) Establish(context.Context, *EstablishRequest) (*EstablishResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Establish not implemented")
 }
+func (UnimplementedPeeringServiceServer) RotateSecrets(context.Context, *RotateSecretsRequest) (*RotateSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSecrets not implemented")
+}
 func (UnimplementedPeeringServiceServer) PeeringRead(context.Context, *PeeringReadRequest) (*PeeringReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PeeringRead not implemented")
 }
 func (UnimplementedPeeringServiceServer) PeeringList(context.Context, *PeeringListRequest) (*PeeringListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PeeringList not implemented")
 }
+func (UnimplementedPeeringServiceServer) PeeringDiagnostics(context.Context, *PeeringDiagnosticsRequest) (*PeeringDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeeringDiagnostics not implemented")
+}
 func (UnimplementedPeeringServiceServer) PeeringDelete(context.Context, *PeeringDeleteRequest) (*PeeringDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PeeringDelete not implemented")
 }
@@ -208,6 +236,24 @@ func _PeeringService_Establish_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeeringService_RotateSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeeringServiceServer).RotateSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.peering.PeeringService/RotateSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeeringServiceServer).RotateSecrets(ctx, req.(*RotateSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PeeringService_PeeringRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PeeringReadRequest)
 	if err := dec(in); err != nil {
@@ -244,6 +290,24 @@ func _PeeringService_PeeringList_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PeeringService_PeeringDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeeringDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeeringServiceServer).PeeringDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hashicorp.consul.internal.peering.PeeringService/PeeringDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeeringServiceServer).PeeringDiagnostics(ctx, req.(*PeeringDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PeeringService_PeeringDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PeeringDeleteRequest)
 	if err := dec(in); err != nil {
@@ -331,6 +395,10 @@ var PeeringService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Establish",
 			Handler:    _PeeringService_Establish_Handler,
 		},
+		{
+			MethodName: "RotateSecrets",
+			Handler:    _PeeringService_RotateSecrets_Handler,
+		},
 		{
 			MethodName: "PeeringRead",
 			Handler:    _PeeringService_PeeringRead_Handler,
@@ -339,6 +407,10 @@ var PeeringService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PeeringList",
 			Handler:    _PeeringService_PeeringList_Handler,
 		},
+		{
+			MethodName: "PeeringDiagnostics",
+			Handler:    _PeeringService_PeeringDiagnostics_Handler,
+		},
 		{
 			MethodName: "PeeringDelete",
 			Handler:    _PeeringService_PeeringDelete_Handler,
@@ -10,11 +10,13 @@ const (
 	TypeURLExportedServiceList    = apiTypePrefix + "hashicorp.consul.internal.peerstream.ExportedServiceList"
 	TypeURLPeeringTrustBundle     = apiTypePrefix + "hashicorp.consul.internal.peering.PeeringTrustBundle"
 	TypeURLPeeringServerAddresses = apiTypePrefix + "hashicorp.consul.internal.peering.PeeringServerAddresses"
+	TypeURLPeeringStreamSecret    = apiTypePrefix + "hashicorp.consul.internal.peering.PeeringSecrets.Stream"
 )
 
 func KnownTypeURL(s string) bool {
 	switch s {
-	case TypeURLExportedService, TypeURLExportedServiceList, TypeURLPeeringTrustBundle, TypeURLPeeringServerAddresses:
+	case TypeURLExportedService, TypeURLExportedServiceList, TypeURLPeeringTrustBundle, TypeURLPeeringServerAddresses,
+		TypeURLPeeringStreamSecret:
 		return true
 	}
 	return false
@@ -14,6 +14,7 @@ func AccessLogsConfigToStructs(s *AccessLogsConfig, t *structs.AccessLogsConfig)
 	t.Path = s.Path
 	t.JSONFormat = s.JSONFormat
 	t.TextFormat = s.TextFormat
+	t.IncludeIntentionDenials = s.IncludeIntentionDenials
 }
 func AccessLogsConfigFromStructs(t *structs.AccessLogsConfig, s *AccessLogsConfig) {
 	if s == nil {
@@ -25,6 +26,7 @@ func AccessLogsConfigFromStructs(t *structs.AccessLogsConfig, s *AccessLogsConfi
 	s.Path = t.Path
 	s.JSONFormat = t.JSONFormat
 	s.TextFormat = t.TextFormat
+	s.IncludeIntentionDenials = t.IncludeIntentionDenials
 }
 func ConnectProxyConfigToStructs(s *ConnectProxyConfig, t *structs.ConnectProxyConfig) {
 	if s == nil {
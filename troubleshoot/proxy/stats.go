@@ -6,6 +6,7 @@ package troubleshoot
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 
 	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
 	"github.com/hashicorp/consul/troubleshoot/validate"
@@ -69,17 +70,35 @@ func (t *Troubleshoot) troubleshootStats() (validate.Messages, error) {
 }
 
 func (t *Troubleshoot) getEnvoyStats(filter string) ([]*envoy_admin_v3.SimpleMetric, error) {
+	stats, err := t.requestEnvoyStats(fmt.Sprintf("stats?format=json&filter=%s&type=Counters", filter))
+	if err != nil {
+		return nil, err
+	}
 
-	var resultErr error
+	t.envoyStats = stats
+	return stats, nil
+}
 
-	jsonRaw, err := t.request(fmt.Sprintf("stats?format=json&filter=%s&type=Counters", filter))
+// GetEnvoyStats returns the counters and gauges of the Envoy proxy whose name
+// matches the filter regular expression.
+func (t *Troubleshoot) GetEnvoyStats(filter string) ([]*envoy_admin_v3.SimpleMetric, error) {
+	return t.requestEnvoyStats("stats?format=json&filter=" + url.QueryEscape(filter))
+}
+
+func (t *Troubleshoot) requestEnvoyStats(path string) ([]*envoy_admin_v3.SimpleMetric, error) {
+	jsonRaw, err := t.request(path)
 	if err != nil {
 		return nil, fmt.Errorf("error in requesting envoy Admin API /stats endpoint: %w", err)
 	}
+	return parseEnvoyStats(jsonRaw)
+}
 
+// parseEnvoyStats parses the JSON output of the Envoy admin API /stats
+// endpoint. Histograms are ignored.
+func parseEnvoyStats(jsonRaw []byte) ([]*envoy_admin_v3.SimpleMetric, error) {
 	var rawStats statsJson
 
-	err = json.Unmarshal(jsonRaw, &rawStats)
+	err := json.Unmarshal(jsonRaw, &rawStats)
 	if err != nil {
 		return nil, fmt.Errorf("could not unmarshal /stats response: %w", err)
 	}
@@ -87,6 +106,9 @@ func (t *Troubleshoot) getEnvoyStats(filter string) ([]*envoy_admin_v3.SimpleMet
 	stats := []*envoy_admin_v3.SimpleMetric{}
 
 	for _, s := range rawStats.Stats {
+		if s.Name == "" {
+			continue
+		}
 		stat := &envoy_admin_v3.SimpleMetric{
 			Value: uint64(s.Value),
 			Name:  s.Name,
@@ -94,6 +116,5 @@ func (t *Troubleshoot) getEnvoyStats(filter string) ([]*envoy_admin_v3.SimpleMet
 		stats = append(stats, stat)
 	}
 
-	t.envoyStats = stats
-	return stats, resultErr
+	return stats, nil
 }
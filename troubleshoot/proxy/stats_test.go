@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package troubleshoot
+
+import (
+	"testing"
+
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnvoyStats(t *testing.T) {
+	t.Parallel()
+
+	raw := []byte(`{
+  "stats": [
+    {"name": "cluster.dc2.internal.11111111-2222-3333-4444-555555555555.consul.upstream_cx_total", "value": 4},
+    {"name": "cluster.dc2.internal.11111111-2222-3333-4444-555555555555.consul.upstream_cx_active", "value": 1},
+    {"name": "cluster.dc2.internal.11111111-2222-3333-4444-555555555555.consul.upstream_cx_connect_fail"},
+    {"histograms": {"supported_quantiles": [0, 25, 50], "computed_quantiles": []}}
+  ]
+}`)
+
+	stats, err := parseEnvoyStats(raw)
+	require.NoError(t, err)
+	require.Equal(t, []*envoy_admin_v3.SimpleMetric{
+		{Name: "cluster.dc2.internal.11111111-2222-3333-4444-555555555555.consul.upstream_cx_total", Value: 4},
+		{Name: "cluster.dc2.internal.11111111-2222-3333-4444-555555555555.consul.upstream_cx_active", Value: 1},
+		{Name: "cluster.dc2.internal.11111111-2222-3333-4444-555555555555.consul.upstream_cx_connect_fail"},
+	}, stats)
+
+	_, err = parseEnvoyStats([]byte("not json"))
+	require.ErrorContains(t, err, "could not unmarshal /stats response")
+}